@@ -0,0 +1,82 @@
+package authentication
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Authing/authing-golang-sdk/v3/util"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/valyala/fasthttp"
+)
+
+// requestObjectTTL request 对象的有效期
+const requestObjectTTL = 5 * time.Minute
+
+// buildRequestObject 将授权参数签名为 request 对象（RFC 9101），配置了 PrivateKey 时使用私钥签名，否则使用 AppSecret 以 HS256 签名
+func (client *AuthenticationClient) buildRequestObject(issuer string, params map[string]interface{}) (string, error) {
+	now := time.Now()
+	claims := jwt.MapClaims{}
+	for key, value := range params {
+		claims[key] = value
+	}
+	claims["iss"] = client.options.AppId
+	claims["aud"] = issuer
+	claims["jti"] = util.RandStringImpr(32)
+	claims["iat"] = now.Unix()
+	claims["nbf"] = now.Unix()
+	claims["exp"] = now.Add(requestObjectTTL).Unix()
+
+	var method jwt.SigningMethod = jwt.SigningMethodHS256
+	var key interface{} = []byte(client.options.AppSecret)
+	if client.privateKey != nil {
+		method, key = client.privateKeyMethod, client.privateKey
+	} else if client.options.AppSecret == "" {
+		return "", errors.New("签名 request 对象需要配置 PrivateKey 或 AppSecret")
+	}
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["typ"] = "oauth-authz-req+jwt"
+	if client.privateKey != nil && client.options.PrivateKeyId != "" {
+		token.Header["kid"] = client.options.PrivateKeyId
+	}
+	return token.SignedString(key)
+}
+
+// pushAuthorizationRequest 将授权参数推送到 PAR 端点（RFC 9126），返回用于授权地址的 request_uri
+func (client *AuthenticationClient) pushAuthorizationRequest(ctx context.Context, endpoints *protocolEndpoints, params map[string]interface{}) (string, error) {
+	header := map[string]string{
+		"Content-Type": "application/x-www-form-urlencoded",
+	}
+	body := map[string]string{}
+	for key, value := range params {
+		body[key] = fmt.Sprintf("%v", value)
+	}
+	if err := client.applyClientAuth(client.options.TokenEndPointAuthMethod, endpoints.Token, header, body); err != nil {
+		return "", err
+	}
+	resp, err := client.SendProtocolHttpRequestWithContext(ctx, &ProtocolRequestOption{
+		Url:     endpoints.PushedAuthorization,
+		Method:  fasthttp.MethodPost,
+		Headers: client.getReqHeaders(header),
+		ReqDto:  body,
+	})
+	if err != nil {
+		return "", err
+	}
+	if err = checkOAuthError(resp.StatusCode, resp.Body); err != nil {
+		return "", err
+	}
+	var response struct {
+		RequestUri string `json:"request_uri"`
+		ExpiresIn  int64  `json:"expires_in"`
+	}
+	if err = json.Unmarshal(resp.Body, &response); err != nil {
+		return "", fmt.Errorf("无法解析 PAR 响应: %w", err)
+	}
+	if response.RequestUri == "" {
+		return "", fmt.Errorf("PAR 响应缺少 request_uri: %s", resp.Body)
+	}
+	return response.RequestUri, nil
+}
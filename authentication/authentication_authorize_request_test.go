@@ -0,0 +1,102 @@
+package authentication
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const testPARResponse = `{"request_uri":"urn:ietf:params:oauth:request_uri:abc","expires_in":60}`
+
+// pushedForm 返回推送到 PAR 端点的参数
+func pushedForm(t *testing.T, server *stubServer) url.Values {
+	requests := server.requests("/oidc/request")
+	if len(requests) != 1 || server.hits("") != 1 {
+		t.Fatalf("应只请求一次 PAR 端点: %+v", server.requests(""))
+	}
+	return requests[0].Form
+}
+
+func authorizeQuery(t *testing.T, rawUrl string) url.Values {
+	parsed, err := url.Parse(rawUrl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return parsed.Query()
+}
+
+func TestBuildAuthorizeUrlByOidc_PAR(t *testing.T) {
+	server := newStubServer(stubResponse(http.StatusCreated, testPARResponse))
+	defer server.Close()
+	client := newTestClient(t, server.URL, withoutSecret)
+
+	result, err := client.BuildAuthorizeUrlByOidc(&OIDCAuthURLParams{
+		Scope:                      "openid profile",
+		CodeChallengeMethod:        CodeChallengeS256,
+		PushedAuthorizationRequest: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := authorizeQuery(t, result.Url)
+	if len(query) != 2 || query.Get("client_id") != "app" || query.Get("request_uri") != "urn:ietf:params:oauth:request_uri:abc" {
+		t.Fatalf("授权地址只应包含 client_id 与 request_uri: %s", result.Url)
+	}
+	form := pushedForm(t, server)
+	if form.Get("state") != result.State || form.Get("nonce") != result.Nonce || form.Get("scope") != "openid profile" ||
+		form.Get("code_challenge") == "" || form.Get("redirect_uri") != "https://example.com/callback" {
+		t.Fatalf("推送的授权参数不符合预期: %v", form)
+	}
+}
+
+func TestBuildAuthorizeUrlByOidc_SignedRequestObject(t *testing.T) {
+	client := newTestClient(t, "https://example.authing.cn")
+	maxAge := 60
+	result, err := client.BuildAuthorizeUrlByOidc(&OIDCAuthURLParams{MaxAge: &maxAge, SignedRequestObject: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := authorizeQuery(t, result.Url)
+	if len(query) != 2 || query.Get("client_id") != "app" {
+		t.Fatalf("授权地址只应包含 client_id 与 request: %s", result.Url)
+	}
+	claims := jwt.MapClaims{}
+	token, err := jwt.ParseWithClaims(query.Get("request"), claims, func(token *jwt.Token) (interface{}, error) {
+		return []byte("secret"), nil
+	}, jwt.WithValidMethods([]string{"HS256"}), jwt.WithIssuer("app"), jwt.WithAudience(testIssuer))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token.Header["typ"] != "oauth-authz-req+jwt" || claims["state"] != result.State || claims["nonce"] != result.Nonce ||
+		claims["client_id"] != "app" || claims["response_type"] != "code" || claims["max_age"] != float64(60) {
+		t.Fatalf("request 对象内容不符合预期: %v %v", token.Header, claims)
+	}
+}
+
+func TestBuildAuthorizeUrlByOidc_PARWithPrivateKeyRequestObject(t *testing.T) {
+	server := newStubServer(stubResponse(http.StatusCreated, testPARResponse))
+	defer server.Close()
+	key := newTestRSAKey(t)
+	client := newTestClient(t, server.URL, withoutSecret, withPrivateKeyJwt(key, "key-1"))
+
+	result, err := client.BuildAuthorizeUrlByOidc(&OIDCAuthURLParams{PushedAuthorizationRequest: true, SignedRequestObject: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if query := authorizeQuery(t, result.Url); query.Get("request_uri") == "" || query.Get("request") != "" {
+		t.Fatalf("授权地址不符合预期: %s", result.Url)
+	}
+	form := pushedForm(t, server)
+	if form.Get("client_assertion_type") != ClientAssertionType || form.Get("state") != "" {
+		t.Fatalf("PAR 请求应只包含 request 对象与客户端认证参数: %v", form)
+	}
+	claims := jwt.MapClaims{}
+	token, err := jwt.ParseWithClaims(form.Get("request"), claims, func(token *jwt.Token) (interface{}, error) {
+		return &key.PublicKey, nil
+	}, jwt.WithValidMethods([]string{"RS256"}))
+	if err != nil || token.Header["kid"] != "key-1" || claims["state"] != result.State {
+		t.Fatalf("request 对象不符合预期: %v %v %v", err, token.Header, claims)
+	}
+}
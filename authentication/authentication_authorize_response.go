@@ -0,0 +1,106 @@
+package authentication
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// AuthorizeResponse 授权端点回调到 redirect_uri 时携带的参数
+type AuthorizeResponse struct {
+	Code         string
+	State        string
+	AccessToken  string
+	IDToken      string
+	TokenType    string
+	ExpiresIn    int
+	Scope        string
+	SessionState string
+	/**
+	授权失败时的错误码，例如 access_denied、login_required
+	*/
+	Error            string
+	ErrorDescription string
+	ErrorUri         string
+}
+
+// AuthorizeError 授权端点返回的错误
+type AuthorizeError struct {
+	ErrorCode        string
+	ErrorDescription string
+	ErrorUri         string
+	State            string
+}
+
+func (e *AuthorizeError) Error() string {
+	if e.ErrorDescription == "" {
+		return e.ErrorCode
+	}
+	return e.ErrorCode + ": " + e.ErrorDescription
+}
+
+// ParseAuthorizeResponse 解析授权回调参数，input 可以是完整的回调地址（query 或 fragment 均可）或查询字符串。
+// 授权端点返回 error 时，同时返回解析结果与 *AuthorizeError
+func ParseAuthorizeResponse(input string) (*AuthorizeResponse, error) {
+	raw := input
+	// fragment 模式下参数全部位于 # 之后，否则取 ? 之后的查询字符串
+	if index := strings.Index(raw, "#"); index >= 0 && index < len(raw)-1 {
+		raw = raw[index+1:]
+	} else {
+		raw = strings.SplitN(raw, "#", 2)[0]
+		if index := strings.Index(raw, "?"); index >= 0 {
+			raw = raw[index+1:]
+		}
+	}
+	values, err := url.ParseQuery(raw)
+	if err != nil {
+		return nil, fmt.Errorf("解析授权回调参数失败: %w", err)
+	}
+	return parseAuthorizeValues(values)
+}
+
+// ParseAuthorizeResponseFromRequest 从回调请求中解析授权结果，支持 query 与 form_post 模式。
+// fragment 模式的参数不会发送到服务端，需由前端提取后使用 ParseAuthorizeResponse 解析
+func ParseAuthorizeResponseFromRequest(r *http.Request) (*AuthorizeResponse, error) {
+	if err := r.ParseForm(); err != nil {
+		return nil, fmt.Errorf("解析授权回调参数失败: %w", err)
+	}
+	values := r.URL.Query()
+	if r.Method == http.MethodPost {
+		values = r.PostForm
+	}
+	return parseAuthorizeValues(values)
+}
+
+func parseAuthorizeValues(values url.Values) (*AuthorizeResponse, error) {
+	response := &AuthorizeResponse{
+		Code:             values.Get("code"),
+		State:            values.Get("state"),
+		AccessToken:      values.Get("access_token"),
+		IDToken:          values.Get("id_token"),
+		TokenType:        values.Get("token_type"),
+		Scope:            values.Get("scope"),
+		SessionState:     values.Get("session_state"),
+		Error:            values.Get("error"),
+		ErrorDescription: values.Get("error_description"),
+		ErrorUri:         values.Get("error_uri"),
+	}
+	if expiresIn := values.Get("expires_in"); expiresIn != "" {
+		n, err := strconv.Atoi(expiresIn)
+		if err != nil {
+			return nil, fmt.Errorf("expires_in 格式错误: %s", expiresIn)
+		}
+		response.ExpiresIn = n
+	}
+	if response.Error != "" {
+		return response, &AuthorizeError{
+			ErrorCode:        response.Error,
+			ErrorDescription: response.ErrorDescription,
+			ErrorUri:         response.ErrorUri,
+			State:            response.State,
+		}
+	}
+	return response, nil
+}
@@ -0,0 +1,372 @@
+package authentication
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/valyala/fasthttp"
+)
+
+// CasVersionEnum CAS 协议版本
+type CasVersionEnum string
+
+const (
+	CasV2 CasVersionEnum = "2.0"
+	CasV3 CasVersionEnum = "3.0"
+)
+
+// casProxyGrantingTicketTTL 回调收到的 PGT 在内存中的保存时间
+const casProxyGrantingTicketTTL = 5 * time.Minute
+
+// casProxyGrantingTicketMaxSize 内存中最多保存的 PGT 数量
+const casProxyGrantingTicketMaxSize = 10000
+
+// ErrCasServiceRequired CasFilterOptions 未设置 Service
+var ErrCasServiceRequired = errors.New("CasFilterOptions.Service 不能为空")
+
+// CasError CAS 服务端返回的 authenticationFailure 或 proxyFailure，Code 例如 INVALID_TICKET、INVALID_SERVICE
+type CasError struct {
+	Code        string `xml:"code,attr"`
+	Description string `xml:",chardata"`
+}
+
+func (e *CasError) Error() string {
+	return fmt.Sprintf("CAS 校验失败 %s: %s", e.Code, strings.TrimSpace(e.Description))
+}
+
+// CasAttributes cas:attributes 中释放的用户属性，同名属性可能有多个值
+type CasAttributes map[string][]string
+
+// Get 返回属性的第一个值，属性不存在时返回空字符串
+func (attributes CasAttributes) Get(name string) string {
+	if values := attributes[name]; len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// UnmarshalXML 同时支持 <cas:name>value</cas:name> 与 <cas:attribute name="name" value="value"/> 两种属性格式
+func (attributes *CasAttributes) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	if *attributes == nil {
+		*attributes = CasAttributes{}
+	}
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+		switch element := token.(type) {
+		case xml.StartElement:
+			var value string
+			if err = decoder.DecodeElement(&value, &element); err != nil {
+				return err
+			}
+			name := element.Name.Local
+			if name == "attribute" {
+				for _, attr := range element.Attr {
+					switch attr.Name.Local {
+					case "name":
+						name = attr.Value
+					case "value":
+						value = attr.Value
+					}
+				}
+			}
+			(*attributes)[name] = append((*attributes)[name], strings.TrimSpace(value))
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// CasAuthenticationSuccess CAS 票据校验成功的结果
+type CasAuthenticationSuccess struct {
+	User string `xml:"user"`
+	/**
+	校验时传入 PgtUrl 时返回的 PGT IOU，可通过 CasProxyGrantingTicketStore 换取 PGT
+	*/
+	ProxyGrantingTicket string `xml:"proxyGrantingTicket"`
+	/**
+	校验代理票据时经过的代理列表，第一个为最近的代理
+	*/
+	Proxies    []string      `xml:"proxies>proxy"`
+	Attributes CasAttributes `xml:"attributes"`
+}
+
+type casServiceResponse struct {
+	XMLName               xml.Name                  `xml:"serviceResponse"`
+	AuthenticationSuccess *CasAuthenticationSuccess `xml:"authenticationSuccess"`
+	AuthenticationFailure *CasError                 `xml:"authenticationFailure"`
+	ProxySuccess          *struct {
+		ProxyTicket string `xml:"proxyTicket"`
+	} `xml:"proxySuccess"`
+	ProxyFailure *CasError `xml:"proxyFailure"`
+}
+
+type CasValidateParams struct {
+	Ticket  string
+	Service string
+	/**
+	CAS 协议版本，默认为 3.0（/p3/serviceValidate），3.0 会返回 cas:attributes
+	*/
+	Version CasVersionEnum
+	/**
+	接收 PGT 的回调地址，必须为 https，不为空时校验结果包含 PGT IOU
+	*/
+	PgtUrl string
+	/**
+	是否要求票据由用户重新输入凭据签发
+	*/
+	Renew bool
+}
+
+func (client *AuthenticationClient) casUrl(path string) string {
+	return fmt.Sprintf("%s/cas-idp/%s%s", client.options.AppHost, client.options.AppId, path)
+}
+
+// ValidateServiceTicket 校验 CAS 服务票据（ST），解析 XML 格式的 cas:serviceResponse，校验失败时返回 *CasError
+func (client *AuthenticationClient) ValidateServiceTicket(ctx context.Context, params *CasValidateParams) (*CasAuthenticationSuccess, error) {
+	return client.validateCasTicket(ctx, "/serviceValidate", params)
+}
+
+// ValidateProxyTicket 校验 CAS 代理票据（PT），也可用于校验服务票据，结果中的 Proxies 为经过的代理列表
+func (client *AuthenticationClient) ValidateProxyTicket(ctx context.Context, params *CasValidateParams) (*CasAuthenticationSuccess, error) {
+	return client.validateCasTicket(ctx, "/proxyValidate", params)
+}
+
+func (client *AuthenticationClient) validateCasTicket(ctx context.Context, path string, params *CasValidateParams) (*CasAuthenticationSuccess, error) {
+	if params == nil || params.Ticket == "" || params.Service == "" {
+		return nil, errors.New("ticket 与 service 不能为空")
+	}
+	if params.Version != CasV2 {
+		path = "/p3" + path
+	}
+	query := map[string]string{
+		"ticket":  params.Ticket,
+		"service": params.Service,
+		"pgtUrl":  params.PgtUrl,
+	}
+	if params.Renew {
+		query["renew"] = "true"
+	}
+	response, err := client.sendCasRequest(ctx, path, query)
+	if err != nil {
+		return nil, err
+	}
+	if response.AuthenticationFailure != nil {
+		return nil, response.AuthenticationFailure
+	}
+	if response.AuthenticationSuccess == nil {
+		return nil, errors.New("CAS 响应缺少 authenticationSuccess")
+	}
+	return response.AuthenticationSuccess, nil
+}
+
+// RequestProxyTicket 使用 PGT 为 targetService 申请代理票据（PT），失败时返回 *CasError
+func (client *AuthenticationClient) RequestProxyTicket(ctx context.Context, pgt, targetService string) (string, error) {
+	response, err := client.sendCasRequest(ctx, "/proxy", map[string]string{
+		"pgt":           pgt,
+		"targetService": targetService,
+	})
+	if err != nil {
+		return "", err
+	}
+	if response.ProxyFailure != nil {
+		return "", response.ProxyFailure
+	}
+	if response.ProxySuccess == nil || response.ProxySuccess.ProxyTicket == "" {
+		return "", errors.New("CAS 响应缺少 proxyTicket")
+	}
+	return response.ProxySuccess.ProxyTicket, nil
+}
+
+func (client *AuthenticationClient) sendCasRequest(ctx context.Context, path string, query map[string]string) (*casServiceResponse, error) {
+	res, err := client.SendProtocolHttpRequestWithContext(ctx, &ProtocolRequestOption{
+		Url:     client.casUrl(path),
+		Method:  fasthttp.MethodGet,
+		Headers: client.getReqHeaders(nil),
+		ReqDto:  query,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != 200 {
+		return nil, fmt.Errorf("CAS 请求失败[%d]:%s", res.StatusCode, res.Body)
+	}
+	var response casServiceResponse
+	if err = xml.Unmarshal(res.Body, &response); err != nil {
+		return nil, fmt.Errorf("无法解析 CAS 响应: %w", err)
+	}
+	return &response, nil
+}
+
+// CasProxyGrantingTicketStore 保存 pgtUrl 回调收到的 PGT IOU 与 PGT 的对应关系
+type CasProxyGrantingTicketStore interface {
+	Save(pgtIou, pgt string)
+	// Load 返回 PGT IOU 对应的 PGT，读取后删除
+	Load(pgtIou string) (string, bool)
+}
+
+type casProxyGrantingTicket struct {
+	pgt       string
+	expiresAt time.Time
+}
+
+// CasMemoryProxyGrantingTicketStore 保存在内存中的 CasProxyGrantingTicketStore，仅适用于单实例部署。
+// PGT 保存 5 分钟，最多保存 10000 个，已满时淘汰最早保存的 PGT
+type CasMemoryProxyGrantingTicketStore struct {
+	mutex   sync.Mutex
+	tickets map[string]casProxyGrantingTicket
+	maxSize int
+}
+
+func NewCasMemoryProxyGrantingTicketStore() *CasMemoryProxyGrantingTicketStore {
+	return &CasMemoryProxyGrantingTicketStore{
+		tickets: make(map[string]casProxyGrantingTicket),
+		maxSize: casProxyGrantingTicketMaxSize,
+	}
+}
+
+func (store *CasMemoryProxyGrantingTicketStore) Save(pgtIou, pgt string) {
+	now := time.Now()
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	var oldestIou string
+	var oldest time.Time
+	for iou, ticket := range store.tickets {
+		if !now.Before(ticket.expiresAt) {
+			delete(store.tickets, iou)
+		} else if oldestIou == "" || ticket.expiresAt.Before(oldest) {
+			oldestIou, oldest = iou, ticket.expiresAt
+		}
+	}
+	if _, ok := store.tickets[pgtIou]; !ok && len(store.tickets) >= store.maxSize {
+		delete(store.tickets, oldestIou)
+	}
+	store.tickets[pgtIou] = casProxyGrantingTicket{pgt: pgt, expiresAt: now.Add(casProxyGrantingTicketTTL)}
+}
+
+func (store *CasMemoryProxyGrantingTicketStore) Load(pgtIou string) (string, bool) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	ticket, ok := store.tickets[pgtIou]
+	delete(store.tickets, pgtIou)
+	if !ok || !time.Now().Before(ticket.expiresAt) {
+		return "", false
+	}
+	return ticket.pgt, true
+}
+
+// CasProxyCallbackHandler 处理 CAS 服务端对 pgtUrl 的回调，将 pgtIou 与 pgtId 保存到 store。
+// CAS 协议要求 pgtUrl 使用 HTTPS，非 HTTPS 请求（含反向代理通过 X-Forwarded-Proto 声明的协议）返回 403
+func CasProxyCallbackHandler(store CasProxyGrantingTicketStore) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requestScheme(r.TLS != nil, r.Header.Get("X-Forwarded-Proto")) != "https" {
+			http.Error(w, "pgtUrl 必须使用 HTTPS", http.StatusForbidden)
+			return
+		}
+		query := r.URL.Query()
+		if pgtIou, pgt := query.Get("pgtIou"), query.Get("pgtId"); pgtIou != "" && pgt != "" {
+			store.Save(pgtIou, pgt)
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+type casUserContextKey struct{}
+
+// CasUserFromContext 返回 CasFilter 校验票据后放入请求 context 的结果
+func CasUserFromContext(ctx context.Context) (*CasAuthenticationSuccess, bool) {
+	success, ok := ctx.Value(casUserContextKey{}).(*CasAuthenticationSuccess)
+	return success, ok
+}
+
+type CasFilterOptions struct {
+	/**
+	必填，固定的 service 地址，需与 CAS 应用配置的回调地址一致。
+	不使用请求的 Host 与 X-Forwarded-Proto 生成，避免客户端指定票据校验时的 service
+	*/
+	Service string
+	/**
+	CAS 协议版本，默认为 3.0
+	*/
+	Version CasVersionEnum
+	/**
+	是否要求用户重新输入凭据
+	*/
+	Renew bool
+	/**
+	判断请求是否已登录，已登录时直接放行；为空时每个不带 ticket 的请求都会重定向到 CAS 登录
+	*/
+	Authenticated func(r *http.Request) bool
+	/**
+	票据校验成功后调用，可用于保存会话；返回错误时交给 OnError 处理
+	*/
+	OnAuthenticated func(w http.ResponseWriter, r *http.Request, success *CasAuthenticationSuccess) error
+	/**
+	票据校验失败时调用，默认返回 401
+	*/
+	OnError func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// CasFilter 返回 net/http 中间件：未登录时重定向到 BuildAuthorizeUrlByCas，
+// 回到 service 地址时校验 ticket，成功后将结果放入请求 context 并继续处理请求。
+// options.Service 为空时所有请求都交给 OnError 处理，错误为 ErrCasServiceRequired
+func (client *AuthenticationClient) CasFilter(options *CasFilterOptions) func(http.Handler) http.Handler {
+	if options == nil {
+		options = &CasFilterOptions{}
+	}
+	onError := options.OnError
+	if onError == nil {
+		onError = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		}
+	}
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if options.Authenticated != nil && options.Authenticated(r) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			service := options.Service
+			if service == "" {
+				onError(w, r, ErrCasServiceRequired)
+				return
+			}
+			ticket := r.URL.Query().Get("ticket")
+			if ticket == "" {
+				redirectUrl := client.BuildAuthorizeUrlByCas(stringPtr(url.QueryEscape(service)))
+				if options.Renew {
+					redirectUrl += "&renew=true"
+				}
+				http.Redirect(w, r, redirectUrl, http.StatusFound)
+				return
+			}
+			success, err := client.ValidateServiceTicket(r.Context(), &CasValidateParams{
+				Ticket:  ticket,
+				Service: service,
+				Version: options.Version,
+				Renew:   options.Renew,
+			})
+			if err == nil && options.OnAuthenticated != nil {
+				err = options.OnAuthenticated(w, r, success)
+			}
+			if err != nil {
+				onError(w, r, err)
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), casUserContextKey{}, success)))
+		})
+	}
+}
+
+func stringPtr(value string) *string {
+	return &value
+}
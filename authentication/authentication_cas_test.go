@@ -0,0 +1,184 @@
+package authentication
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+const casSuccessResponse = `<cas:serviceResponse xmlns:cas="http://www.yale.edu/tp/cas">
+  <cas:authenticationSuccess>
+    <cas:user>alice</cas:user>
+    <cas:proxyGrantingTicket>PGTIOU-1</cas:proxyGrantingTicket>
+    <cas:proxies><cas:proxy>https://proxy.example.com/cb</cas:proxy></cas:proxies>
+    <cas:attributes>
+      <cas:email>alice@example.com</cas:email>
+      <cas:role>admin</cas:role>
+      <cas:role>dev</cas:role>
+      <cas:attribute name="tenant" value="t-1"/>
+    </cas:attributes>
+  </cas:authenticationSuccess>
+</cas:serviceResponse>`
+
+const casFailureResponse = `<cas:serviceResponse xmlns:cas="http://www.yale.edu/tp/cas">
+  <cas:authenticationFailure code="INVALID_TICKET">
+    Ticket ST-1 not recognized
+  </cas:authenticationFailure>
+</cas:serviceResponse>`
+
+// serveCas 模拟 CAS 服务端的票据校验与代理接口
+func serveCas(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	switch {
+	case r.URL.Path == "/cas-idp/app/proxy" && query.Get("pgt") == "PGT-1":
+		w.Write([]byte(`<cas:serviceResponse xmlns:cas="http://www.yale.edu/tp/cas"><cas:proxySuccess><cas:proxyTicket>PT-1</cas:proxyTicket></cas:proxySuccess></cas:serviceResponse>`))
+	case r.URL.Path == "/cas-idp/app/proxy":
+		w.Write([]byte(`<cas:serviceResponse xmlns:cas="http://www.yale.edu/tp/cas"><cas:proxyFailure code="INVALID_TICKET">pgt expired</cas:proxyFailure></cas:serviceResponse>`))
+	case query.Get("ticket") == "ST-ok":
+		w.Write([]byte(casSuccessResponse))
+	default:
+		w.Write([]byte(casFailureResponse))
+	}
+}
+
+func TestValidateServiceTicket(t *testing.T) {
+	server := newStubServer(serveCas)
+	defer server.Close()
+	client := newTestClient(t, server.URL, withoutSecret)
+	ctx := context.Background()
+
+	success, err := client.ValidateServiceTicket(ctx, &CasValidateParams{Ticket: "ST-ok", Service: "https://app.example.com/?a=1", PgtUrl: "https://app.example.com/pgt"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if success.User != "alice" || success.ProxyGrantingTicket != "PGTIOU-1" || len(success.Proxies) != 1 ||
+		success.Attributes.Get("email") != "alice@example.com" || len(success.Attributes["role"]) != 2 || success.Attributes.Get("tenant") != "t-1" {
+		t.Fatalf("校验结果不符合预期: %+v", success)
+	}
+	if query := server.requests("")[0].Query; query.Get("service") != "https://app.example.com/?a=1" || query.Get("pgtUrl") != "https://app.example.com/pgt" {
+		t.Fatalf("校验参数不符合预期: %v", query)
+	}
+
+	_, err = client.ValidateProxyTicket(ctx, &CasValidateParams{Ticket: "PT-bad", Service: "https://app.example.com/", Version: CasV2})
+	var casError *CasError
+	if !errors.As(err, &casError) || casError.Code != "INVALID_TICKET" {
+		t.Fatalf("校验失败时应返回 CasError: %v", err)
+	}
+}
+
+func TestRequestProxyTicket(t *testing.T) {
+	server := newStubServer(serveCas)
+	defer server.Close()
+	client := newTestClient(t, server.URL, withoutSecret)
+
+	store := NewCasMemoryProxyGrantingTicketStore()
+	callback := httptest.NewRecorder()
+	CasProxyCallbackHandler(store).ServeHTTP(callback, httptest.NewRequest(http.MethodGet, "https://app.example.com/pgt?pgtIou=PGTIOU-1&pgtId=PGT-1", nil))
+	pgt, ok := store.Load("PGTIOU-1")
+	if callback.Code != http.StatusOK || !ok || pgt != "PGT-1" {
+		t.Fatalf("回调未保存 PGT: %d %s", callback.Code, pgt)
+	}
+	if _, ok = store.Load("PGTIOU-1"); ok {
+		t.Fatal("PGT 读取后应删除")
+	}
+	callback = httptest.NewRecorder()
+	CasProxyCallbackHandler(store).ServeHTTP(callback, httptest.NewRequest(http.MethodGet, "http://app.example.com/pgt?pgtIou=PGTIOU-2&pgtId=PGT-2", nil))
+	if _, ok = store.Load("PGTIOU-2"); callback.Code != http.StatusForbidden || ok {
+		t.Fatalf("非 HTTPS 回调不应保存 PGT: %d", callback.Code)
+	}
+
+	ticket, err := client.RequestProxyTicket(context.Background(), pgt, "https://backend.example.com/")
+	if err != nil || ticket != "PT-1" || server.requests("")[0].Query.Get("targetService") != "https://backend.example.com/" {
+		t.Fatalf("申请代理票据失败: %s %v", ticket, err)
+	}
+	_, err = client.RequestProxyTicket(context.Background(), "PGT-expired", "https://backend.example.com/")
+	var casError *CasError
+	if !errors.As(err, &casError) || casError.Code != "INVALID_TICKET" {
+		t.Fatalf("申请失败时应返回 CasError: %v", err)
+	}
+}
+
+func TestCasFilter(t *testing.T) {
+	server := newStubServer(serveCas)
+	defer server.Close()
+	client := newTestClient(t, server.URL, withoutSecret)
+	handler := client.CasFilter(&CasFilterOptions{Service: "https://app.example.com/page?b=2"})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		success, _ := CasUserFromContext(r.Context())
+		w.Write([]byte(success.User))
+	}))
+
+	w := httptest.NewRecorder()
+	forged := httptest.NewRequest(http.MethodGet, "http://app.example.com/page?b=2", nil)
+	forged.Host = "evil.example.com"
+	handler.ServeHTTP(w, forged)
+	location := w.Header().Get("Location")
+	if w.Code != http.StatusFound || !strings.HasPrefix(location, server.URL+"/cas-idp/app?service=") {
+		t.Fatalf("未登录时应重定向到 CAS 登录: %d %s", w.Code, location)
+	}
+	redirect, _ := url.Parse(location)
+	if service := redirect.Query().Get("service"); service != "https://app.example.com/page?b=2" {
+		t.Fatalf("service 应使用固定值而不是请求的 Host: %s", service)
+	}
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "http://app.example.com/page?b=2&ticket=ST-ok", nil))
+	if w.Code != http.StatusOK || w.Body.String() != "alice" {
+		t.Fatalf("票据校验成功后应放行: %d %s", w.Code, w.Body.String())
+	}
+	if service := server.requests("")[0].Query.Get("service"); service != "https://app.example.com/page?b=2" {
+		t.Fatalf("校验时应使用固定的 service: %s", service)
+	}
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "http://app.example.com/page?ticket=ST-bad", nil))
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("票据无效时应返回 401: %d", w.Code)
+	}
+}
+
+func TestCasFilter_RequiresService(t *testing.T) {
+	client := newTestClient(t, "https://example.authing.cn", withoutSecret)
+	var filterErr error
+	handler := client.CasFilter(&CasFilterOptions{
+		OnError: func(w http.ResponseWriter, r *http.Request, err error) {
+			filterErr = err
+			w.WriteHeader(http.StatusInternalServerError)
+		},
+	})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "http://app.example.com/page", nil))
+	if w.Code != http.StatusInternalServerError || !errors.Is(filterErr, ErrCasServiceRequired) {
+		t.Fatalf("未设置 Service 时应返回 ErrCasServiceRequired: %d %v", w.Code, filterErr)
+	}
+}
+
+func TestCasMemoryProxyGrantingTicketStore_MaxSize(t *testing.T) {
+	store := NewCasMemoryProxyGrantingTicketStore()
+	store.maxSize = 2
+	store.Save("PGTIOU-1", "PGT-1")
+	time.Sleep(time.Millisecond)
+	store.Save("PGTIOU-2", "PGT-2")
+	store.Save("PGTIOU-3", "PGT-3")
+	if len(store.tickets) != 2 {
+		t.Fatalf("PGT 数量不应超过上限, 实际 %d", len(store.tickets))
+	}
+	if _, ok := store.Load("PGTIOU-1"); ok {
+		t.Fatal("应淘汰最早保存的 PGT")
+	}
+}
+
+func TestValidateTicketV1_ShortResponse(t *testing.T) {
+	server := newStubServer(stubResponse(http.StatusOK, "no"))
+	defer server.Close()
+	client := newTestClient(t, server.URL, withoutSecret)
+
+	resp, err := client.ValidateTicketV1("ST-1", "https://app.example.com/")
+	if err != nil || resp.Valid || resp.Username != "" {
+		t.Fatalf("响应不完整时应返回无效结果: %+v %v", resp, err)
+	}
+}
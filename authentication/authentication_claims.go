@@ -0,0 +1,123 @@
+package authentication
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/valyala/fasthttp"
+)
+
+func (userInfo *UserInfo) UnmarshalJSON(data []byte) error {
+	type plain UserInfo
+	if err := json.Unmarshal(data, (*plain)(userInfo)); err != nil {
+		return err
+	}
+	return json.Unmarshal(data, &userInfo.Claims)
+}
+
+func (claims *IDTokenClaims) UnmarshalJSON(data []byte) error {
+	type plain IDTokenClaims
+	if err := json.Unmarshal(data, (*plain)(claims)); err != nil {
+		return err
+	}
+	return json.Unmarshal(data, &claims.Claims)
+}
+
+// GetUserInfoInto 获取用户信息并解析到调用方定义的结构体 v 中，用于读取自定义 claims
+func (client *AuthenticationClient) GetUserInfoInto(accessToken string, v interface{}) error {
+	return client.GetUserInfoIntoWithContext(context.Background(), accessToken, v)
+}
+
+// GetUserInfoIntoWithContext 同 GetUserInfoInto，ctx 用于取消请求或控制请求截止时间
+func (client *AuthenticationClient) GetUserInfoIntoWithContext(ctx context.Context, accessToken string, v interface{}) error {
+	body, err := client.fetchUserInfo(ctx, accessToken)
+	if err != nil {
+		return err
+	}
+	if err = json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("无法解析用户信息:%w", err)
+	}
+	return nil
+}
+
+// ParseIDTokenInto 校验 id token 并将其中的 claims 解析到调用方定义的结构体 v 中，校验规则同 ParseIDToken
+func (client *AuthenticationClient) ParseIDTokenInto(tokenStr string, v interface{}) error {
+	return client.ParseIDTokenIntoWithContext(context.Background(), tokenStr, v)
+}
+
+// ParseIDTokenIntoWithContext 同 ParseIDTokenInto，ctx 用于控制获取 JWKS 的请求
+func (client *AuthenticationClient) ParseIDTokenIntoWithContext(ctx context.Context, tokenStr string, v interface{}) error {
+	if _, err := client.ParseIDTokenWithContext(ctx, tokenStr); err != nil {
+		return err
+	}
+	payload, err := jwtPayload(tokenStr)
+	if err != nil {
+		return err
+	}
+	if err = json.Unmarshal(payload, v); err != nil {
+		return fmt.Errorf("无法解析 id token claims: %w", err)
+	}
+	return nil
+}
+
+// fetchUserInfo 请求用户信息端点，返回 JSON 格式的 claims；响应为签名的 JWT（application/jwt）时校验签名、iss 与 aud 后返回其 payload
+func (client *AuthenticationClient) fetchUserInfo(ctx context.Context, accessToken string) ([]byte, error) {
+	endpoints, err := client.endpoints(ctx, OIDC)
+	if err != nil {
+		return nil, err
+	}
+	scheme := "Bearer "
+	if client.dpop != nil {
+		scheme = "DPoP "
+	}
+	res, err := client.sendWithDPoP(ctx, accessToken, func() (*ProtocolRequestOption, error) {
+		return &ProtocolRequestOption{
+			Method: fasthttp.MethodPost,
+			Url:    endpoints.Userinfo,
+			Headers: client.getReqHeaders(map[string]string{
+				"Authorization": scheme + accessToken,
+			}),
+		}, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("根据 access token 获取用户信息时失败: %w", err)
+	}
+	if res.StatusCode != 200 {
+		return nil, fmt.Errorf("根据 access token 获取用户信息失败[%d]:%s", res.StatusCode, res.Body)
+	}
+	if res.Header == nil {
+		return res.Body, nil
+	}
+	mediaType, _, _ := mime.ParseMediaType(string(res.Header.ContentType()))
+	if mediaType != "application/jwt" {
+		return res.Body, nil
+	}
+	tokenStr := strings.TrimSpace(string(res.Body))
+	parser := jwt.NewParser(jwt.WithIssuer(endpoints.Issuer), jwt.WithAudience(client.options.AppId))
+	token, err := parser.Parse(tokenStr, client.getKey4AccessToken(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("签名的用户信息校验失败: %w", err)
+	}
+	if !token.Valid {
+		return nil, errors.New("签名的用户信息非法")
+	}
+	return jwtPayload(tokenStr)
+}
+
+// jwtPayload 返回 JWT payload 部分解码后的 JSON，调用前需已校验签名
+func jwtPayload(tokenStr string) ([]byte, error) {
+	parts := strings.Split(tokenStr, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("token 格式错误")
+	}
+	payload, err := jwt.NewParser().DecodeSegment(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("token 格式错误: %w", err)
+	}
+	return payload, nil
+}
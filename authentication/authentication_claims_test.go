@@ -0,0 +1,96 @@
+package authentication
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+type testCustomClaims struct {
+	Subject  string   `json:"sub"`
+	TenantId string   `json:"tenant_id"`
+	Roles    []string `json:"roles"`
+}
+
+// serveUserInfo 以 contentType 返回用户信息，body 的参数为服务器的 issuer
+func serveUserInfo(contentType string, body func(issuer string) string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", contentType)
+		w.Write([]byte(body("http://" + r.Host + "/oidc")))
+	}
+}
+
+func TestGetUserInfo_CustomClaims(t *testing.T) {
+	server := newStubServer(serveUserInfo("application/json", func(string) string {
+		return `{"sub":"user-1","email":"a@example.com","email_verified":true,"tenant_id":"t-1","roles":["admin"]}`
+	}))
+	defer server.Close()
+	client := newTestClient(t, server.URL)
+
+	userInfo, err := client.GetUserInfo("at")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if userInfo.Subject != "user-1" || !userInfo.EmailVerified || userInfo.Claims["tenant_id"] != "t-1" || userInfo.Claims["email"] != "a@example.com" {
+		t.Fatalf("自定义 claims 未保留: %+v", userInfo)
+	}
+	var custom testCustomClaims
+	if err = client.GetUserInfoInto("at", &custom); err != nil {
+		t.Fatal(err)
+	}
+	if custom.Subject != "user-1" || custom.TenantId != "t-1" || len(custom.Roles) != 1 || custom.Roles[0] != "admin" {
+		t.Fatalf("解析到自定义结构体失败: %+v", custom)
+	}
+}
+
+func TestGetUserInfo_SignedResponse(t *testing.T) {
+	sign := func(audience string) func(issuer string) string {
+		return func(issuer string) string {
+			token, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+				"iss":       issuer,
+				"aud":       audience,
+				"sub":       "user-1",
+				"tenant_id": "t-1",
+			}).SignedString([]byte("secret"))
+			return token
+		}
+	}
+	server := newStubServer(serveUserInfo("application/jwt; charset=utf-8", sign("app")))
+	defer server.Close()
+	client := newTestClient(t, server.URL)
+
+	userInfo, err := client.GetUserInfo("at")
+	if err != nil || userInfo.Subject != "user-1" || userInfo.Claims["tenant_id"] != "t-1" {
+		t.Fatalf("签名的用户信息解析失败: %+v %v", userInfo, err)
+	}
+
+	other := newStubServer(serveUserInfo("application/jwt", sign("other")))
+	defer other.Close()
+	client = newTestClient(t, other.URL)
+	if _, err = client.GetUserInfo("at"); err == nil {
+		t.Fatal("受众不匹配时应校验失败")
+	}
+}
+
+func TestParseIDTokenInto(t *testing.T) {
+	client := newTestClient(t, "https://example.authing.cn")
+	token := signTestIDToken(t, jwt.MapClaims{"tenant_id": "t-1", "roles": []string{"admin"}})
+
+	claims, err := client.ParseIDToken(token)
+	if err != nil || claims.Claims["tenant_id"] != "t-1" || claims.Nonce != "nonce-1" {
+		t.Fatalf("自定义 claims 未保留: %+v %v", claims, err)
+	}
+	var custom testCustomClaims
+	if err = client.ParseIDTokenInto(token, &custom); err != nil {
+		t.Fatal(err)
+	}
+	if custom.Subject != "user-1" || custom.TenantId != "t-1" || len(custom.Roles) != 1 {
+		t.Fatalf("解析到自定义结构体失败: %+v", custom)
+	}
+	expired := signTestIDToken(t, jwt.MapClaims{"exp": time.Now().Add(-time.Hour).Unix()})
+	if err = client.ParseIDTokenInto(expired, &custom); err == nil {
+		t.Fatal("过期的 id token 应校验失败")
+	}
+}
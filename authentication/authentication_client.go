@@ -1,17 +1,18 @@
 package authentication
 
 import (
-	"encoding/base64"
+	"context"
+	"crypto"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"log"
 	"strings"
 	"time"
 
 	"github.com/Authing/authing-golang-sdk/v3/constant"
 	"github.com/Authing/authing-golang-sdk/v3/dto"
 	"github.com/Authing/authing-golang-sdk/v3/util"
-	"github.com/MicahParks/keyfunc/v2"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/valyala/fasthttp"
 )
@@ -26,17 +27,23 @@ var commonHeaders = map[string]string{
 }
 
 type AuthenticationClient struct {
-	httpClient *fasthttp.Client
-	options    *AuthenticationClientOptions
-	jwks       *keyfunc.JWKS
-	eventHub   *util.WebSocketEventHub
+	httpClient   *fasthttp.Client
+	options      *AuthenticationClientOptions
+	keySet       *KeySet
+	eventHub     *util.WebSocketEventHub
+	interceptors []util.Interceptor
+	discovery    *discoveryCache
+	// private_key_jwt 使用的私钥与签名算法
+	privateKey       crypto.Signer
+	privateKeyMethod jwt.SigningMethod
+	dpop             *dpopSigner
 }
 
 func NewAuthenticationClient(options *AuthenticationClientOptions) (*AuthenticationClient, error) {
 	if options.AppId == "" {
 		return nil, errors.New("AppId 不能为空")
 	}
-	if options.AppSecret == "" {
+	if options.AppSecret == "" && options.TokenEndPointAuthMethod != None && options.TokenEndPointAuthMethod != PrivateKeyJwt {
 		return nil, errors.New("AppSecret 不能为空")
 	}
 	if options.AppHost == "" {
@@ -63,12 +70,42 @@ func NewAuthenticationClient(options *AuthenticationClientOptions) (*Authenticat
 	if options.TokenEndPointAuthMethod == "" {
 		options.TokenEndPointAuthMethod = ClientSecretPost
 	}
+	if options.RetryPolicy == nil {
+		options.RetryPolicy = util.DefaultRetryPolicy()
+	}
+	if options.DiscoveryCacheTTL == 0 {
+		options.DiscoveryCacheTTL = 24 * time.Hour
+	}
 
 	client := &AuthenticationClient{
-		options:  options,
-		eventHub: util.NewWebSocketEvent(),
+		options:   options,
+		eventHub:  util.NewWebSocketEvent(),
+		discovery: &discoveryCache{},
+	}
+	if options.PrivateKey != "" {
+		privateKey, method, err := parseClientAssertionKey(options.PrivateKey)
+		if err != nil {
+			return nil, err
+		}
+		client.privateKey, client.privateKeyMethod = privateKey, method
+	} else if options.TokenEndPointAuthMethod == PrivateKeyJwt {
+		return nil, errors.New("TokenEndPointAuthMethod 为 private_key_jwt 时 PrivateKey 不能为空")
+	}
+	if options.EnableDPoP {
+		dpop, err := newDPoPSigner(options.DPoPPrivateKey)
+		if err != nil {
+			return nil, err
+		}
+		client.dpop = dpop
 	}
 	client.httpClient = client.createHttpClient()
+	client.keySet = options.KeySet
+	if client.keySet == nil {
+		client.keySet = newKeySet(client.fetchJWKS, options.KeySetOptions)
+	} else if client.keySet.fetch != nil {
+		// NewRemoteKeySet 未指定 HttpClient 时使用本 client 的 HTTP 配置，私有化部署的自签名证书等配置同样生效
+		client.keySet.httpTransport(client.httpClient, options.ReadTimeout)
+	}
 
 	return client, nil
 }
@@ -82,9 +119,21 @@ func (client *AuthenticationClient) getUrl(path string) string {
 }
 
 func (client *AuthenticationClient) BuildAuthorizeUrlByOidc(params *OIDCAuthURLParams) (AuthUrlResult, error) {
+	return client.BuildAuthorizeUrlByOidcWithContext(context.Background(), params)
+}
+
+// BuildAuthorizeUrlByOidcWithContext 同 BuildAuthorizeUrlByOidc，ctx 用于控制服务发现与推送授权请求（PAR）的请求
+func (client *AuthenticationClient) BuildAuthorizeUrlByOidcWithContext(ctx context.Context, params *OIDCAuthURLParams) (AuthUrlResult, error) {
 	if params == nil {
 		params = &OIDCAuthURLParams{}
 	}
+	endpoints, err := client.endpoints(ctx, OIDC)
+	if err != nil {
+		return AuthUrlResult{}, err
+	}
+	if err = client.checkCodeChallengeMethod(ctx, params.CodeChallengeMethod); err != nil {
+		return AuthUrlResult{}, err
+	}
 
 	// scope
 	scope := params.Scope
@@ -101,26 +150,57 @@ func (client *AuthenticationClient) BuildAuthorizeUrlByOidc(params *OIDCAuthURLP
 	if nonce == "" {
 		nonce = util.RandStringImpr(RandStringLen)
 	}
+	codeVerifier, codeChallenge, err := buildCodeChallenge(params.CodeChallengeMethod, params.CodeVerifier)
+	if err != nil {
+		return AuthUrlResult{}, err
+	}
 	redirectUri := params.RedirectUri
 	if redirectUri == "" {
 		redirectUri = client.options.RedirectUri
 	}
+	responseType := params.ResponseType
+	if responseType == "" {
+		responseType = "code"
+	}
 	responseMode := params.ResponseMode
 	if responseMode == "" {
 		responseMode = "query"
 	}
 
-	dataMap := map[string]interface{}{
-		"redirect_uri":  redirectUri,
-		"client_id":     client.options.AppId,
-		"response_mode": "query",
-		"response_type": "code",
-		"scope":         scope,
-		"nonce":         nonce,
-		"state":         state,
+	dataMap := map[string]interface{}{}
+	// 自定义参数优先级最低，不会覆盖下方的标准参数
+	for key, value := range params.ExtraParams {
+		dataMap[key] = value
+	}
+	dataMap["redirect_uri"] = redirectUri
+	dataMap["client_id"] = client.options.AppId
+	dataMap["response_mode"] = responseMode
+	dataMap["response_type"] = responseType
+	dataMap["scope"] = scope
+	dataMap["nonce"] = nonce
+	dataMap["state"] = state
+	if codeChallenge != "" {
+		dataMap["code_challenge"] = codeChallenge
+		dataMap["code_challenge_method"] = string(params.CodeChallengeMethod)
+	}
+	optionalParams := map[string]string{
+		"login_hint": params.LoginHint,
+		"ui_locales": params.UiLocales,
+		"acr_values": params.AcrValues,
+		"tenant_id":  params.TenantId,
+	}
+	for key, value := range optionalParams {
+		if value != "" {
+			dataMap[key] = value
+		}
+	}
+	if params.MaxAge != nil {
+		dataMap["max_age"] = *params.MaxAge
 	}
 
-	if params.Forced {
+	if params.Prompt != "" {
+		dataMap["prompt"] = params.Prompt
+	} else if params.Forced {
 		dataMap["prompt"] = "login"
 	} else {
 		arr := strings.Split(scope, " ")
@@ -132,22 +212,83 @@ func (client *AuthenticationClient) BuildAuthorizeUrlByOidc(params *OIDCAuthURLP
 			}
 		}
 	}
+	if params.SignedRequestObject {
+		requestObject, err := client.buildRequestObject(endpoints.Issuer, dataMap)
+		if err != nil {
+			return AuthUrlResult{}, err
+		}
+		dataMap = map[string]interface{}{
+			"client_id": client.options.AppId,
+			"request":   requestObject,
+		}
+	}
+	if params.PushedAuthorizationRequest {
+		requestUri, err := client.pushAuthorizationRequest(ctx, endpoints, dataMap)
+		if err != nil {
+			return AuthUrlResult{}, err
+		}
+		dataMap = map[string]interface{}{
+			"client_id":   client.options.AppId,
+			"request_uri": requestUri,
+		}
+	}
 	return AuthUrlResult{
-		State: state,
-		Nonce: nonce,
-		Url:   client.getUrl("/oidc/auth?") + util.GenQueryString(dataMap),
+		State:        state,
+		Nonce:        nonce,
+		CodeVerifier: codeVerifier,
+		Url:          endpoints.Authorization + "?" + util.GenQueryString(dataMap),
 	}, nil
 }
 
+// buildCodeChallenge 根据 PKCE 方式生成 code_verifier 与 code_challenge，method 为空时不使用 PKCE
+func buildCodeChallenge(method CodeChallengeMethodEnum, codeVerifier string) (string, string, error) {
+	switch method {
+	case "":
+		return "", "", nil
+	case CodeChallengeS256, CodeChallengePlain:
+	default:
+		return "", "", fmt.Errorf("不支持的 code_challenge_method: %s", method)
+	}
+	if codeVerifier == "" {
+		var err error
+		if codeVerifier, err = util.GenerateCodeVerifier(); err != nil {
+			return "", "", fmt.Errorf("生成 code_verifier 失败: %w", err)
+		}
+	}
+	return codeVerifier, util.CodeChallenge(codeVerifier, string(method)), nil
+}
+
 func (client *AuthenticationClient) BuildAuthorizeUrlByOauth(params *OAuth2AuthURLParams) (string, error) {
+	result, err := client.BuildAuthorizeResultByOauth(params)
+	return result.Url, err
+}
+
+// BuildAuthorizeResultByOauth 同 BuildAuthorizeUrlByOauth，同时返回 state 与 PKCE 的 code_verifier
+func (client *AuthenticationClient) BuildAuthorizeResultByOauth(params *OAuth2AuthURLParams) (AuthUrlResult, error) {
+	if params == nil {
+		params = &OAuth2AuthURLParams{}
+	}
+	codeVerifier, codeChallenge, err := buildCodeChallenge(params.CodeChallengeMethod, params.CodeVerifier)
+	if err != nil {
+		return AuthUrlResult{}, err
+	}
+	state := util.GetValueOrDefault(params.State, util.RandomString(12))
 	dataMap := map[string]string{
 		"client_id":     util.GetValueOrDefault(client.options.AppId),
 		"scope":         util.GetValueOrDefault(params.Scope, "openid profile email phone address"),
-		"state":         util.GetValueOrDefault(params.State, util.RandomString(12)),
+		"state":         state,
 		"response_type": util.GetValueOrDefault(params.ResponseType, "code"),
 		"redirect_uri":  util.GetValueOrDefault(params.RedirectUri, client.options.RedirectUri),
 	}
-	return client.getUrl("/oauth/auth?") + util.GetQueryString(dataMap), nil
+	if codeChallenge != "" {
+		dataMap["code_challenge"] = codeChallenge
+		dataMap["code_challenge_method"] = string(params.CodeChallengeMethod)
+	}
+	return AuthUrlResult{
+		State:        state,
+		CodeVerifier: codeVerifier,
+		Url:          client.getUrl("/oauth/auth?") + util.GetQueryString(dataMap),
+	}, nil
 }
 
 func (client *AuthenticationClient) BuildAuthorizeUrlBySaml() string {
@@ -184,7 +325,9 @@ func (client *AuthenticationClient) ValidateTicketV1(ticket, service string) (*s
 	var username, message string
 
 	valid := (sps[0] == "yes")
-	username = sps[1]
+	if valid && len(sps) > 1 {
+		username = sps[1]
+	}
 	if !valid {
 		message = "ticket is not valid"
 	}
@@ -202,6 +345,8 @@ func (client *AuthenticationClient) ValidateTicketV1(ticket, service string) (*s
 }
 
 // 通过远端服务验证票据合法性
+//
+// Deprecated: 该方法按 JSON 解析响应，无法处理标准 CAS 2.0/3.0 的 XML 响应，请使用 ValidateServiceTicket
 func (client *AuthenticationClient) ValidateTicketV2(ticket, service string, format string) (*struct {
 	Code    int64       `json:"code"`
 	Message string      `json:"message"`
@@ -235,203 +380,267 @@ func (client *AuthenticationClient) ValidateTicketV2(ticket, service string, for
 
 // GetAccessTokenByCode 使用 code 换取 accessToken
 func (client *AuthenticationClient) GetAccessTokenByCode(code string) (OIDCTokenResponse, error) {
-	url := client.options.AppHost + "/oidc/token"
-	header := map[string]string{
-		"Content-Type": "application/x-www-form-urlencoded",
+	return client.GetAccessTokenByCodeWithParams(&CodeToTokenParams{Code: code})
+}
+
+// GetAccessTokenByCodeWithParams 使用 code 换取 accessToken，支持传入 PKCE 的 code_verifier、
+// 本次授权使用的 redirect_uri，以及用于校验 id token 的 nonce
+func (client *AuthenticationClient) GetAccessTokenByCodeWithParams(params *CodeToTokenParams) (OIDCTokenResponse, error) {
+	tokenSet, err := client.GetAccessTokenByCodeWithContext(context.Background(), params)
+	var response OIDCTokenResponse
+	if tokenSet != nil {
+		response = OIDCTokenResponse{
+			AccessToken:  tokenSet.AccessToken,
+			IDToken:      tokenSet.IDToken,
+			RefreshToken: tokenSet.RefreshToken,
+			ExpiresIn:    uint64(tokenSet.ExpiresIn),
+			TokenType:    tokenSet.TokenType,
+		}
+	}
+	var oauthError *OAuthError
+	if errors.As(err, &oauthError) {
+		response.Error = oauthError.ErrorCode
+		response.ErrorDescription = oauthError.ErrorDescription
 	}
+	return response, err
+}
+
+// GetAccessTokenByCodeWithContext 使用 code 换取 TokenSet，ctx 用于取消请求或控制请求截止时间。
+// 服务端返回错误时返回 *OAuthError；params.Nonce 不为空时校验 id token，校验失败返回 *IDTokenError
+func (client *AuthenticationClient) GetAccessTokenByCodeWithContext(ctx context.Context, params *CodeToTokenParams) (*TokenSet, error) {
 	body := map[string]string{
-		"client_id":     client.options.AppId,
-		"client_secret": client.options.AppSecret,
-		"grant_type":    "authorization_code",
-		"code":          code,
-		"redirect_uri":  client.options.RedirectUri,
-	}
-
-	switch client.options.TokenEndPointAuthMethod {
-	case ClientSecretPost:
-		body["client_id"] = client.options.AppId
-		body["client_secret"] = client.options.AppSecret
-	case ClientSecretBasic:
-		base64String := "Basic " + base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s", client.options.AppId, client.options.AppSecret)))
-		header["Authorization"] = base64String
-	default:
-		body["client_id"] = client.options.AppId
+		"grant_type":   "authorization_code",
+		"code":         params.Code,
+		"redirect_uri": util.GetValueOrDefault(params.RedirectUri, client.options.RedirectUri),
 	}
-	resp, err := client.SendProtocolHttpRequest(&ProtocolRequestOption{
-		Url:     url,
-		Method:  fasthttp.MethodPost,
-		Headers: client.getReqHeaders(header),
-		ReqDto:  body,
-	})
+	if params.CodeVerifier != "" {
+		body["code_verifier"] = params.CodeVerifier
+	}
+	tokenSet, err := client.requestTokenSet(ctx, OIDC, client.options.TokenEndPointAuthMethod, body)
 	if err != nil {
-		return OIDCTokenResponse{}, err
+		return nil, err
+	}
+	if params.Nonce != "" && tokenSet.IDToken != "" {
+		_, err = client.ValidateIDTokenWithContext(ctx, tokenSet.IDToken, ValidationOptions{
+			Nonce:       params.Nonce,
+			AccessToken: tokenSet.AccessToken,
+		})
+		if err != nil {
+			return nil, err
+		}
 	}
-	var tokenResponse OIDCTokenResponse
-	err = json.Unmarshal(resp.Body, &tokenResponse)
-	return tokenResponse, err
+	return tokenSet, nil
 }
 
 // GetAccessTokenByClientCredentials
 // AuthenticationClient Credentials 模式获取 Access Token
 func (client *AuthenticationClient) GetAccessTokenByClientCredentials(req GetAccessTokenByClientCredentialsRequest) (string, error) {
-	if req.Scope == constant.StringEmpty {
-		return constant.StringEmpty, errors.New("请传入 scope 参数，请看文档：https://docs.authing.cn/v2/guides/authorization/m2m-authz.html")
-	}
-	if req.ClientCredentialInput == nil {
-		return constant.StringEmpty, errors.New("请在调用本方法时传入 ClientCredentialInput 参数，请看文档：https://docs.authing.cn/v2/guides/authorization/m2m-authz.html")
+	if err := checkClientCredentialsRequest(req); err != nil {
+		return constant.StringEmpty, err
 	}
+	body, err := client.requestToken(context.Background(), OIDC, "", clientCredentialsBody(req))
+	return string(body), err
+}
 
-	url := client.options.AppHost + "/oidc/token"
+// GetAccessTokenByClientCredentialsWithContext 同 GetAccessTokenByClientCredentials，返回 TokenSet，服务端返回错误时返回 *OAuthError
+func (client *AuthenticationClient) GetAccessTokenByClientCredentialsWithContext(ctx context.Context, req GetAccessTokenByClientCredentialsRequest) (*TokenSet, error) {
+	if err := checkClientCredentialsRequest(req); err != nil {
+		return nil, err
+	}
+	return client.requestTokenSet(ctx, OIDC, "", clientCredentialsBody(req))
+}
 
-	header := map[string]string{
-		"Content-Type": "application/x-www-form-urlencoded",
+func checkClientCredentialsRequest(req GetAccessTokenByClientCredentialsRequest) error {
+	if req.Scope == constant.StringEmpty {
+		return errors.New("请传入 scope 参数，请看文档：https://docs.authing.cn/v2/guides/authorization/m2m-authz.html")
+	}
+	if req.ClientCredentialInput == nil {
+		return errors.New("请在调用本方法时传入 ClientCredentialInput 参数，请看文档：https://docs.authing.cn/v2/guides/authorization/m2m-authz.html")
 	}
+	return nil
+}
 
-	body := map[string]string{
+func clientCredentialsBody(req GetAccessTokenByClientCredentialsRequest) map[string]string {
+	return map[string]string{
 		"client_id":     req.ClientCredentialInput.AccessKey,
 		"client_secret": req.ClientCredentialInput.SecretKey,
 		"grant_type":    "client_credentials",
 		"scope":         req.Scope,
 	}
-
-	resp, err := client.SendProtocolHttpRequest(&ProtocolRequestOption{
-		Url:     url,
-		Method:  fasthttp.MethodPost,
-		Headers: client.getReqHeaders(header),
-		ReqDto:  body,
-	})
-	if err != nil {
-		return "", err
-	}
-	return string(resp.Body), err
 }
 
 // GetNewAccessTokenByRefreshToken
 //
 //	使用 Refresh token 获取新的 Access token
 func (client *AuthenticationClient) GetNewAccessTokenByRefreshToken(refreshToken string) (string, error) {
-	if client.options.Protocol != OIDC && client.options.Protocol != OAUTH {
-		return constant.StringEmpty, errors.New("初始化 AuthenticationClient 时传入的 protocol 参数必须为 ProtocolEnum.OAUTH 或 ProtocolEnum.OIDC，请检查参数")
+	if err := client.checkTokenProtocol(); err != nil {
+		return constant.StringEmpty, err
 	}
-	if client.options.AppSecret == "" && client.options.TokenEndPointAuthMethod != constant.None {
-		return constant.StringEmpty, errors.New("请在初始化 AuthenticationClient 时传入 Secret")
-	}
-
-	url := client.options.AppHost + fmt.Sprintf("/%s/token", client.options.Protocol)
+	body, err := client.requestToken(context.Background(), client.options.Protocol, client.options.TokenEndPointAuthMethod, refreshTokenBody(refreshToken))
+	return string(body), err
+}
 
-	header := map[string]string{
-		"Content-Type": "application/x-www-form-urlencoded",
+// GetNewAccessTokenByRefreshTokenWithContext 同 GetNewAccessTokenByRefreshToken，返回 TokenSet，服务端返回错误时返回 *OAuthError。
+// 服务端未返回新的 refresh token 时，TokenSet.RefreshToken 沿用传入的 refreshToken
+func (client *AuthenticationClient) GetNewAccessTokenByRefreshTokenWithContext(ctx context.Context, refreshToken string) (*TokenSet, error) {
+	if err := client.checkTokenProtocol(); err != nil {
+		return nil, err
+	}
+	tokenSet, err := client.requestTokenSet(ctx, client.options.Protocol, client.options.TokenEndPointAuthMethod, refreshTokenBody(refreshToken))
+	if err != nil {
+		return nil, err
 	}
+	if tokenSet.RefreshToken == "" {
+		tokenSet.RefreshToken = refreshToken
+	}
+	return tokenSet, nil
+}
 
-	body := map[string]string{
-		"client_id":     client.options.AppId,
-		"client_secret": client.options.AppSecret,
+func refreshTokenBody(refreshToken string) map[string]string {
+	return map[string]string{
 		"grant_type":    "refresh_token",
 		"refresh_token": refreshToken,
 	}
+}
 
-	switch client.options.TokenEndPointAuthMethod {
-	case ClientSecretPost:
-		body["client_id"] = client.options.AppId
-		body["client_secret"] = client.options.AppSecret
-	case ClientSecretBasic:
-		base64String := "Basic " + base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s", client.options.AppId, client.options.AppSecret)))
-		header["Authorization"] = base64String
-	default:
-		body["client_id"] = client.options.AppId
+func (client *AuthenticationClient) checkTokenProtocol() error {
+	if client.options.Protocol != OIDC && client.options.Protocol != OAUTH {
+		return errors.New("初始化 AuthenticationClient 时传入的 protocol 参数必须为 ProtocolEnum.OAUTH 或 ProtocolEnum.OIDC，请检查参数")
 	}
-	resp, err := client.SendProtocolHttpRequest(&ProtocolRequestOption{
-		Url:     url,
-		Method:  fasthttp.MethodPost,
-		Headers: client.getReqHeaders(header),
-		ReqDto:  body,
-	})
+	return nil
+}
+
+func (client *AuthenticationClient) IntrospectToken(token string) (*dto.TokenIntrospectResponse, error) {
+	return client.IntrospectTokenWithContext(context.Background(), token)
+}
+
+// IntrospectTokenWithContext 同 IntrospectToken，ctx 用于取消请求或控制请求截止时间。
+// 检查端点返回错误时返回 *OAuthError，配置了 IntrospectionCache 时优先使用缓存结果
+func (client *AuthenticationClient) IntrospectTokenWithContext(ctx context.Context, token string) (*dto.TokenIntrospectResponse, error) {
+	cache := client.options.IntrospectionCache
+	if cache != nil {
+		if claims, found := cache.Get(token); found {
+			return introspectResponseOf(claims), nil
+		}
+	}
+	body, err := client.introspect(ctx, token)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+	var response dto.TokenIntrospectResponse
+	if err = json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("无法解析 token 检查结果: %w", err)
+	}
+	if cache != nil {
+		var claims *AccessTokenClaims
+		if response.Active {
+			claims = &AccessTokenClaims{}
+			if err = json.Unmarshal(body, claims); err != nil {
+				return nil, fmt.Errorf("无法解析 token 检查结果: %w", err)
+			}
+		}
+		cache.Set(token, claims)
 	}
-	return string(resp.Body), err
+	return &response, nil
 }
 
-func (client *AuthenticationClient) IntrospectToken(token string) (*dto.TokenIntrospectResponse, error) {
-	url := client.options.AppHost + fmt.Sprintf("/%s/token/introspection", client.options.Protocol)
+// introspectResponseOf 由缓存的 claims 生成检查结果，claims 为 nil 表示 token 无效
+func introspectResponseOf(claims *AccessTokenClaims) *dto.TokenIntrospectResponse {
+	if claims == nil {
+		return &dto.TokenIntrospectResponse{}
+	}
+	response := &dto.TokenIntrospectResponse{
+		Active:   true,
+		Sub:      claims.Subject,
+		ClientId: claims.ClientId,
+		Iss:      claims.Issuer,
+		Jti:      claims.ID,
+		Scope:    claims.Scope,
+	}
+	if claims.ExpiresAt != nil {
+		response.Exp = int(claims.ExpiresAt.Unix())
+	}
+	if claims.IssuedAt != nil {
+		response.Iat = int(claims.IssuedAt.Unix())
+	}
+	return response
+}
+
+// introspect 调用 token 检查端点，返回原始响应体；端点返回错误时返回 *OAuthError
+func (client *AuthenticationClient) introspect(ctx context.Context, token string) ([]byte, error) {
+	endpoints, err := client.endpoints(ctx, client.options.Protocol)
+	if err != nil {
+		return nil, err
+	}
 	header := map[string]string{
 		"Content-Type": "application/x-www-form-urlencoded",
 	}
 	body := map[string]string{
 		"token": token,
 	}
-	switch client.options.TokenEndPointAuthMethod {
-	case ClientSecretPost:
-		body["client_id"] = client.options.AppId
-		body["client_secret"] = client.options.AppSecret
-	case ClientSecretBasic:
-		base64String := "Basic " + base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s", client.options.AppId, client.options.AppSecret)))
-		header["Authorization"] = base64String
-	default:
-		body["client_id"] = client.options.AppId
+	if err = client.applyClientAuth(client.authMethodOf(client.options.IntrospectionEndPointAuthMethod), endpoints.Token, header, body); err != nil {
+		return nil, err
 	}
-	resp, err := client.SendProtocolHttpRequest(&ProtocolRequestOption{
-		Url:     url,
+	resp, err := client.SendProtocolHttpRequestWithContext(ctx, &ProtocolRequestOption{
+		Url:     endpoints.Introspection,
 		Method:  fasthttp.MethodPost,
 		Headers: client.getReqHeaders(header),
 		ReqDto:  body,
 	})
-
 	if err != nil {
 		return nil, err
 	}
-	var response dto.TokenIntrospectResponse
-
-	if resp == nil || resp.Body == nil {
-		return &response, err
-	}
-	println(string(resp.Body))
-
-	err = json.Unmarshal(resp.Body, &response)
-	if err != nil {
+	if err = checkOAuthError(resp.StatusCode, resp.Body); err != nil {
 		return nil, err
 	}
-	return &response, err
+	return resp.Body, nil
 }
 
 // RevokeToken
 // 撤回 Access token 或 Refresh token
 func (client *AuthenticationClient) RevokeToken(token string) (bool, error) {
-	if client.options.Protocol != OIDC && client.options.Protocol != OAUTH {
-		return false, errors.New("初始化 AuthenticationClient 时传入的 protocol 参数必须为 ProtocolEnum.OAUTH 或 ProtocolEnum.OIDC，请检查参数")
+	return client.RevokeTokenWithContext(context.Background(), token, "")
+}
+
+// RevokeTokenWithContext 同 RevokeToken，tokenTypeHint 不为空时作为 token_type_hint 传给撤销端点，
+// 撤销端点返回错误时返回 *OAuthError
+func (client *AuthenticationClient) RevokeTokenWithContext(ctx context.Context, token string, tokenTypeHint TokenTypeHintEnum) (bool, error) {
+	if err := client.checkTokenProtocol(); err != nil {
+		return false, err
 	}
-	if client.options.AppSecret == "" && client.options.TokenEndPointAuthMethod != constant.None {
-		return false, errors.New("请在初始化 AuthenticationClient 时传入 Secret")
+	endpoints, err := client.endpoints(ctx, client.options.Protocol)
+	if err != nil {
+		return false, err
 	}
-
-	url := client.options.AppHost + fmt.Sprintf("/%s/token/revocation", client.options.Protocol)
+	url := endpoints.Revocation
 
 	header := map[string]string{
 		"Content-Type": "application/x-www-form-urlencoded",
 	}
 
 	body := map[string]string{
-		"client_id": client.options.AppId,
-		"token":     token,
+		"token": token,
+	}
+	if tokenTypeHint != "" {
+		body["token_type_hint"] = string(tokenTypeHint)
 	}
 
-	switch client.options.TokenEndPointAuthMethod {
-	case ClientSecretPost:
-		body["client_id"] = client.options.AppId
-		body["client_secret"] = client.options.AppSecret
-	case ClientSecretBasic:
-		base64String := "Basic " + base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s", client.options.AppId, client.options.AppSecret)))
-		header["Authorization"] = base64String
-	default:
-		body["client_id"] = client.options.AppId
+	if err = client.applyClientAuth(client.authMethodOf(client.options.RevocationEndPointAuthMethod), endpoints.Token, header, body); err != nil {
+		return false, err
 	}
-	_, err := client.SendProtocolHttpRequest(&ProtocolRequestOption{
+	resp, err := client.SendProtocolHttpRequestWithContext(ctx, &ProtocolRequestOption{
 		Url:     url,
 		Method:  fasthttp.MethodPost,
 		Headers: client.getReqHeaders(header),
 		ReqDto:  body,
 	})
-	return true, err
+	if err != nil {
+		return false, err
+	}
+	if err = checkOAuthError(resp.StatusCode, resp.Body); err != nil {
+		return false, err
+	}
+	return true, nil
 }
 
 // 拼接登出 URL
@@ -455,74 +664,129 @@ func (client *AuthenticationClient) BuildLogoutUrl(params *BuildLogoutURLParams)
 	return url, nil
 }
 
-func (client *AuthenticationClient) getJWKS() (*keyfunc.JWKS, error) {
+// KeySet 返回当前 client 用于校验 token 签名的 KeySet，可通过 AuthenticationClientOptions.KeySet 共享给同一用户池的其他 client
+func (client *AuthenticationClient) KeySet() *KeySet {
+	return client.keySet
+}
 
-	if client.jwks != nil {
-		return client.jwks, nil
+func (client *AuthenticationClient) fetchJWKS(ctx context.Context) ([]byte, error) {
+	endpoints, err := client.endpoints(ctx, OIDC)
+	if err != nil {
+		return nil, err
 	}
-
-	jwksURL := client.getUrl(JWK_PATH)
-	jwks, err := keyfunc.Get(jwksURL, keyfunc.Options{})
+	res, err := client.SendProtocolHttpRequestWithContext(ctx, &ProtocolRequestOption{
+		Url:     endpoints.Jwks,
+		Method:  fasthttp.MethodGet,
+		Headers: client.getReqHeaders(nil),
+	})
 	if err != nil {
-		return nil, fmt.Errorf("获取 jwk 密钥失败: %w", err)
+		return nil, err
+	}
+	if res.StatusCode != 200 {
+		return nil, fmt.Errorf("[%d]:%s", res.StatusCode, res.Body)
 	}
-	client.jwks = jwks
-	return jwks, err
+	return res.Body, nil
 }
 
-func (client *AuthenticationClient) getKeyCommon(token *jwt.Token) (interface{}, error) {
+func (client *AuthenticationClient) getKeyCommon(ctx context.Context, token *jwt.Token) (interface{}, error) {
 	alg, ok := token.Header["alg"].(string)
 	if !ok {
 		return nil, fmt.Errorf("算法字段非法 %v", token.Header["alg"])
 	}
 	if alg == ALG_HS256 {
+		// 空密钥同样能通过 HMAC 校验，未配置 AppSecret 时任何人都可以伪造 token
+		if client.options.AppSecret == "" {
+			return nil, errors.New("未配置 AppSecret，无法校验 HS256 签名")
+		}
 		return []byte(client.options.AppSecret), nil
 	}
-	jwks, err := client.getJWKS()
-	if err != nil {
-		return nil, fmt.Errorf("获取 JWKS 失败 %v", err)
-	}
-	return jwks.Keyfunc(token)
+	return client.keySet.Key(ctx, token)
 }
 
-func (client *AuthenticationClient) getKey4IdToken(token *jwt.Token) (interface{}, error) {
-	claims := token.Claims.(*IDTokenClaims)
-	claims.IssuedAt = jwt.NewNumericDate(time.Now())
-
-	return client.getKeyCommon(token)
+func (client *AuthenticationClient) getKey4AccessToken(ctx context.Context) jwt.Keyfunc {
+	return func(token *jwt.Token) (interface{}, error) {
+		return client.getKeyCommon(ctx, token)
+	}
 }
 
-func (client *AuthenticationClient) getKey4AccessToken(token *jwt.Token) (interface{}, error) {
-	claims := token.Claims.(*AccessTokenClaims)
-	claims.IssuedAt = jwt.NewNumericDate(time.Now())
-
-	return client.getKeyCommon(token)
+func (client *AuthenticationClient) ParseIDToken(tokenStr string) (*IDTokenClaims, error) {
+	return client.ParseIDTokenWithContext(context.Background(), tokenStr)
 }
 
-func (client *AuthenticationClient) ParseIDToken(tokenStr string) (*IDTokenClaims, error) {
-	tokenJwt, err := jwt.ParseWithClaims(tokenStr, &IDTokenClaims{}, client.getKey4IdToken)
+// ParseIDTokenWithContext 同 ParseIDToken，ctx 用于控制获取 JWKS 的请求。
+// 校验签名、签名算法、iss、aud、exp 与 iat，需要校验 nonce 等参数时使用 ValidateIDToken
+func (client *AuthenticationClient) ParseIDTokenWithContext(ctx context.Context, tokenStr string) (*IDTokenClaims, error) {
+	claims, err := client.ValidateIDTokenWithContext(ctx, tokenStr, ValidationOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("解析id token失败: %w", err)
 	}
-	claims, ok := tokenJwt.Claims.(*IDTokenClaims)
-	if ok && tokenJwt.Valid {
-		return claims, nil
-	}
-	// TODO return error
-	return nil, errors.New("id token非法")
+	return claims, nil
 }
 
 func (client *AuthenticationClient) IntrospectAccessTokenOffline(tokenStr string) (*AccessTokenClaims, error) {
-	token, err := jwt.ParseWithClaims(tokenStr, &AccessTokenClaims{}, client.getKey4AccessToken)
+	return client.IntrospectAccessTokenOfflineWithContext(context.Background(), tokenStr)
+}
+
+// IntrospectAccessTokenOfflineWithContext 同 IntrospectAccessTokenOffline，ctx 用于控制获取 JWKS 的请求。
+// 校验签名、签名算法（RS256，配置了 AppSecret 时允许 HS256）、iss、exp 与 typ，并拒绝 id token
+func (client *AuthenticationClient) IntrospectAccessTokenOfflineWithContext(ctx context.Context, tokenStr string) (*AccessTokenClaims, error) {
+	claims, err := client.parseAccessToken(ctx, tokenStr, "", nil)
 	if err != nil {
 		return nil, fmt.Errorf("解析 access token失败: %w", err)
 	}
-	claims, ok := token.Claims.(*AccessTokenClaims)
-	if ok && token.Valid {
-		return claims, nil
+	return claims, nil
+}
+
+var (
+	errAccessTokenIssuer = errors.New("获取 issuer 失败")
+	errNotAccessToken    = errors.New("token 不是 access token")
+)
+
+// accessTokenOfflineClaims 用于识别 id token 的 claims
+type accessTokenOfflineClaims struct {
+	AccessTokenClaims
+	Nonce    string      `json:"nonce,omitempty"`
+	AtHash   string      `json:"at_hash,omitempty"`
+	AuthTime interface{} `json:"auth_time,omitempty"`
+}
+
+// parseAccessToken 离线校验 access token 的签名、签名算法、iss、exp 与 typ，并拒绝带有 nonce、at_hash 或 auth_time 的 id token。
+// issuer 为空时使用服务发现元数据中的 issuer，未启用服务发现时为 AppHost + /oidc；
+// algs 为空时允许 RS256，配置了 AppSecret 时同时允许 HS256
+func (client *AuthenticationClient) parseAccessToken(ctx context.Context, tokenStr string, issuer string, algs []string) (*AccessTokenClaims, error) {
+	if issuer == "" {
+		endpoints, err := client.endpoints(ctx, OIDC)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", errAccessTokenIssuer, err)
+		}
+		issuer = endpoints.Issuer
+	}
+	if len(algs) == 0 {
+		algs = []string{"RS256"}
+		if client.options.AppSecret != "" {
+			algs = append(algs, ALG_HS256)
+		}
+	}
+	claims := &accessTokenOfflineClaims{}
+	parser := jwt.NewParser(jwt.WithValidMethods(algs), jwt.WithIssuer(issuer))
+	token, err := parser.ParseWithClaims(tokenStr, claims, client.getKey4AccessToken(ctx))
+	if err != nil {
+		return nil, err
+	}
+	// jwt v5 只在 exp 存在时校验，缺少 exp 的 token 将永久有效
+	if claims.ExpiresAt == nil {
+		return nil, fmt.Errorf("%w: 缺少 exp", jwt.ErrTokenRequiredClaimMissing)
 	}
-	// TODO return error
-	return nil, errors.New("access token非法")
+	if typ, ok := token.Header["typ"].(string); ok {
+		typ = strings.TrimPrefix(strings.ToLower(typ), "application/")
+		if typ != "jwt" && typ != "at+jwt" {
+			return nil, fmt.Errorf("%w: typ 为 %s", errNotAccessToken, typ)
+		}
+	}
+	if claims.Nonce != "" || claims.AtHash != "" || claims.AuthTime != nil {
+		return nil, fmt.Errorf("%w: 包含 id token 的 claims", errNotAccessToken)
+	}
+	return &claims.AccessTokenClaims, nil
 }
 
 func (client *AuthenticationClient) getReqHeaders(customHeaders map[string]string) map[string]string {
@@ -537,21 +801,18 @@ func (client *AuthenticationClient) getReqHeaders(customHeaders map[string]strin
 	return newHeaders
 }
 func (client *AuthenticationClient) GetUserInfo(accessToken string) (*UserInfo, error) {
-	res, err := client.SendProtocolHttpRequest(&ProtocolRequestOption{
-		Method: fasthttp.MethodPost,
-		Url:    client.getUrl("/oidc/me"),
-		Headers: client.getReqHeaders(map[string]string{
-			"Authorization": `Bearer ` + accessToken,
-		}),
-	})
+	return client.GetUserInfoWithContext(context.Background(), accessToken)
+}
+
+// GetUserInfoWithContext 同 GetUserInfo，ctx 用于取消请求或控制请求截止时间。
+// 支持签名的 JWT 格式响应，未映射到固定字段的自定义 claims 保存在 UserInfo.Claims 中
+func (client *AuthenticationClient) GetUserInfoWithContext(ctx context.Context, accessToken string) (*UserInfo, error) {
+	body, err := client.fetchUserInfo(ctx, accessToken)
 	if err != nil {
-		return nil, fmt.Errorf("根据 access token 获取用户信息时失败: %w", err)
-	}
-	if res.StatusCode != 200 {
-		return nil, fmt.Errorf("根据 access token 获取用户信息失败[%d]:%s", res.StatusCode, res.Body)
+		return nil, err
 	}
 	var userInfo UserInfo
-	err = json.Unmarshal(res.Body, &userInfo)
+	err = json.Unmarshal(body, &userInfo)
 	if err != nil {
 		return nil, fmt.Errorf("无法解析用户信息:%w", err)
 	}
@@ -562,6 +823,10 @@ func (client *AuthenticationClient) buildLogoutUrlOIDC(params *BuildLogoutURLPar
 	if params == nil {
 		params = &BuildLogoutURLParams{}
 	}
+	endpoints, err := client.endpoints(context.Background(), OIDC)
+	if err != nil {
+		return "", err
+	}
 	idToken := params.IDTokenHint
 	redirectUri := params.PostLogoutRedirectUri
 	if redirectUri == "" {
@@ -586,11 +851,25 @@ func (client *AuthenticationClient) buildLogoutUrlOIDC(params *BuildLogoutURLPar
 	if queryString != "" {
 		queryString = "?" + queryString
 	}
-	return client.getUrl("/oidc/session/end") + queryString, nil
+	return endpoints.EndSession + queryString, nil
 }
 
 func (client *AuthenticationClient) SignInByUsernamePassword(username string, password string, options dto.SignInOptionsDto) *dto.LoginTokenRespDto {
-	body, err := client.SendHttpRequest(
+	response, err := client.SignInByUsernamePasswordWithContext(context.Background(), username, password, options)
+	if err != nil {
+		response = &dto.LoginTokenRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
+	}
+	return response
+}
+
+// SignInByUsernamePasswordWithContext 同 SignInByUsernamePassword，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) SignInByUsernamePasswordWithContext(ctx context.Context, username string, password string, options dto.SignInOptionsDto) (*dto.LoginTokenRespDto, error) {
+	var response dto.LoginTokenRespDto
+	err := client.request(ctx,
 		"/api/v3/signin",
 		fasthttp.MethodPost,
 		&dto.SigninByCredentialsDto{
@@ -603,22 +882,30 @@ func (client *AuthenticationClient) SignInByUsernamePassword(username string, pa
 			ClientId:     client.options.AppId,
 			ClientSecret: client.options.AppSecret,
 		},
+		&response,
 	)
-	var response dto.LoginTokenRespDto
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	err = json.Unmarshal(body, &response)
+	return &response, nil
+}
+
+func (client *AuthenticationClient) SignInByEmailPassword(email string, password string, options dto.SignInOptionsDto) *dto.LoginTokenRespDto {
+	response, err := client.SignInByEmailPasswordWithContext(context.Background(), email, password, options)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.LoginTokenRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	return &response
+	return response
 }
 
-func (client *AuthenticationClient) SignInByEmailPassword(email string, password string, options dto.SignInOptionsDto) *dto.LoginTokenRespDto {
-	body, err := client.SendHttpRequest(
+// SignInByEmailPasswordWithContext 同 SignInByEmailPassword，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) SignInByEmailPasswordWithContext(ctx context.Context, email string, password string, options dto.SignInOptionsDto) (*dto.LoginTokenRespDto, error) {
+	var response dto.LoginTokenRespDto
+	err := client.request(ctx,
 		"/api/v3/signin",
 		fasthttp.MethodPost,
 		&dto.SigninByCredentialsDto{
@@ -631,22 +918,30 @@ func (client *AuthenticationClient) SignInByEmailPassword(email string, password
 			ClientId:     client.options.AppId,
 			ClientSecret: client.options.AppSecret,
 		},
+		&response,
 	)
-	var response dto.LoginTokenRespDto
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	err = json.Unmarshal(body, &response)
+	return &response, nil
+}
+
+func (client *AuthenticationClient) SignInByPhonePassword(phone string, password string, options dto.SignInOptionsDto) *dto.LoginTokenRespDto {
+	response, err := client.SignInByPhonePasswordWithContext(context.Background(), phone, password, options)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.LoginTokenRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	return &response
+	return response
 }
 
-func (client *AuthenticationClient) SignInByPhonePassword(phone string, password string, options dto.SignInOptionsDto) *dto.LoginTokenRespDto {
-	body, err := client.SendHttpRequest(
+// SignInByPhonePasswordWithContext 同 SignInByPhonePassword，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) SignInByPhonePasswordWithContext(ctx context.Context, phone string, password string, options dto.SignInOptionsDto) (*dto.LoginTokenRespDto, error) {
+	var response dto.LoginTokenRespDto
+	err := client.request(ctx,
 		"/api/v3/signin",
 		fasthttp.MethodPost,
 		&dto.SigninByCredentialsDto{
@@ -659,22 +954,30 @@ func (client *AuthenticationClient) SignInByPhonePassword(phone string, password
 			ClientId:     client.options.AppId,
 			ClientSecret: client.options.AppSecret,
 		},
+		&response,
 	)
-	var response dto.LoginTokenRespDto
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	err = json.Unmarshal(body, &response)
+	return &response, nil
+}
+
+func (client *AuthenticationClient) SignInByAccountPassword(account string, password string, options dto.SignInOptionsDto) *dto.LoginTokenRespDto {
+	response, err := client.SignInByAccountPasswordWithContext(context.Background(), account, password, options)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.LoginTokenRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	return &response
+	return response
 }
 
-func (client *AuthenticationClient) SignInByAccountPassword(account string, password string, options dto.SignInOptionsDto) *dto.LoginTokenRespDto {
-	body, err := client.SendHttpRequest(
+// SignInByAccountPasswordWithContext 同 SignInByAccountPassword，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) SignInByAccountPasswordWithContext(ctx context.Context, account string, password string, options dto.SignInOptionsDto) (*dto.LoginTokenRespDto, error) {
+	var response dto.LoginTokenRespDto
+	err := client.request(ctx,
 		"/api/v3/signin",
 		fasthttp.MethodPost,
 		&dto.SigninByCredentialsDto{
@@ -687,22 +990,30 @@ func (client *AuthenticationClient) SignInByAccountPassword(account string, pass
 			ClientId:     client.options.AppId,
 			ClientSecret: client.options.AppSecret,
 		},
+		&response,
 	)
-	var response dto.LoginTokenRespDto
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	err = json.Unmarshal(body, &response)
+	return &response, nil
+}
+
+func (client *AuthenticationClient) SignInByPhonePassCode(phone string, passCode string, phoneCountryCode string, options dto.SignInOptionsDto) *dto.LoginTokenRespDto {
+	response, err := client.SignInByPhonePassCodeWithContext(context.Background(), phone, passCode, phoneCountryCode, options)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.LoginTokenRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	return &response
+	return response
 }
 
-func (client *AuthenticationClient) SignInByPhonePassCode(phone string, passCode string, phoneCountryCode string, options dto.SignInOptionsDto) *dto.LoginTokenRespDto {
-	body, err := client.SendHttpRequest(
+// SignInByPhonePassCodeWithContext 同 SignInByPhonePassCode，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) SignInByPhonePassCodeWithContext(ctx context.Context, phone string, passCode string, phoneCountryCode string, options dto.SignInOptionsDto) (*dto.LoginTokenRespDto, error) {
+	var response dto.LoginTokenRespDto
+	err := client.request(ctx,
 		"/api/v3/signin",
 		fasthttp.MethodPost,
 		&dto.SigninByCredentialsDto{
@@ -716,22 +1027,30 @@ func (client *AuthenticationClient) SignInByPhonePassCode(phone string, passCode
 			ClientId:     client.options.AppId,
 			ClientSecret: client.options.AppSecret,
 		},
+		&response,
 	)
-	var response dto.LoginTokenRespDto
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	err = json.Unmarshal(body, &response)
+	return &response, nil
+}
+
+func (client *AuthenticationClient) SignInByEmailPassCode(email string, passCode string, options dto.SignInOptionsDto) *dto.LoginTokenRespDto {
+	response, err := client.SignInByEmailPassCodeWithContext(context.Background(), email, passCode, options)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.LoginTokenRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	return &response
+	return response
 }
 
-func (client *AuthenticationClient) SignInByEmailPassCode(email string, passCode string, options dto.SignInOptionsDto) *dto.LoginTokenRespDto {
-	body, err := client.SendHttpRequest(
+// SignInByEmailPassCodeWithContext 同 SignInByEmailPassCode，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) SignInByEmailPassCodeWithContext(ctx context.Context, email string, passCode string, options dto.SignInOptionsDto) (*dto.LoginTokenRespDto, error) {
+	var response dto.LoginTokenRespDto
+	err := client.request(ctx,
 		"/api/v3/signin",
 		fasthttp.MethodPost,
 		&dto.SigninByCredentialsDto{
@@ -744,22 +1063,30 @@ func (client *AuthenticationClient) SignInByEmailPassCode(email string, passCode
 			ClientId:     client.options.AppId,
 			ClientSecret: client.options.AppSecret,
 		},
+		&response,
 	)
-	var response dto.LoginTokenRespDto
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	err = json.Unmarshal(body, &response)
+	return &response, nil
+}
+
+func (client *AuthenticationClient) SignInByLDAP(sAMAccountName string, passCode string, options dto.SignInOptionsDto) *dto.LoginTokenRespDto {
+	response, err := client.SignInByLDAPWithContext(context.Background(), sAMAccountName, passCode, options)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.LoginTokenRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	return &response
+	return response
 }
 
-func (client *AuthenticationClient) SignInByLDAP(sAMAccountName string, passCode string, options dto.SignInOptionsDto) *dto.LoginTokenRespDto {
-	body, err := client.SendHttpRequest(
+// SignInByLDAPWithContext 同 SignInByLDAP，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) SignInByLDAPWithContext(ctx context.Context, sAMAccountName string, passCode string, options dto.SignInOptionsDto) (*dto.LoginTokenRespDto, error) {
+	var response dto.LoginTokenRespDto
+	err := client.request(ctx,
 		"/api/v3/signin",
 		fasthttp.MethodPost,
 		&dto.SigninByCredentialsDto{
@@ -772,22 +1099,30 @@ func (client *AuthenticationClient) SignInByLDAP(sAMAccountName string, passCode
 			ClientId:     client.options.AppId,
 			ClientSecret: client.options.AppSecret,
 		},
+		&response,
 	)
-	var response dto.LoginTokenRespDto
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	err = json.Unmarshal(body, &response)
+	return &response, nil
+}
+
+func (client *AuthenticationClient) SignInByAD(sAMAccountName string, passCode string, options dto.SignInOptionsDto) *dto.LoginTokenRespDto {
+	response, err := client.SignInByADWithContext(context.Background(), sAMAccountName, passCode, options)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.LoginTokenRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	return &response
+	return response
 }
 
-func (client *AuthenticationClient) SignInByAD(sAMAccountName string, passCode string, options dto.SignInOptionsDto) *dto.LoginTokenRespDto {
-	body, err := client.SendHttpRequest(
+// SignInByADWithContext 同 SignInByAD，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) SignInByADWithContext(ctx context.Context, sAMAccountName string, passCode string, options dto.SignInOptionsDto) (*dto.LoginTokenRespDto, error) {
+	var response dto.LoginTokenRespDto
+	err := client.request(ctx,
 		"/api/v3/signin",
 		fasthttp.MethodPost,
 		&dto.SigninByCredentialsDto{
@@ -800,22 +1135,30 @@ func (client *AuthenticationClient) SignInByAD(sAMAccountName string, passCode s
 			ClientId:     client.options.AppId,
 			ClientSecret: client.options.AppSecret,
 		},
+		&response,
 	)
-	var response dto.LoginTokenRespDto
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	err = json.Unmarshal(body, &response)
+	return &response, nil
+}
+
+func (client *AuthenticationClient) SignUpByEmailPassCode(email string, passCode string, options dto.SignUpOptionsDto) *dto.UserSingleRespDto {
+	response, err := client.SignUpByEmailPassCodeWithContext(context.Background(), email, passCode, options)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.UserSingleRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	return &response
+	return response
 }
 
-func (client *AuthenticationClient) SignUpByEmailPassCode(email string, passCode string, options dto.SignUpOptionsDto) *dto.UserSingleRespDto {
-	body, err := client.SendHttpRequest(
+// SignUpByEmailPassCodeWithContext 同 SignUpByEmailPassCode，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) SignUpByEmailPassCodeWithContext(ctx context.Context, email string, passCode string, options dto.SignUpOptionsDto) (*dto.UserSingleRespDto, error) {
+	var response dto.UserSingleRespDto
+	err := client.request(ctx,
 		"/api/v3/signup",
 		fasthttp.MethodPost,
 		&dto.SignUpDto{
@@ -826,22 +1169,30 @@ func (client *AuthenticationClient) SignUpByEmailPassCode(email string, passCode
 			},
 			Options: options,
 		},
+		&response,
 	)
-	var response dto.UserSingleRespDto
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	err = json.Unmarshal(body, &response)
+	return &response, nil
+}
+
+func (client *AuthenticationClient) SignUpByPhonePassCode(phone string, passCode string, phoneCountryCode string, options dto.SignUpOptionsDto) *dto.UserSingleRespDto {
+	response, err := client.SignUpByPhonePassCodeWithContext(context.Background(), phone, passCode, phoneCountryCode, options)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.UserSingleRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	return &response
+	return response
 }
 
-func (client *AuthenticationClient) SignUpByPhonePassCode(phone string, passCode string, phoneCountryCode string, options dto.SignUpOptionsDto) *dto.UserSingleRespDto {
-	body, err := client.SendHttpRequest(
+// SignUpByPhonePassCodeWithContext 同 SignUpByPhonePassCode，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) SignUpByPhonePassCodeWithContext(ctx context.Context, phone string, passCode string, phoneCountryCode string, options dto.SignUpOptionsDto) (*dto.UserSingleRespDto, error) {
+	var response dto.UserSingleRespDto
+	err := client.request(ctx,
 		"/api/v3/signup",
 		fasthttp.MethodPost,
 		&dto.SignUpDto{
@@ -853,22 +1204,30 @@ func (client *AuthenticationClient) SignUpByPhonePassCode(phone string, passCode
 			},
 			Options: options,
 		},
+		&response,
 	)
-	var response dto.UserSingleRespDto
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	err = json.Unmarshal(body, &response)
+	return &response, nil
+}
+
+func (client *AuthenticationClient) SignUpByEmailPassword(email string, password string, options dto.SignUpOptionsDto) *dto.UserSingleRespDto {
+	response, err := client.SignUpByEmailPasswordWithContext(context.Background(), email, password, options)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.UserSingleRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	return &response
+	return response
 }
 
-func (client *AuthenticationClient) SignUpByEmailPassword(email string, password string, options dto.SignUpOptionsDto) *dto.UserSingleRespDto {
-	body, err := client.SendHttpRequest(
+// SignUpByEmailPasswordWithContext 同 SignUpByEmailPassword，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) SignUpByEmailPasswordWithContext(ctx context.Context, email string, password string, options dto.SignUpOptionsDto) (*dto.UserSingleRespDto, error) {
+	var response dto.UserSingleRespDto
+	err := client.request(ctx,
 		"/api/v3/signup",
 		fasthttp.MethodPost,
 		&dto.SignUpDto{
@@ -879,22 +1238,30 @@ func (client *AuthenticationClient) SignUpByEmailPassword(email string, password
 			},
 			Options: options,
 		},
+		&response,
 	)
-	var response dto.UserSingleRespDto
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	err = json.Unmarshal(body, &response)
+	return &response, nil
+}
+
+func (client *AuthenticationClient) SignUpByUsernamePassword(username string, password string, options dto.SignUpOptionsDto) *dto.UserSingleRespDto {
+	response, err := client.SignUpByUsernamePasswordWithContext(context.Background(), username, password, options)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.UserSingleRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	return &response
+	return response
 }
 
-func (client *AuthenticationClient) SignUpByUsernamePassword(username string, password string, options dto.SignUpOptionsDto) *dto.UserSingleRespDto {
-	body, err := client.SendHttpRequest(
+// SignUpByUsernamePasswordWithContext 同 SignUpByUsernamePassword，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) SignUpByUsernamePasswordWithContext(ctx context.Context, username string, password string, options dto.SignUpOptionsDto) (*dto.UserSingleRespDto, error) {
+	var response dto.UserSingleRespDto
+	err := client.request(ctx,
 		"/api/v3/signup",
 		fasthttp.MethodPost,
 		&dto.SignUpDto{
@@ -905,18 +1272,12 @@ func (client *AuthenticationClient) SignUpByUsernamePassword(username string, pa
 			},
 			Options: options,
 		},
+		&response,
 	)
-	var response dto.UserSingleRespDto
-	if err != nil {
-		fmt.Println(err)
-		return nil
-	}
-	err = json.Unmarshal(body, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 // ==== AUTO GENERATED AUTHENTICATION METHODS BEGIN ====
@@ -974,18 +1335,25 @@ func (client *AuthenticationClient) SignUpByUsernamePassword(username string, pa
 	* @returns LoginTokenRespDto 成功认证
 */
 func (client *AuthenticationClient) SignInByCredentials(reqDto *dto.SigninByCredentialsDto) *dto.LoginTokenRespDto {
-	b, err := client.SendHttpRequest("/api/v3/signin", fasthttp.MethodPost, reqDto)
-	var response dto.LoginTokenRespDto
+	response, err := client.SignInByCredentialsWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.LoginTokenRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// SignInByCredentialsWithContext 同 SignInByCredentials，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) SignInByCredentialsWithContext(ctx context.Context, reqDto *dto.SigninByCredentialsDto) (*dto.LoginTokenRespDto, error) {
+	var response dto.LoginTokenRespDto
+	err := client.request(ctx, "/api/v3/signin", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1042,18 +1410,25 @@ func (client *AuthenticationClient) SignInByCredentials(reqDto *dto.SigninByCred
 	* @returns LoginTokenRespDto
 */
 func (client *AuthenticationClient) SignInByMobile(reqDto *dto.SigninByMobileDto) *dto.LoginTokenRespDto {
-	b, err := client.SendHttpRequest("/api/v3/signin-by-mobile", fasthttp.MethodPost, reqDto)
-	var response dto.LoginTokenRespDto
+	response, err := client.SignInByMobileWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.LoginTokenRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// SignInByMobileWithContext 同 SignInByMobile，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) SignInByMobileWithContext(ctx context.Context, reqDto *dto.SigninByMobileDto) (*dto.LoginTokenRespDto, error) {
+	var response dto.LoginTokenRespDto
+	err := client.request(ctx, "/api/v3/signin-by-mobile", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1062,18 +1437,25 @@ func (client *AuthenticationClient) SignInByMobile(reqDto *dto.SigninByMobileDto
 * @returns GetAlipayAuthInfoRespDto
  */
 func (client *AuthenticationClient) GetAlipayAuthInfo(reqDto *dto.GetAlipayAuthinfoDto) *dto.GetAlipayAuthInfoRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-alipay-authinfo", fasthttp.MethodGet, reqDto)
-	var response dto.GetAlipayAuthInfoRespDto
+	response, err := client.GetAlipayAuthInfoWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.GetAlipayAuthInfoRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetAlipayAuthInfoWithContext 同 GetAlipayAuthInfo，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) GetAlipayAuthInfoWithContext(ctx context.Context, reqDto *dto.GetAlipayAuthinfoDto) (*dto.GetAlipayAuthInfoRespDto, error) {
+	var response dto.GetAlipayAuthInfoRespDto
+	err := client.request(ctx, "/api/v3/get-alipay-authinfo", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1082,18 +1464,25 @@ func (client *AuthenticationClient) GetAlipayAuthInfo(reqDto *dto.GetAlipayAuthi
 * @returns GeneQRCodeRespDto
  */
 func (client *AuthenticationClient) GeneQrCode(reqDto *dto.GenerateQrcodeDto) *dto.GeneQRCodeRespDto {
-	b, err := client.SendHttpRequest("/api/v3/gene-qrcode", fasthttp.MethodPost, reqDto)
-	var response dto.GeneQRCodeRespDto
+	response, err := client.GeneQrCodeWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.GeneQRCodeRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GeneQrCodeWithContext 同 GeneQrCode，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) GeneQrCodeWithContext(ctx context.Context, reqDto *dto.GenerateQrcodeDto) (*dto.GeneQRCodeRespDto, error) {
+	var response dto.GeneQRCodeRespDto
+	err := client.request(ctx, "/api/v3/gene-qrcode", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1102,18 +1491,25 @@ func (client *AuthenticationClient) GeneQrCode(reqDto *dto.GenerateQrcodeDto) *d
 * @returns CheckQRCodeStatusRespDto
  */
 func (client *AuthenticationClient) CheckQrCodeStatus(reqDto *dto.CheckQrcodeStatusDto) *dto.CheckQRCodeStatusRespDto {
-	b, err := client.SendHttpRequest("/api/v3/check-qrcode-status", fasthttp.MethodGet, reqDto)
-	var response dto.CheckQRCodeStatusRespDto
+	response, err := client.CheckQrCodeStatusWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CheckQRCodeStatusRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// CheckQrCodeStatusWithContext 同 CheckQrCodeStatus，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) CheckQrCodeStatusWithContext(ctx context.Context, reqDto *dto.CheckQrcodeStatusDto) (*dto.CheckQRCodeStatusRespDto, error) {
+	var response dto.CheckQRCodeStatusRespDto
+	err := client.request(ctx, "/api/v3/check-qrcode-status", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1170,18 +1566,25 @@ func (client *AuthenticationClient) CheckQrCodeStatus(reqDto *dto.CheckQrcodeSta
 	* @returns LoginTokenRespDto
 */
 func (client *AuthenticationClient) ExchangeTokenSetWithQrCodeTicket(reqDto *dto.ExchangeTokenSetWithQRcodeTicketDto) *dto.LoginTokenRespDto {
-	b, err := client.SendHttpRequest("/api/v3/exchange-tokenset-with-qrcode-ticket", fasthttp.MethodPost, reqDto)
-	var response dto.LoginTokenRespDto
+	response, err := client.ExchangeTokenSetWithQrCodeTicketWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.LoginTokenRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ExchangeTokenSetWithQrCodeTicketWithContext 同 ExchangeTokenSetWithQrCodeTicket，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) ExchangeTokenSetWithQrCodeTicketWithContext(ctx context.Context, reqDto *dto.ExchangeTokenSetWithQRcodeTicketDto) (*dto.LoginTokenRespDto, error) {
+	var response dto.LoginTokenRespDto
+	err := client.request(ctx, "/api/v3/exchange-tokenset-with-qrcode-ticket", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1190,18 +1593,25 @@ func (client *AuthenticationClient) ExchangeTokenSetWithQrCodeTicket(reqDto *dto
 * @returns CommonResponseDto
  */
 func (client *AuthenticationClient) ChangeQrCodeStatus(reqDto *dto.ChangeQRCodeStatusDto) *dto.CommonResponseDto {
-	b, err := client.SendHttpRequest("/api/v3/change-qrcode-status", fasthttp.MethodPost, reqDto)
-	var response dto.CommonResponseDto
+	response, err := client.ChangeQrCodeStatusWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CommonResponseDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ChangeQrCodeStatusWithContext 同 ChangeQrCodeStatus，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) ChangeQrCodeStatusWithContext(ctx context.Context, reqDto *dto.ChangeQRCodeStatusDto) (*dto.CommonResponseDto, error) {
+	var response dto.CommonResponseDto
+	err := client.request(ctx, "/api/v3/change-qrcode-status", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1210,18 +1620,25 @@ func (client *AuthenticationClient) ChangeQrCodeStatus(reqDto *dto.ChangeQRCodeS
 * @returns SendSMSRespDto
  */
 func (client *AuthenticationClient) SendSms(reqDto *dto.SendSMSDto) *dto.SendSMSRespDto {
-	b, err := client.SendHttpRequest("/api/v3/send-sms", fasthttp.MethodPost, reqDto)
-	var response dto.SendSMSRespDto
+	response, err := client.SendSmsWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.SendSMSRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// SendSmsWithContext 同 SendSms，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) SendSmsWithContext(ctx context.Context, reqDto *dto.SendSMSDto) (*dto.SendSMSRespDto, error) {
+	var response dto.SendSMSRespDto
+	err := client.request(ctx, "/api/v3/send-sms", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1230,18 +1647,25 @@ func (client *AuthenticationClient) SendSms(reqDto *dto.SendSMSDto) *dto.SendSMS
 * @returns SendEmailRespDto
  */
 func (client *AuthenticationClient) SendEmail(reqDto *dto.SendEmailDto) *dto.SendEmailRespDto {
-	b, err := client.SendHttpRequest("/api/v3/send-email", fasthttp.MethodPost, reqDto)
-	var response dto.SendEmailRespDto
+	response, err := client.SendEmailWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.SendEmailRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// SendEmailWithContext 同 SendEmail，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) SendEmailWithContext(ctx context.Context, reqDto *dto.SendEmailDto) (*dto.SendEmailRespDto, error) {
+	var response dto.SendEmailRespDto
+	err := client.request(ctx, "/api/v3/send-email", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1250,18 +1674,25 @@ func (client *AuthenticationClient) SendEmail(reqDto *dto.SendEmailDto) *dto.Sen
 * @returns UserSingleRespDto
  */
 func (client *AuthenticationClient) GetProfile(reqDto *dto.GetProfileDto) *dto.UserSingleRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-profile", fasthttp.MethodGet, reqDto)
-	var response dto.UserSingleRespDto
+	response, err := client.GetProfileWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.UserSingleRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetProfileWithContext 同 GetProfile，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) GetProfileWithContext(ctx context.Context, reqDto *dto.GetProfileDto) (*dto.UserSingleRespDto, error) {
+	var response dto.UserSingleRespDto
+	err := client.request(ctx, "/api/v3/get-profile", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1270,18 +1701,25 @@ func (client *AuthenticationClient) GetProfile(reqDto *dto.GetProfileDto) *dto.U
 * @returns UserSingleRespDto
  */
 func (client *AuthenticationClient) UpdateProfile(reqDto *dto.UpdateUserProfileDto) *dto.UserSingleRespDto {
-	b, err := client.SendHttpRequest("/api/v3/update-profile", fasthttp.MethodPost, reqDto)
-	var response dto.UserSingleRespDto
+	response, err := client.UpdateProfileWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.UserSingleRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// UpdateProfileWithContext 同 UpdateProfile，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) UpdateProfileWithContext(ctx context.Context, reqDto *dto.UpdateUserProfileDto) (*dto.UserSingleRespDto, error) {
+	var response dto.UserSingleRespDto
+	err := client.request(ctx, "/api/v3/update-profile", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1290,18 +1728,25 @@ func (client *AuthenticationClient) UpdateProfile(reqDto *dto.UpdateUserProfileD
 * @returns CommonResponseDto
  */
 func (client *AuthenticationClient) BindEmail(reqDto *dto.BindEmailDto) *dto.CommonResponseDto {
-	b, err := client.SendHttpRequest("/api/v3/bind-email", fasthttp.MethodPost, reqDto)
-	var response dto.CommonResponseDto
+	response, err := client.BindEmailWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CommonResponseDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// BindEmailWithContext 同 BindEmail，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) BindEmailWithContext(ctx context.Context, reqDto *dto.BindEmailDto) (*dto.CommonResponseDto, error) {
+	var response dto.CommonResponseDto
+	err := client.request(ctx, "/api/v3/bind-email", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1310,18 +1755,25 @@ func (client *AuthenticationClient) BindEmail(reqDto *dto.BindEmailDto) *dto.Com
 * @returns CommonResponseDto
  */
 func (client *AuthenticationClient) UnbindEmail(reqDto *dto.UnbindEmailDto) *dto.CommonResponseDto {
-	b, err := client.SendHttpRequest("/api/v3/unbind-email", fasthttp.MethodPost, reqDto)
-	var response dto.CommonResponseDto
+	response, err := client.UnbindEmailWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CommonResponseDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// UnbindEmailWithContext 同 UnbindEmail，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) UnbindEmailWithContext(ctx context.Context, reqDto *dto.UnbindEmailDto) (*dto.CommonResponseDto, error) {
+	var response dto.CommonResponseDto
+	err := client.request(ctx, "/api/v3/unbind-email", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1330,18 +1782,25 @@ func (client *AuthenticationClient) UnbindEmail(reqDto *dto.UnbindEmailDto) *dto
 * @returns CommonResponseDto
  */
 func (client *AuthenticationClient) BindPhone(reqDto *dto.BindPhoneDto) *dto.CommonResponseDto {
-	b, err := client.SendHttpRequest("/api/v3/bind-phone", fasthttp.MethodPost, reqDto)
-	var response dto.CommonResponseDto
+	response, err := client.BindPhoneWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CommonResponseDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// BindPhoneWithContext 同 BindPhone，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) BindPhoneWithContext(ctx context.Context, reqDto *dto.BindPhoneDto) (*dto.CommonResponseDto, error) {
+	var response dto.CommonResponseDto
+	err := client.request(ctx, "/api/v3/bind-phone", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1350,18 +1809,25 @@ func (client *AuthenticationClient) BindPhone(reqDto *dto.BindPhoneDto) *dto.Com
 * @returns CommonResponseDto
  */
 func (client *AuthenticationClient) UnbindPhone(reqDto *dto.UnbindPhoneDto) *dto.CommonResponseDto {
-	b, err := client.SendHttpRequest("/api/v3/unbind-phone", fasthttp.MethodPost, reqDto)
-	var response dto.CommonResponseDto
+	response, err := client.UnbindPhoneWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CommonResponseDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// UnbindPhoneWithContext 同 UnbindPhone，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) UnbindPhoneWithContext(ctx context.Context, reqDto *dto.UnbindPhoneDto) (*dto.CommonResponseDto, error) {
+	var response dto.CommonResponseDto
+	err := client.request(ctx, "/api/v3/unbind-phone", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1370,18 +1836,25 @@ func (client *AuthenticationClient) UnbindPhone(reqDto *dto.UnbindPhoneDto) *dto
 * @returns GetSecurityInfoRespDto
  */
 func (client *AuthenticationClient) GetSecurityLevel() *dto.GetSecurityInfoRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-security-info", fasthttp.MethodGet, nil)
-	var response dto.GetSecurityInfoRespDto
+	response, err := client.GetSecurityLevelWithContext(context.Background())
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.GetSecurityInfoRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetSecurityLevelWithContext 同 GetSecurityLevel，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) GetSecurityLevelWithContext(ctx context.Context) (*dto.GetSecurityInfoRespDto, error) {
+	var response dto.GetSecurityInfoRespDto
+	err := client.request(ctx, "/api/v3/get-security-info", fasthttp.MethodGet, nil, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1390,18 +1863,25 @@ func (client *AuthenticationClient) GetSecurityLevel() *dto.GetSecurityInfoRespD
 * @returns CommonResponseDto
  */
 func (client *AuthenticationClient) UpdatePassword(reqDto *dto.UpdatePasswordDto) *dto.CommonResponseDto {
-	b, err := client.SendHttpRequest("/api/v3/update-password", fasthttp.MethodPost, reqDto)
-	var response dto.CommonResponseDto
+	response, err := client.UpdatePasswordWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CommonResponseDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// UpdatePasswordWithContext 同 UpdatePassword，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) UpdatePasswordWithContext(ctx context.Context, reqDto *dto.UpdatePasswordDto) (*dto.CommonResponseDto, error) {
+	var response dto.CommonResponseDto
+	err := client.request(ctx, "/api/v3/update-password", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1410,18 +1890,25 @@ func (client *AuthenticationClient) UpdatePassword(reqDto *dto.UpdatePasswordDto
 * @returns VerifyUpdateEmailRequestRespDto
  */
 func (client *AuthenticationClient) VerifyUpdateEmailRequest(reqDto *dto.VerifyUpdateEmailRequestDto) *dto.VerifyUpdateEmailRequestRespDto {
-	b, err := client.SendHttpRequest("/api/v3/verify-update-email-request", fasthttp.MethodPost, reqDto)
-	var response dto.VerifyUpdateEmailRequestRespDto
+	response, err := client.VerifyUpdateEmailRequestWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.VerifyUpdateEmailRequestRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// VerifyUpdateEmailRequestWithContext 同 VerifyUpdateEmailRequest，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) VerifyUpdateEmailRequestWithContext(ctx context.Context, reqDto *dto.VerifyUpdateEmailRequestDto) (*dto.VerifyUpdateEmailRequestRespDto, error) {
+	var response dto.VerifyUpdateEmailRequestRespDto
+	err := client.request(ctx, "/api/v3/verify-update-email-request", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1431,18 +1918,25 @@ func (client *AuthenticationClient) VerifyUpdateEmailRequest(reqDto *dto.VerifyU
 	* @returns CommonResponseDto
 */
 func (client *AuthenticationClient) UpdateEmail(reqDto *dto.UpdateEmailDto) *dto.CommonResponseDto {
-	b, err := client.SendHttpRequest("/api/v3/update-email", fasthttp.MethodPost, reqDto)
-	var response dto.CommonResponseDto
+	response, err := client.UpdateEmailWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CommonResponseDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// UpdateEmailWithContext 同 UpdateEmail，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) UpdateEmailWithContext(ctx context.Context, reqDto *dto.UpdateEmailDto) (*dto.CommonResponseDto, error) {
+	var response dto.CommonResponseDto
+	err := client.request(ctx, "/api/v3/update-email", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1451,18 +1945,25 @@ func (client *AuthenticationClient) UpdateEmail(reqDto *dto.UpdateEmailDto) *dto
 * @returns VerifyUpdatePhoneRequestRespDto
  */
 func (client *AuthenticationClient) VerifyUpdatePhoneRequest(reqDto *dto.VerifyUpdatePhoneRequestDto) *dto.VerifyUpdatePhoneRequestRespDto {
-	b, err := client.SendHttpRequest("/api/v3/verify-update-phone-request", fasthttp.MethodPost, reqDto)
-	var response dto.VerifyUpdatePhoneRequestRespDto
+	response, err := client.VerifyUpdatePhoneRequestWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.VerifyUpdatePhoneRequestRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// VerifyUpdatePhoneRequestWithContext 同 VerifyUpdatePhoneRequest，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) VerifyUpdatePhoneRequestWithContext(ctx context.Context, reqDto *dto.VerifyUpdatePhoneRequestDto) (*dto.VerifyUpdatePhoneRequestRespDto, error) {
+	var response dto.VerifyUpdatePhoneRequestRespDto
+	err := client.request(ctx, "/api/v3/verify-update-phone-request", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1472,18 +1973,25 @@ func (client *AuthenticationClient) VerifyUpdatePhoneRequest(reqDto *dto.VerifyU
 	* @returns CommonResponseDto
 */
 func (client *AuthenticationClient) UpdatePhone(reqDto *dto.UpdatePhoneDto) *dto.CommonResponseDto {
-	b, err := client.SendHttpRequest("/api/v3/update-phone", fasthttp.MethodPost, reqDto)
-	var response dto.CommonResponseDto
+	response, err := client.UpdatePhoneWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CommonResponseDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// UpdatePhoneWithContext 同 UpdatePhone，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) UpdatePhoneWithContext(ctx context.Context, reqDto *dto.UpdatePhoneDto) (*dto.CommonResponseDto, error) {
+	var response dto.CommonResponseDto
+	err := client.request(ctx, "/api/v3/update-phone", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1492,18 +2000,25 @@ func (client *AuthenticationClient) UpdatePhone(reqDto *dto.UpdatePhoneDto) *dto
 * @returns PasswordResetVerifyResp
  */
 func (client *AuthenticationClient) VerifyResetPasswordRequest(reqDto *dto.VerifyResetPasswordRequestDto) *dto.PasswordResetVerifyResp {
-	b, err := client.SendHttpRequest("/api/v3/verify-reset-password-request", fasthttp.MethodPost, reqDto)
-	var response dto.PasswordResetVerifyResp
+	response, err := client.VerifyResetPasswordRequestWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.PasswordResetVerifyResp{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// VerifyResetPasswordRequestWithContext 同 VerifyResetPasswordRequest，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) VerifyResetPasswordRequestWithContext(ctx context.Context, reqDto *dto.VerifyResetPasswordRequestDto) (*dto.PasswordResetVerifyResp, error) {
+	var response dto.PasswordResetVerifyResp
+	err := client.request(ctx, "/api/v3/verify-reset-password-request", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1512,18 +2027,25 @@ func (client *AuthenticationClient) VerifyResetPasswordRequest(reqDto *dto.Verif
 * @returns IsSuccessRespDto
  */
 func (client *AuthenticationClient) ResetPassword(reqDto *dto.ResetPasswordDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/reset-password", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.ResetPasswordWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ResetPasswordWithContext 同 ResetPassword，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) ResetPasswordWithContext(ctx context.Context, reqDto *dto.ResetPasswordDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/reset-password", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1532,18 +2054,25 @@ func (client *AuthenticationClient) ResetPassword(reqDto *dto.ResetPasswordDto)
 * @returns VerifyDeleteAccountRequestRespDto
  */
 func (client *AuthenticationClient) VerifyDeleteAccountRequest(reqDto *dto.VerifyDeleteAccountRequestDto) *dto.VerifyDeleteAccountRequestRespDto {
-	b, err := client.SendHttpRequest("/api/v3/verify-delete-account-request", fasthttp.MethodPost, reqDto)
-	var response dto.VerifyDeleteAccountRequestRespDto
+	response, err := client.VerifyDeleteAccountRequestWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.VerifyDeleteAccountRequestRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// VerifyDeleteAccountRequestWithContext 同 VerifyDeleteAccountRequest，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) VerifyDeleteAccountRequestWithContext(ctx context.Context, reqDto *dto.VerifyDeleteAccountRequestDto) (*dto.VerifyDeleteAccountRequestRespDto, error) {
+	var response dto.VerifyDeleteAccountRequestRespDto
+	err := client.request(ctx, "/api/v3/verify-delete-account-request", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1552,18 +2081,25 @@ func (client *AuthenticationClient) VerifyDeleteAccountRequest(reqDto *dto.Verif
 * @returns IsSuccessRespDto
  */
 func (client *AuthenticationClient) DeleteAccount(reqDto *dto.DeleteAccounDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/delete-account", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.DeleteAccountWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// DeleteAccountWithContext 同 DeleteAccount，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) DeleteAccountWithContext(ctx context.Context, reqDto *dto.DeleteAccounDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/delete-account", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1572,18 +2108,25 @@ func (client *AuthenticationClient) DeleteAccount(reqDto *dto.DeleteAccounDto) *
 * @returns SystemInfoResp
  */
 func (client *AuthenticationClient) GetSystemInfo() *dto.SystemInfoResp {
-	b, err := client.SendHttpRequest("/api/v3/system", fasthttp.MethodGet, nil)
-	var response dto.SystemInfoResp
+	response, err := client.GetSystemInfoWithContext(context.Background())
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.SystemInfoResp{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetSystemInfoWithContext 同 GetSystemInfo，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) GetSystemInfoWithContext(ctx context.Context) (*dto.SystemInfoResp, error) {
+	var response dto.SystemInfoResp
+	err := client.request(ctx, "/api/v3/system", fasthttp.MethodGet, nil, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1592,18 +2135,25 @@ func (client *AuthenticationClient) GetSystemInfo() *dto.SystemInfoResp {
 * @returns GetCountryListRespDto
  */
 func (client *AuthenticationClient) GetCountryList() *dto.GetCountryListRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-country-list", fasthttp.MethodGet, nil)
-	var response dto.GetCountryListRespDto
+	response, err := client.GetCountryListWithContext(context.Background())
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.GetCountryListRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetCountryListWithContext 同 GetCountryList，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) GetCountryListWithContext(ctx context.Context) (*dto.GetCountryListRespDto, error) {
+	var response dto.GetCountryListRespDto
+	err := client.request(ctx, "/api/v3/get-country-list", fasthttp.MethodGet, nil, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1612,18 +2162,25 @@ func (client *AuthenticationClient) GetCountryList() *dto.GetCountryListRespDto
 * @returns PreCheckCodeRespDto
  */
 func (client *AuthenticationClient) PreCheckCode(reqDto *dto.PreCheckCodeDto) *dto.PreCheckCodeRespDto {
-	b, err := client.SendHttpRequest("/api/v3/pre-check-code", fasthttp.MethodPost, reqDto)
-	var response dto.PreCheckCodeRespDto
+	response, err := client.PreCheckCodeWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.PreCheckCodeRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// PreCheckCodeWithContext 同 PreCheckCode，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) PreCheckCodeWithContext(ctx context.Context, reqDto *dto.PreCheckCodeDto) (*dto.PreCheckCodeRespDto, error) {
+	var response dto.PreCheckCodeRespDto
+	err := client.request(ctx, "/api/v3/pre-check-code", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1632,18 +2189,25 @@ func (client *AuthenticationClient) PreCheckCode(reqDto *dto.PreCheckCodeDto) *d
 * @returns SendEnrollFactorRequestRespDto
  */
 func (client *AuthenticationClient) SendEnrollFactorRequest(reqDto *dto.SendEnrollFactorRequestDto) *dto.SendEnrollFactorRequestRespDto {
-	b, err := client.SendHttpRequest("/api/v3/send-enroll-factor-request", fasthttp.MethodPost, reqDto)
-	var response dto.SendEnrollFactorRequestRespDto
+	response, err := client.SendEnrollFactorRequestWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.SendEnrollFactorRequestRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// SendEnrollFactorRequestWithContext 同 SendEnrollFactorRequest，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) SendEnrollFactorRequestWithContext(ctx context.Context, reqDto *dto.SendEnrollFactorRequestDto) (*dto.SendEnrollFactorRequestRespDto, error) {
+	var response dto.SendEnrollFactorRequestRespDto
+	err := client.request(ctx, "/api/v3/send-enroll-factor-request", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1652,18 +2216,25 @@ func (client *AuthenticationClient) SendEnrollFactorRequest(reqDto *dto.SendEnro
 * @returns EnrollFactorRespDto
  */
 func (client *AuthenticationClient) EnrollFactor(reqDto *dto.EnrollFactorDto) *dto.EnrollFactorRespDto {
-	b, err := client.SendHttpRequest("/api/v3/enroll-factor", fasthttp.MethodPost, reqDto)
-	var response dto.EnrollFactorRespDto
+	response, err := client.EnrollFactorWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.EnrollFactorRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// EnrollFactorWithContext 同 EnrollFactor，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) EnrollFactorWithContext(ctx context.Context, reqDto *dto.EnrollFactorDto) (*dto.EnrollFactorRespDto, error) {
+	var response dto.EnrollFactorRespDto
+	err := client.request(ctx, "/api/v3/enroll-factor", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1672,18 +2243,25 @@ func (client *AuthenticationClient) EnrollFactor(reqDto *dto.EnrollFactorDto) *d
 * @returns ResetFactorRespDto
  */
 func (client *AuthenticationClient) ResetFactor(reqDto *dto.ResetFactorDto) *dto.ResetFactorRespDto {
-	b, err := client.SendHttpRequest("/api/v3/reset-factor", fasthttp.MethodPost, reqDto)
-	var response dto.ResetFactorRespDto
+	response, err := client.ResetFactorWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.ResetFactorRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ResetFactorWithContext 同 ResetFactor，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) ResetFactorWithContext(ctx context.Context, reqDto *dto.ResetFactorDto) (*dto.ResetFactorRespDto, error) {
+	var response dto.ResetFactorRespDto
+	err := client.request(ctx, "/api/v3/reset-factor", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1692,18 +2270,25 @@ func (client *AuthenticationClient) ResetFactor(reqDto *dto.ResetFactorDto) *dto
 * @returns ListEnrolledFactorsRespDto
  */
 func (client *AuthenticationClient) ListEnrolledFactors() *dto.ListEnrolledFactorsRespDto {
-	b, err := client.SendHttpRequest("/api/v3/list-enrolled-factors", fasthttp.MethodGet, nil)
-	var response dto.ListEnrolledFactorsRespDto
+	response, err := client.ListEnrolledFactorsWithContext(context.Background())
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.ListEnrolledFactorsRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ListEnrolledFactorsWithContext 同 ListEnrolledFactors，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) ListEnrolledFactorsWithContext(ctx context.Context) (*dto.ListEnrolledFactorsRespDto, error) {
+	var response dto.ListEnrolledFactorsRespDto
+	err := client.request(ctx, "/api/v3/list-enrolled-factors", fasthttp.MethodGet, nil, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1712,18 +2297,25 @@ func (client *AuthenticationClient) ListEnrolledFactors() *dto.ListEnrolledFacto
 * @returns GetFactorRespDto
  */
 func (client *AuthenticationClient) GetFactor(reqDto *dto.GetFactorDto) *dto.GetFactorRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-factor", fasthttp.MethodGet, reqDto)
-	var response dto.GetFactorRespDto
+	response, err := client.GetFactorWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.GetFactorRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetFactorWithContext 同 GetFactor，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) GetFactorWithContext(ctx context.Context, reqDto *dto.GetFactorDto) (*dto.GetFactorRespDto, error) {
+	var response dto.GetFactorRespDto
+	err := client.request(ctx, "/api/v3/get-factor", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1732,18 +2324,25 @@ func (client *AuthenticationClient) GetFactor(reqDto *dto.GetFactorDto) *dto.Get
 * @returns ListFactorsToEnrollRespDto
  */
 func (client *AuthenticationClient) ListFactorsToEnroll() *dto.ListFactorsToEnrollRespDto {
-	b, err := client.SendHttpRequest("/api/v3/list-factors-to-enroll", fasthttp.MethodGet, nil)
-	var response dto.ListFactorsToEnrollRespDto
+	response, err := client.ListFactorsToEnrollWithContext(context.Background())
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.ListFactorsToEnrollRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ListFactorsToEnrollWithContext 同 ListFactorsToEnroll，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) ListFactorsToEnrollWithContext(ctx context.Context) (*dto.ListFactorsToEnrollRespDto, error) {
+	var response dto.ListFactorsToEnrollRespDto
+	err := client.request(ctx, "/api/v3/list-factors-to-enroll", fasthttp.MethodGet, nil, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1752,18 +2351,25 @@ func (client *AuthenticationClient) ListFactorsToEnroll() *dto.ListFactorsToEnro
 * @returns MfaOtpVerityRespDto
  */
 func (client *AuthenticationClient) MfaOtpVerify(reqDto *dto.MfaOtpVerityDto) *dto.MfaOtpVerityRespDto {
-	b, err := client.SendHttpRequest("/api/v3/mfa-totp-verify", fasthttp.MethodPost, reqDto)
-	var response dto.MfaOtpVerityRespDto
+	response, err := client.MfaOtpVerifyWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.MfaOtpVerityRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// MfaOtpVerifyWithContext 同 MfaOtpVerify，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) MfaOtpVerifyWithContext(ctx context.Context, reqDto *dto.MfaOtpVerityDto) (*dto.MfaOtpVerityRespDto, error) {
+	var response dto.MfaOtpVerityRespDto
+	err := client.request(ctx, "/api/v3/mfa-totp-verify", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1774,18 +2380,25 @@ func (client *AuthenticationClient) MfaOtpVerify(reqDto *dto.MfaOtpVerityDto) *d
 	* @returns GenerateBindExtIdpLinkRespDto
 */
 func (client *AuthenticationClient) GenerateLinkExtIdpUrl(reqDto *dto.GenerateLinkExtidpUrlDto) *dto.GenerateBindExtIdpLinkRespDto {
-	b, err := client.SendHttpRequest("/api/v3/generate-link-extidp-url", fasthttp.MethodGet, reqDto)
-	var response dto.GenerateBindExtIdpLinkRespDto
+	response, err := client.GenerateLinkExtIdpUrlWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.GenerateBindExtIdpLinkRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GenerateLinkExtIdpUrlWithContext 同 GenerateLinkExtIdpUrl，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) GenerateLinkExtIdpUrlWithContext(ctx context.Context, reqDto *dto.GenerateLinkExtidpUrlDto) (*dto.GenerateBindExtIdpLinkRespDto, error) {
+	var response dto.GenerateBindExtIdpLinkRespDto
+	err := client.request(ctx, "/api/v3/generate-link-extidp-url", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1794,18 +2407,25 @@ func (client *AuthenticationClient) GenerateLinkExtIdpUrl(reqDto *dto.GenerateLi
 * @returns CommonResponseDto
  */
 func (client *AuthenticationClient) UnlinkExtIdp(reqDto *dto.UnlinkExtIdpDto) *dto.CommonResponseDto {
-	b, err := client.SendHttpRequest("/api/v3/unlink-extidp", fasthttp.MethodPost, reqDto)
-	var response dto.CommonResponseDto
+	response, err := client.UnlinkExtIdpWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CommonResponseDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// UnlinkExtIdpWithContext 同 UnlinkExtIdp，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) UnlinkExtIdpWithContext(ctx context.Context, reqDto *dto.UnlinkExtIdpDto) (*dto.CommonResponseDto, error) {
+	var response dto.CommonResponseDto
+	err := client.request(ctx, "/api/v3/unlink-extidp", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1849,18 +2469,25 @@ func (client *AuthenticationClient) UnlinkExtIdp(reqDto *dto.UnlinkExtIdpDto) *d
 	* @returns GetIdentitiesRespDto
 */
 func (client *AuthenticationClient) GetIdentities() *dto.GetIdentitiesRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-identities", fasthttp.MethodGet, nil)
-	var response dto.GetIdentitiesRespDto
+	response, err := client.GetIdentitiesWithContext(context.Background())
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.GetIdentitiesRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetIdentitiesWithContext 同 GetIdentities，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) GetIdentitiesWithContext(ctx context.Context) (*dto.GetIdentitiesRespDto, error) {
+	var response dto.GetIdentitiesRespDto
+	err := client.request(ctx, "/api/v3/get-identities", fasthttp.MethodGet, nil, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1869,18 +2496,25 @@ func (client *AuthenticationClient) GetIdentities() *dto.GetIdentitiesRespDto {
 * @returns GetExtIdpsRespDto
  */
 func (client *AuthenticationClient) GetApplicationEnabledExtIdps() *dto.GetExtIdpsRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-application-enabled-extidps", fasthttp.MethodGet, nil)
-	var response dto.GetExtIdpsRespDto
+	response, err := client.GetApplicationEnabledExtIdpsWithContext(context.Background())
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.GetExtIdpsRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetApplicationEnabledExtIdpsWithContext 同 GetApplicationEnabledExtIdps，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) GetApplicationEnabledExtIdpsWithContext(ctx context.Context) (*dto.GetExtIdpsRespDto, error) {
+	var response dto.GetExtIdpsRespDto
+	err := client.request(ctx, "/api/v3/get-application-enabled-extidps", fasthttp.MethodGet, nil, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1896,18 +2530,25 @@ func (client *AuthenticationClient) GetApplicationEnabledExtIdps() *dto.GetExtId
 	* @returns UserSingleRespDto
 */
 func (client *AuthenticationClient) SignUp(reqDto *dto.SignUpDto) *dto.UserSingleRespDto {
-	b, err := client.SendHttpRequest("/api/v3/signup", fasthttp.MethodPost, reqDto)
-	var response dto.UserSingleRespDto
+	response, err := client.SignUpWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.UserSingleRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// SignUpWithContext 同 SignUp，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) SignUpWithContext(ctx context.Context, reqDto *dto.SignUpDto) (*dto.UserSingleRespDto, error) {
+	var response dto.UserSingleRespDto
+	err := client.request(ctx, "/api/v3/signup", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1916,18 +2557,25 @@ func (client *AuthenticationClient) SignUp(reqDto *dto.SignUpDto) *dto.UserSingl
 * @returns DecryptWechatMiniProgramDataRespDto
  */
 func (client *AuthenticationClient) DecryptWechatMiniProgramData(reqDto *dto.DecryptWechatMiniProgramDataDto) *dto.DecryptWechatMiniProgramDataRespDto {
-	b, err := client.SendHttpRequest("/api/v3/decrypt-wechat-miniprogram-data", fasthttp.MethodPost, reqDto)
-	var response dto.DecryptWechatMiniProgramDataRespDto
+	response, err := client.DecryptWechatMiniProgramDataWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.DecryptWechatMiniProgramDataRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// DecryptWechatMiniProgramDataWithContext 同 DecryptWechatMiniProgramData，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) DecryptWechatMiniProgramDataWithContext(ctx context.Context, reqDto *dto.DecryptWechatMiniProgramDataDto) (*dto.DecryptWechatMiniProgramDataRespDto, error) {
+	var response dto.DecryptWechatMiniProgramDataRespDto
+	err := client.request(ctx, "/api/v3/decrypt-wechat-miniprogram-data", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1936,18 +2584,25 @@ func (client *AuthenticationClient) DecryptWechatMiniProgramData(reqDto *dto.Dec
 * @returns GetWechatAccessTokenRespDto
  */
 func (client *AuthenticationClient) GetWechatMpAccessToken(reqDto *dto.GetWechatAccessTokenDto) *dto.GetWechatAccessTokenRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-wechat-access-token", fasthttp.MethodPost, reqDto)
-	var response dto.GetWechatAccessTokenRespDto
+	response, err := client.GetWechatMpAccessTokenWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.GetWechatAccessTokenRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetWechatMpAccessTokenWithContext 同 GetWechatMpAccessToken，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) GetWechatMpAccessTokenWithContext(ctx context.Context, reqDto *dto.GetWechatAccessTokenDto) (*dto.GetWechatAccessTokenRespDto, error) {
+	var response dto.GetWechatAccessTokenRespDto
+	err := client.request(ctx, "/api/v3/get-wechat-access-token", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1956,18 +2611,25 @@ func (client *AuthenticationClient) GetWechatMpAccessToken(reqDto *dto.GetWechat
 * @returns GetLoginHistoryRespDto
  */
 func (client *AuthenticationClient) GetLoginHistory(reqDto *dto.GetMyLoginHistoryDto) *dto.GetLoginHistoryRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-my-login-history", fasthttp.MethodGet, reqDto)
-	var response dto.GetLoginHistoryRespDto
+	response, err := client.GetLoginHistoryWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.GetLoginHistoryRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetLoginHistoryWithContext 同 GetLoginHistory，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) GetLoginHistoryWithContext(ctx context.Context, reqDto *dto.GetMyLoginHistoryDto) (*dto.GetLoginHistoryRespDto, error) {
+	var response dto.GetLoginHistoryRespDto
+	err := client.request(ctx, "/api/v3/get-my-login-history", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1976,18 +2638,25 @@ func (client *AuthenticationClient) GetLoginHistory(reqDto *dto.GetMyLoginHistor
 * @returns GetLoggedInAppsRespDto
  */
 func (client *AuthenticationClient) GetLoggedInApps() *dto.GetLoggedInAppsRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-my-logged-in-apps", fasthttp.MethodGet, nil)
-	var response dto.GetLoggedInAppsRespDto
+	response, err := client.GetLoggedInAppsWithContext(context.Background())
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.GetLoggedInAppsRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetLoggedInAppsWithContext 同 GetLoggedInApps，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) GetLoggedInAppsWithContext(ctx context.Context) (*dto.GetLoggedInAppsRespDto, error) {
+	var response dto.GetLoggedInAppsRespDto
+	err := client.request(ctx, "/api/v3/get-my-logged-in-apps", fasthttp.MethodGet, nil, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1996,18 +2665,25 @@ func (client *AuthenticationClient) GetLoggedInApps() *dto.GetLoggedInAppsRespDt
 * @returns GetAccessibleAppsRespDto
  */
 func (client *AuthenticationClient) GetAccessibleApps() *dto.GetAccessibleAppsRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-my-accessible-apps", fasthttp.MethodGet, nil)
-	var response dto.GetAccessibleAppsRespDto
+	response, err := client.GetAccessibleAppsWithContext(context.Background())
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.GetAccessibleAppsRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetAccessibleAppsWithContext 同 GetAccessibleApps，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) GetAccessibleAppsWithContext(ctx context.Context) (*dto.GetAccessibleAppsRespDto, error) {
+	var response dto.GetAccessibleAppsRespDto
+	err := client.request(ctx, "/api/v3/get-my-accessible-apps", fasthttp.MethodGet, nil, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2016,18 +2692,25 @@ func (client *AuthenticationClient) GetAccessibleApps() *dto.GetAccessibleAppsRe
 * @returns GetTenantListRespDto
  */
 func (client *AuthenticationClient) GetTenantList() *dto.GetTenantListRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-my-tenant-list", fasthttp.MethodGet, nil)
-	var response dto.GetTenantListRespDto
+	response, err := client.GetTenantListWithContext(context.Background())
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.GetTenantListRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetTenantListWithContext 同 GetTenantList，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) GetTenantListWithContext(ctx context.Context) (*dto.GetTenantListRespDto, error) {
+	var response dto.GetTenantListRespDto
+	err := client.request(ctx, "/api/v3/get-my-tenant-list", fasthttp.MethodGet, nil, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2036,18 +2719,25 @@ func (client *AuthenticationClient) GetTenantList() *dto.GetTenantListRespDto {
 * @returns RoleListRespDto
  */
 func (client *AuthenticationClient) GetRoleList(reqDto *dto.GetMyRoleListDto) *dto.RoleListRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-my-role-list", fasthttp.MethodGet, reqDto)
-	var response dto.RoleListRespDto
+	response, err := client.GetRoleListWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.RoleListRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetRoleListWithContext 同 GetRoleList，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) GetRoleListWithContext(ctx context.Context, reqDto *dto.GetMyRoleListDto) (*dto.RoleListRespDto, error) {
+	var response dto.RoleListRespDto
+	err := client.request(ctx, "/api/v3/get-my-role-list", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2056,18 +2746,25 @@ func (client *AuthenticationClient) GetRoleList(reqDto *dto.GetMyRoleListDto) *d
 * @returns GroupListRespDto
  */
 func (client *AuthenticationClient) GetGroupList() *dto.GroupListRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-my-group-list", fasthttp.MethodGet, nil)
-	var response dto.GroupListRespDto
+	response, err := client.GetGroupListWithContext(context.Background())
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.GroupListRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetGroupListWithContext 同 GetGroupList，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) GetGroupListWithContext(ctx context.Context) (*dto.GroupListRespDto, error) {
+	var response dto.GroupListRespDto
+	err := client.request(ctx, "/api/v3/get-my-group-list", fasthttp.MethodGet, nil, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2076,18 +2773,25 @@ func (client *AuthenticationClient) GetGroupList() *dto.GroupListRespDto {
 * @returns UserDepartmentPaginatedRespDto
  */
 func (client *AuthenticationClient) GetDepartmentList(reqDto *dto.GetMyDepartmentListDto) *dto.UserDepartmentPaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-my-department-list", fasthttp.MethodGet, reqDto)
-	var response dto.UserDepartmentPaginatedRespDto
+	response, err := client.GetDepartmentListWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.UserDepartmentPaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetDepartmentListWithContext 同 GetDepartmentList，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) GetDepartmentListWithContext(ctx context.Context, reqDto *dto.GetMyDepartmentListDto) (*dto.UserDepartmentPaginatedRespDto, error) {
+	var response dto.UserDepartmentPaginatedRespDto
+	err := client.request(ctx, "/api/v3/get-my-department-list", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2096,18 +2800,25 @@ func (client *AuthenticationClient) GetDepartmentList(reqDto *dto.GetMyDepartmen
 * @returns AuthorizedResourcePaginatedRespDto
  */
 func (client *AuthenticationClient) GetAuthorizedResources(reqDto *dto.GetMyAuthorizedResourcesDto) *dto.AuthorizedResourcePaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-my-authorized-resources", fasthttp.MethodGet, reqDto)
-	var response dto.AuthorizedResourcePaginatedRespDto
+	response, err := client.GetAuthorizedResourcesWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.AuthorizedResourcePaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetAuthorizedResourcesWithContext 同 GetAuthorizedResources，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) GetAuthorizedResourcesWithContext(ctx context.Context, reqDto *dto.GetMyAuthorizedResourcesDto) (*dto.AuthorizedResourcePaginatedRespDto, error) {
+	var response dto.AuthorizedResourcePaginatedRespDto
+	err := client.request(ctx, "/api/v3/get-my-authorized-resources", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2116,18 +2827,25 @@ func (client *AuthenticationClient) GetAuthorizedResources(reqDto *dto.GetMyAuth
 * @returns CheckResourcePermissionsRespDto
  */
 func (client *AuthenticationClient) CheckPermissionByStringResource(reqDto *dto.CheckPermissionStringResourceDto) *dto.CheckResourcePermissionsRespDto {
-	b, err := client.SendHttpRequest("/api/v3/check-permission-string-resource", fasthttp.MethodPost, reqDto)
-	var response dto.CheckResourcePermissionsRespDto
+	response, err := client.CheckPermissionByStringResourceWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CheckResourcePermissionsRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// CheckPermissionByStringResourceWithContext 同 CheckPermissionByStringResource，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) CheckPermissionByStringResourceWithContext(ctx context.Context, reqDto *dto.CheckPermissionStringResourceDto) (*dto.CheckResourcePermissionsRespDto, error) {
+	var response dto.CheckResourcePermissionsRespDto
+	err := client.request(ctx, "/api/v3/check-permission-string-resource", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2136,18 +2854,25 @@ func (client *AuthenticationClient) CheckPermissionByStringResource(reqDto *dto.
 * @returns CheckResourcePermissionsRespDto
  */
 func (client *AuthenticationClient) CheckPermissionByArrayResource(reqDto *dto.CheckPermissionArrayResourceDto) *dto.CheckResourcePermissionsRespDto {
-	b, err := client.SendHttpRequest("/api/v3/check-permission-array-resource", fasthttp.MethodPost, reqDto)
-	var response dto.CheckResourcePermissionsRespDto
+	response, err := client.CheckPermissionByArrayResourceWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CheckResourcePermissionsRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// CheckPermissionByArrayResourceWithContext 同 CheckPermissionByArrayResource，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) CheckPermissionByArrayResourceWithContext(ctx context.Context, reqDto *dto.CheckPermissionArrayResourceDto) (*dto.CheckResourcePermissionsRespDto, error) {
+	var response dto.CheckResourcePermissionsRespDto
+	err := client.request(ctx, "/api/v3/check-permission-array-resource", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2156,18 +2881,25 @@ func (client *AuthenticationClient) CheckPermissionByArrayResource(reqDto *dto.C
 * @returns CheckResourcePermissionsRespDto
  */
 func (client *AuthenticationClient) CheckPermissionByTreeResource(reqDto *dto.CheckPermissionTreeResourceDto) *dto.CheckResourcePermissionsRespDto {
-	b, err := client.SendHttpRequest("/api/v3/check-permission-tree-resource", fasthttp.MethodPost, reqDto)
-	var response dto.CheckResourcePermissionsRespDto
+	response, err := client.CheckPermissionByTreeResourceWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CheckResourcePermissionsRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// CheckPermissionByTreeResourceWithContext 同 CheckPermissionByTreeResource，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) CheckPermissionByTreeResourceWithContext(ctx context.Context, reqDto *dto.CheckPermissionTreeResourceDto) (*dto.CheckResourcePermissionsRespDto, error) {
+	var response dto.CheckResourcePermissionsRespDto
+	err := client.request(ctx, "/api/v3/check-permission-tree-resource", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2176,18 +2908,25 @@ func (client *AuthenticationClient) CheckPermissionByTreeResource(reqDto *dto.Ch
 * @returns GetUserAuthResourceListRespDto
  */
 func (client *AuthenticationClient) GetUserAuthorizedResourcesList() *dto.GetUserAuthResourceListRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-user-auth-resource-list", fasthttp.MethodGet, nil)
-	var response dto.GetUserAuthResourceListRespDto
+	response, err := client.GetUserAuthorizedResourcesListWithContext(context.Background())
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.GetUserAuthResourceListRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetUserAuthorizedResourcesListWithContext 同 GetUserAuthorizedResourcesList，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) GetUserAuthorizedResourcesListWithContext(ctx context.Context) (*dto.GetUserAuthResourceListRespDto, error) {
+	var response dto.GetUserAuthResourceListRespDto
+	err := client.request(ctx, "/api/v3/get-user-auth-resource-list", fasthttp.MethodGet, nil, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 // ==== AUTO GENERATED AUTHENTICATION METHODS END ====
@@ -2197,18 +2936,25 @@ func (client *AuthenticationClient) GetUserAuthorizedResourcesList() *dto.GetUse
 * @returns GetUserAuthResourcePermissionListRespDto
  */
 func (client *AuthenticationClient) getUserAuthResourcePermissionList(reqDto *dto.GetUserAuthResourcePermissionListDto) *dto.GetUserAuthResourcePermissionListRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-user-auth-resource-permission-list", fasthttp.MethodPost, reqDto)
-	var response dto.GetUserAuthResourcePermissionListRespDto
+	response, err := client.getUserAuthResourcePermissionListWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.GetUserAuthResourcePermissionListRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// getUserAuthResourcePermissionListWithContext 同 getUserAuthResourcePermissionList，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) getUserAuthResourcePermissionListWithContext(ctx context.Context, reqDto *dto.GetUserAuthResourcePermissionListDto) (*dto.GetUserAuthResourcePermissionListRespDto, error) {
+	var response dto.GetUserAuthResourcePermissionListRespDto
+	err := client.request(ctx, "/api/v3/get-user-auth-resource-permission-list", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2217,18 +2963,25 @@ func (client *AuthenticationClient) getUserAuthResourcePermissionList(reqDto *dt
 * @returns GetUserAuthResourceStructRespDto
  */
 func (client *AuthenticationClient) getUserAuthResourceStruct(reqDto *dto.GetUserAuthResourceStructDto) *dto.GetUserAuthResourceStructRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-user-auth-resource-struct", fasthttp.MethodPost, reqDto)
-	var response dto.GetUserAuthResourceStructRespDto
+	response, err := client.getUserAuthResourceStructWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.GetUserAuthResourceStructRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// getUserAuthResourceStructWithContext 同 getUserAuthResourceStruct，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) getUserAuthResourceStructWithContext(ctx context.Context, reqDto *dto.GetUserAuthResourceStructDto) (*dto.GetUserAuthResourceStructRespDto, error) {
+	var response dto.GetUserAuthResourceStructRespDto
+	err := client.request(ctx, "/api/v3/get-user-auth-resource-struct", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2239,19 +2992,26 @@ func (client *AuthenticationClient) getUserAuthResourceStruct(reqDto *dto.GetUse
  * @returns IsSuccessRespDto
  */
 func (client *AuthenticationClient) PubEvent(eventCode string, data interface{}) *dto.IsSuccessRespDto {
-	var reqDto = dto.NewEventReqDto(eventCode, data)
-	b, err := client.SendHttpRequest("/api/v3/pub-userEvent", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.PubEventWithContext(context.Background(), eventCode, data)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// PubEventWithContext 同 PubEvent，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *AuthenticationClient) PubEventWithContext(ctx context.Context, eventCode string, data interface{}) (*dto.IsSuccessRespDto, error) {
+	var reqDto = dto.NewEventReqDto(eventCode, data)
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/pub-userEvent", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -0,0 +1,89 @@
+package authentication
+
+import (
+	"crypto"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Authing/authing-golang-sdk/v3/util"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const ClientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+
+// clientAssertionTTL client assertion 的有效期，每次请求重新生成
+const clientAssertionTTL = 5 * time.Minute
+
+// parseClientAssertionKey 解析 private_key_jwt 使用的 PEM 私钥，RSA 私钥使用 RS256，EC 私钥使用 ES256
+func parseClientAssertionKey(pemKey string) (crypto.Signer, jwt.SigningMethod, error) {
+	if key, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(pemKey)); err == nil {
+		return key, jwt.SigningMethodRS256, nil
+	}
+	if key, err := jwt.ParseECPrivateKeyFromPEM([]byte(pemKey)); err == nil {
+		if key.Curve.Params().BitSize != 256 {
+			return nil, nil, fmt.Errorf("private_key_jwt 仅支持 P-256 曲线的 EC 私钥")
+		}
+		return key, jwt.SigningMethodES256, nil
+	}
+	return nil, nil, errors.New("无法解析 PrivateKey，需为 PEM 格式的 RSA 或 EC 私钥")
+}
+
+// authMethodOf 返回端点使用的认证方式，未单独配置时使用 TokenEndPointAuthMethod
+func (client *AuthenticationClient) authMethodOf(method TokenAuthMethodEnum) TokenAuthMethodEnum {
+	if method == "" {
+		return client.options.TokenEndPointAuthMethod
+	}
+	return method
+}
+
+// applyClientAuth 按认证方式将客户端凭证写入请求头或请求体，所有 token 相关端点统一使用。
+// audience 为 client assertion 的 aud，通常为 token 端点地址
+func (client *AuthenticationClient) applyClientAuth(method TokenAuthMethodEnum, audience string, header map[string]string, body map[string]string) error {
+	appId := client.options.AppId
+	switch method {
+	case ClientSecretPost:
+		body["client_id"] = appId
+		body["client_secret"] = client.options.AppSecret
+	case ClientSecretBasic:
+		header["Authorization"] = "Basic " + base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s", appId, client.options.AppSecret)))
+	case ClientSecretJwt, PrivateKeyJwt:
+		assertion, err := client.buildClientAssertion(method, audience)
+		if err != nil {
+			return err
+		}
+		body["client_id"] = appId
+		body["client_assertion_type"] = ClientAssertionType
+		body["client_assertion"] = assertion
+	case None:
+		body["client_id"] = appId
+	default:
+		return fmt.Errorf("不支持的端点认证方式: %s", method)
+	}
+	return nil
+}
+
+// buildClientAssertion 生成 client_secret_jwt（HS256）或 private_key_jwt（RS256/ES256）的 client assertion
+func (client *AuthenticationClient) buildClientAssertion(method TokenAuthMethodEnum, audience string) (string, error) {
+	now := time.Now()
+	claims := jwt.RegisteredClaims{
+		Issuer:    client.options.AppId,
+		Subject:   client.options.AppId,
+		Audience:  jwt.ClaimStrings{audience},
+		ID:        util.RandStringImpr(32),
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(clientAssertionTTL)),
+	}
+	if method == ClientSecretJwt {
+		return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(client.options.AppSecret))
+	}
+	if client.privateKey == nil {
+		return "", errors.New("使用 private_key_jwt 时必须配置 PrivateKey")
+	}
+	token := jwt.NewWithClaims(client.privateKeyMethod, claims)
+	if client.options.PrivateKeyId != "" {
+		token.Header["kid"] = client.options.PrivateKeyId
+	}
+	return token.SignedString(client.privateKey)
+}
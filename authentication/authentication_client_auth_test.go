@@ -0,0 +1,129 @@
+package authentication
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const testTokenEndpointResponse = `{"access_token":"at","active":true}`
+
+func callTokenEndpoints(t *testing.T, client *AuthenticationClient) {
+	if _, err := client.GetAccessTokenByCode("code"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GetNewAccessTokenByRefreshToken("rt"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.IntrospectToken("at"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.RevokeToken("at"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPrivateKeyJwt(t *testing.T) {
+	server := newStubServer(stubResponse(http.StatusOK, testTokenEndpointResponse))
+	defer server.Close()
+
+	key := newTestRSAKey(t)
+	client := newTestClient(t, server.URL, withoutSecret, withPrivateKeyJwt(key, "key-1"))
+	callTokenEndpoints(t, client)
+
+	jtis := map[string]bool{}
+	for _, request := range server.requests("") {
+		form := request.Form
+		if _, ok := form["client_secret"]; ok || request.Header.Get("Authorization") != "" {
+			t.Fatalf("%s: private_key_jwt 不应发送 client_secret", request.Path)
+		}
+		if form.Get("client_id") != "app" || form.Get("client_assertion_type") != ClientAssertionType {
+			t.Fatalf("%s: client assertion 参数不符合预期: %v", request.Path, form)
+		}
+		claims := &jwt.RegisteredClaims{}
+		token, err := jwt.ParseWithClaims(form.Get("client_assertion"), claims, func(token *jwt.Token) (interface{}, error) {
+			return &key.PublicKey, nil
+		}, jwt.WithValidMethods([]string{"RS256"}), jwt.WithIssuer("app"), jwt.WithAudience(server.URL+"/oidc/token"))
+		if err != nil {
+			t.Fatalf("%s: client assertion 校验失败: %v", request.Path, err)
+		}
+		if token.Header["kid"] != "key-1" || claims.Subject != "app" || claims.ID == "" {
+			t.Fatalf("%s: client assertion 内容不符合预期: %+v %v", request.Path, claims, token.Header)
+		}
+		if claims.ExpiresAt.Sub(time.Now()) > clientAssertionTTL {
+			t.Fatalf("%s: client assertion 有效期过长", request.Path)
+		}
+		if jtis[claims.ID] {
+			t.Fatalf("%s: jti 不应重复", request.Path)
+		}
+		jtis[claims.ID] = true
+	}
+	if len(jtis) != 4 {
+		t.Fatalf("期望 4 个请求, 实际 %d", len(jtis))
+	}
+}
+
+func TestClientSecretJwt(t *testing.T) {
+	server := newStubServer(stubResponse(http.StatusOK, testTokenEndpointResponse))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL, func(options *AuthenticationClientOptions) {
+		options.TokenEndPointAuthMethod = ClientSecretJwt
+	})
+	callTokenEndpoints(t, client)
+	for _, request := range server.requests("") {
+		if _, ok := request.Form["client_secret"]; ok {
+			t.Fatalf("%s: client_secret_jwt 不应发送 client_secret", request.Path)
+		}
+		_, err := jwt.Parse(request.Form.Get("client_assertion"), func(token *jwt.Token) (interface{}, error) {
+			return []byte("secret"), nil
+		}, jwt.WithValidMethods([]string{"HS256"}))
+		if err != nil {
+			t.Fatalf("%s: client assertion 校验失败: %v", request.Path, err)
+		}
+	}
+}
+
+func TestClientSecretBasic_PerEndpointMethod(t *testing.T) {
+	server := newStubServer(stubResponse(http.StatusOK, testTokenEndpointResponse))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL, func(options *AuthenticationClientOptions) {
+		options.TokenEndPointAuthMethod = ClientSecretBasic
+		options.IntrospectionEndPointAuthMethod = ClientSecretPost
+	})
+	callTokenEndpoints(t, client)
+	for _, request := range server.requests("") {
+		introspection := strings.HasSuffix(request.Path, "/introspection")
+		if introspection != (request.Header.Get("Authorization") == "") || introspection != (request.Form.Get("client_secret") == "secret") {
+			t.Fatalf("%s: 端点认证方式不符合预期: %+v", request.Path, request)
+		}
+	}
+}
+
+func TestPrivateKeyJwt_RequiresKey(t *testing.T) {
+	_, err := NewAuthenticationClient(newTestOptions("https://example.authing.cn", withoutSecret, func(options *AuthenticationClientOptions) {
+		options.TokenEndPointAuthMethod = PrivateKeyJwt
+	}))
+	if err == nil {
+		t.Fatal("未配置 PrivateKey 时应返回错误")
+	}
+}
+
+func TestPrivateKeyJwt_RejectsHS256WithoutSecret(t *testing.T) {
+	client := newTestClient(t, "https://example.authing.cn", withoutSecret, withPrivateKeyJwt(newTestRSAKey(t), ""))
+	forged, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"iss": testIssuer,
+		"sub": "user",
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}).SignedString([]byte(""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.IntrospectAccessTokenOffline(forged); err == nil {
+		t.Fatal("private_key_jwt 未配置 AppSecret 时不应接受 HS256 签名")
+	}
+}
@@ -0,0 +1,241 @@
+package authentication
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Authing/authing-golang-sdk/v3/util"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestBuildAuthorizeUrlByOidc_PKCE(t *testing.T) {
+	client := newTestClient(t, "https://example.authing.cn", withoutSecret)
+	result, err := client.BuildAuthorizeUrlByOidc(&OIDCAuthURLParams{CodeChallengeMethod: CodeChallengeS256})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.CodeVerifier) < 43 {
+		t.Fatalf("code_verifier 长度不足: %q", result.CodeVerifier)
+	}
+	u, err := url.Parse(result.Url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := u.Query()
+	if query.Get("code_challenge_method") != "S256" {
+		t.Fatalf("code_challenge_method 不符合预期: %s", result.Url)
+	}
+	if query.Get("code_challenge") != util.CodeChallenge(result.CodeVerifier, util.PKCEMethodS256) {
+		t.Fatalf("code_challenge 与 code_verifier 不匹配: %s", result.Url)
+	}
+
+	if _, err = client.BuildAuthorizeUrlByOidc(&OIDCAuthURLParams{CodeChallengeMethod: "S512"}); err == nil {
+		t.Fatal("不支持的 code_challenge_method 应返回错误")
+	}
+}
+
+func TestBuildAuthorizeResultByOauth_PKCE(t *testing.T) {
+	client := newTestClient(t, "https://example.authing.cn", withoutSecret)
+	result, err := client.BuildAuthorizeResultByOauth(&OAuth2AuthURLParams{
+		CodeChallengeMethod: CodeChallengePlain,
+		CodeVerifier:        "verifier",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, _ := url.Parse(result.Url)
+	if u.Query().Get("code_challenge") != "verifier" || u.Query().Get("state") != result.State {
+		t.Fatalf("授权链接不符合预期: %s", result.Url)
+	}
+}
+
+func TestGetAccessTokenByCodeWithParams_PKCE(t *testing.T) {
+	server := newStubServer(stubResponse(http.StatusOK, `{"access_token":"at","token_type":"Bearer","expires_in":3600}`))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL, withoutSecret)
+	resp, err := client.GetAccessTokenByCodeWithParams(&CodeToTokenParams{
+		Code:         "code",
+		RedirectUri:  "https://example.com/other",
+		CodeVerifier: "verifier",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.AccessToken != "at" {
+		t.Fatalf("access_token 不符合预期: %+v", resp)
+	}
+	form := server.lastRequest().Form
+	if form.Get("code_verifier") != "verifier" || form.Get("redirect_uri") != "https://example.com/other" {
+		t.Fatalf("请求参数不符合预期: %v", form)
+	}
+	if form.Get("client_id") != "app" {
+		t.Fatalf("缺少 client_id: %v", form)
+	}
+	if _, ok := form["client_secret"]; ok {
+		t.Fatalf("TokenEndPointAuthMethod 为 none 时不应发送 client_secret: %v", form)
+	}
+}
+
+func TestBuildAuthorizeUrlByOidc_ResponseModeAndExtraParams(t *testing.T) {
+	client := newTestClient(t, "https://example.authing.cn", withoutSecret)
+	maxAge := 0
+	result, err := client.BuildAuthorizeUrlByOidc(&OIDCAuthURLParams{
+		ResponseType: "code id_token",
+		ResponseMode: "form_post",
+		Prompt:       "none",
+		LoginHint:    "user@example.com",
+		UiLocales:    "zh-CN",
+		AcrValues:    "mfa",
+		MaxAge:       &maxAge,
+		TenantId:     "tenant-1",
+		ExtraParams:  map[string]string{"foo": "bar", "client_id": "other"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, _ := url.Parse(result.Url)
+	expected := map[string]string{
+		"response_type": "code id_token",
+		"response_mode": "form_post",
+		"prompt":        "none",
+		"login_hint":    "user@example.com",
+		"ui_locales":    "zh-CN",
+		"acr_values":    "mfa",
+		"max_age":       "0",
+		"tenant_id":     "tenant-1",
+		"foo":           "bar",
+		"client_id":     "app",
+	}
+	for key, value := range expected {
+		if actual := u.Query().Get(key); actual != value {
+			t.Fatalf("%s 期望 %q, 实际 %q", key, value, actual)
+		}
+	}
+}
+
+func TestParseAuthorizeResponse(t *testing.T) {
+	resp, err := ParseAuthorizeResponse("https://example.com/callback#code=c&id_token=t&state=s&expires_in=60")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Code != "c" || resp.IDToken != "t" || resp.State != "s" || resp.ExpiresIn != 60 {
+		t.Fatalf("解析结果不符合预期: %+v", resp)
+	}
+
+	resp, err = ParseAuthorizeResponse("https://example.com/callback?error=login_required&error_description=need+login&state=s")
+	authorizeError, ok := err.(*AuthorizeError)
+	if !ok || authorizeError.ErrorCode != "login_required" || authorizeError.State != "s" {
+		t.Fatalf("期望 *AuthorizeError, 实际 %v", err)
+	}
+	if resp == nil || resp.ErrorDescription != "need login" {
+		t.Fatalf("解析结果不符合预期: %+v", resp)
+	}
+}
+
+func TestParseAuthorizeResponseFromRequest_FormPost(t *testing.T) {
+	r := httptest.NewRequest(http.MethodPost, "/callback", strings.NewReader("code=c&state=s"))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := ParseAuthorizeResponseFromRequest(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Code != "c" || resp.State != "s" {
+		t.Fatalf("解析结果不符合预期: %+v", resp)
+	}
+}
+
+func TestRevokeToken(t *testing.T) {
+	status, body := http.StatusOK, ""
+	server := newStubServer(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	})
+	defer server.Close()
+	client := newTestClient(t, server.URL, withoutSecret)
+
+	ok, err := client.RevokeTokenWithContext(context.Background(), "rt", RefreshTokenHint)
+	form := server.lastRequest().Form
+	if !ok || err != nil || form.Get("token") != "rt" || form.Get("token_type_hint") != "refresh_token" {
+		t.Fatalf("撤销请求不符合预期: %v %v %v", ok, err, form)
+	}
+
+	status, body = http.StatusBadRequest, `{"error":"unsupported_token_type"}`
+	ok, err = client.RevokeToken("rt")
+	var oauthError *OAuthError
+	if ok || !errors.As(err, &oauthError) || oauthError.ErrorCode != "unsupported_token_type" || oauthError.StatusCode != http.StatusBadRequest {
+		t.Fatalf("撤销失败时应返回 OAuthError: %v %v", ok, err)
+	}
+	if form = server.lastRequest().Form; form.Get("token_type_hint") != "" {
+		t.Fatalf("未指定时不应传 token_type_hint: %v", form)
+	}
+
+	status, body = http.StatusServiceUnavailable, "unavailable"
+	if _, err = client.RevokeToken("rt"); !errors.As(err, &oauthError) || oauthError.ErrorCode != "server_error" {
+		t.Fatalf("非 2xx 响应应返回 OAuthError: %v", err)
+	}
+}
+
+func TestIntrospectToken(t *testing.T) {
+	status := http.StatusOK
+	server := newStubServer(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		if status != http.StatusOK {
+			w.Write([]byte(`{"error":"invalid_client"}`))
+			return
+		}
+		if r.PostForm.Get("token") != "at" {
+			w.Write([]byte(`{"active":false}`))
+			return
+		}
+		w.Write([]byte(`{"active":true,"sub":"user-1","client_id":"app","scope":"openid","exp":` +
+			strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10) + `,"token_type":"Bearer"}`))
+	})
+	defer server.Close()
+	client := newTestClient(t, server.URL, withoutSecret, func(options *AuthenticationClientOptions) {
+		options.IntrospectionCache = NewIntrospectionCache(time.Minute)
+	})
+
+	for i := 0; i < 2; i++ {
+		response, err := client.IntrospectToken("at")
+		if err != nil || !response.Active || response.Sub != "user-1" || response.ClientId != "app" || response.Scope != "openid" || response.Exp == 0 {
+			t.Fatalf("检查结果不符合预期: %+v %v", response, err)
+		}
+		response, err = client.IntrospectToken("revoked")
+		if err != nil || response.Active {
+			t.Fatalf("已失效的 token 应返回 active=false: %+v %v", response, err)
+		}
+	}
+	if n := server.hits(""); n != 2 {
+		t.Fatalf("命中缓存时不应再次请求检查端点, 实际请求 %d 次", n)
+	}
+
+	status = http.StatusUnauthorized
+	_, err := client.IntrospectToken("other")
+	var oauthError *OAuthError
+	if !errors.As(err, &oauthError) || oauthError.ErrorCode != "invalid_client" || oauthError.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("检查失败时应返回 OAuthError: %v", err)
+	}
+}
+
+func TestIntrospectAccessTokenOffline_EmptySecretRejectsHS256(t *testing.T) {
+	client := newTestClient(t, "https://example.authing.cn", withoutSecret)
+	claims := AccessTokenClaims{}
+	claims.Issuer = testIssuer
+	claims.Subject = "user"
+	claims.ExpiresAt = jwt.NewNumericDate(time.Now().Add(time.Hour))
+	forged, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.IntrospectAccessTokenOffline(forged); err == nil {
+		t.Fatal("未配置 AppSecret 时不应接受 HS256 签名")
+	}
+}
@@ -0,0 +1,131 @@
+package authentication
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/valyala/fasthttp"
+)
+
+const DeviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+// devicePollUnit interval 的单位，默认轮询间隔与 slow_down 时的增量均为 5 个单位（RFC 8628）
+var devicePollUnit = time.Second
+
+type DeviceAuthorizationParams struct {
+	/**
+	申请的权限，为空时使用初始化时传入的 Scope
+	*/
+	Scope string
+	/**
+	其他自定义参数
+	*/
+	ExtraParams map[string]string
+}
+
+// DeviceAuthorization 设备授权端点的响应，需将 UserCode 与 VerificationUri（或 VerificationUriComplete）展示给用户
+type DeviceAuthorization struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationUri         string `json:"verification_uri"`
+	VerificationUriComplete string `json:"verification_uri_complete,omitempty"`
+	ExpiresIn               int64  `json:"expires_in"`
+	Interval                int64  `json:"interval,omitempty"`
+	/**
+	device_code 的过期时间
+	*/
+	Expiry time.Time `json:"-"`
+}
+
+// RequestDeviceAuthorization 发起设备授权（RFC 8628），用于无法跳转浏览器的 CLI、电视等设备。
+// 服务端返回错误时返回 *OAuthError
+func (client *AuthenticationClient) RequestDeviceAuthorization(ctx context.Context, params *DeviceAuthorizationParams) (*DeviceAuthorization, error) {
+	if params == nil {
+		params = &DeviceAuthorizationParams{}
+	}
+	endpoints, err := client.endpoints(ctx, OIDC)
+	if err != nil {
+		return nil, err
+	}
+	header := map[string]string{
+		"Content-Type": "application/x-www-form-urlencoded",
+	}
+	body := map[string]string{}
+	for key, value := range params.ExtraParams {
+		body[key] = value
+	}
+	body["client_id"] = client.options.AppId
+	if scope := params.Scope; scope != "" {
+		body["scope"] = scope
+	} else if client.options.Scope != "" {
+		body["scope"] = client.options.Scope
+	}
+	if err = client.applyClientAuth(client.options.TokenEndPointAuthMethod, endpoints.Token, header, body); err != nil {
+		return nil, err
+	}
+	resp, err := client.SendProtocolHttpRequestWithContext(ctx, &ProtocolRequestOption{
+		Url:     endpoints.DeviceAuthorization,
+		Method:  fasthttp.MethodPost,
+		Headers: client.getReqHeaders(header),
+		ReqDto:  body,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err = checkOAuthError(resp.StatusCode, resp.Body); err != nil {
+		return nil, err
+	}
+	var authorization DeviceAuthorization
+	if err = json.Unmarshal(resp.Body, &authorization); err != nil {
+		return nil, fmt.Errorf("无法解析设备授权响应: %w", err)
+	}
+	if authorization.DeviceCode == "" || authorization.UserCode == "" {
+		return nil, fmt.Errorf("设备授权响应缺少 device_code 或 user_code: %s", resp.Body)
+	}
+	authorization.Expiry = time.Now().Add(time.Duration(authorization.ExpiresIn) * time.Second)
+	return &authorization, nil
+}
+
+// PollDeviceToken 按 interval 轮询 token 端点，直到用户完成授权、拒绝授权、device_code 过期或 ctx 结束。
+// 收到 authorization_pending 时继续轮询，收到 slow_down 时增加轮询间隔；
+// 用户拒绝或 device_code 过期时返回 ErrorCode 为 access_denied 或 expired_token 的 *OAuthError
+func (client *AuthenticationClient) PollDeviceToken(ctx context.Context, authorization *DeviceAuthorization) (*TokenSet, error) {
+	interval := time.Duration(authorization.Interval) * devicePollUnit
+	if interval <= 0 {
+		interval = 5 * devicePollUnit
+	}
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+		if authorization.ExpiresIn > 0 && !time.Now().Before(authorization.Expiry) {
+			return nil, &OAuthError{ErrorCode: "expired_token", ErrorDescription: "device_code 已过期"}
+		}
+		tokenSet, err := client.requestTokenSet(ctx, OIDC, client.options.TokenEndPointAuthMethod, map[string]string{
+			"grant_type":  DeviceCodeGrantType,
+			"device_code": authorization.DeviceCode,
+		})
+		if err == nil {
+			return tokenSet, nil
+		}
+		var oauthError *OAuthError
+		if !errors.As(err, &oauthError) {
+			return nil, err
+		}
+		switch oauthError.ErrorCode {
+		case "authorization_pending":
+		case "slow_down":
+			interval += 5 * devicePollUnit
+		default:
+			return nil, err
+		}
+		timer.Reset(interval)
+	}
+}
@@ -0,0 +1,109 @@
+package authentication
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// serveDevice 模拟设备授权端点，token 端点依次返回 pollResponses，之后重复最后一个响应
+func serveDevice(t *testing.T, pollResponses []string) http.HandlerFunc {
+	var polls int32
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oidc/device/auth":
+			if r.PostForm.Get("client_id") != "app" || r.PostForm.Get("scope") != "openid offline_access" {
+				t.Errorf("设备授权参数不符合预期: %v", r.PostForm)
+			}
+			w.Write([]byte(`{"device_code":"dc","user_code":"ABCD-EFGH","verification_uri":"https://example.authing.cn/device",` +
+				`"verification_uri_complete":"https://example.authing.cn/device?user_code=ABCD-EFGH","expires_in":600,"interval":1}`))
+		case "/oidc/token":
+			if r.PostForm.Get("grant_type") != DeviceCodeGrantType || r.PostForm.Get("device_code") != "dc" {
+				t.Errorf("轮询参数不符合预期: %v", r.PostForm)
+			}
+			n := atomic.AddInt32(&polls, 1)
+			response := pollResponses[len(pollResponses)-1]
+			if int(n) <= len(pollResponses) {
+				response = pollResponses[n-1]
+			}
+			if strings.HasPrefix(response, `{"error"`) {
+				w.WriteHeader(http.StatusBadRequest)
+			}
+			w.Write([]byte(response))
+		}
+	}
+}
+
+func TestDeviceAuthorizationGrant(t *testing.T) {
+	devicePollUnit = time.Millisecond
+	defer func() { devicePollUnit = time.Second }()
+	server := newStubServer(serveDevice(t, []string{
+		`{"error":"authorization_pending"}`,
+		`{"error":"slow_down"}`,
+		`{"error":"authorization_pending"}`,
+		`{"access_token":"at","id_token":"it","expires_in":3600}`,
+	}))
+	defer server.Close()
+	client := newTestClient(t, server.URL, withoutSecret)
+	ctx := context.Background()
+
+	authorization, err := client.RequestDeviceAuthorization(ctx, &DeviceAuthorizationParams{Scope: "openid offline_access"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if authorization.UserCode != "ABCD-EFGH" || authorization.VerificationUriComplete == "" || time.Until(authorization.Expiry) < 9*time.Minute {
+		t.Fatalf("设备授权响应不符合预期: %+v", authorization)
+	}
+
+	start := time.Now()
+	tokenSet, err := client.PollDeviceToken(ctx, authorization)
+	if err != nil || tokenSet.AccessToken != "at" || tokenSet.IDToken != "it" {
+		t.Fatalf("期望获得 token, 实际 %+v %v", tokenSet, err)
+	}
+	if n := server.hits("/oidc/token"); n != 4 {
+		t.Fatalf("期望轮询 4 次, 实际 %d 次", n)
+	}
+	// 间隔依次为 1、1、6、6 个单位
+	if elapsed := time.Since(start); elapsed < 14*time.Millisecond {
+		t.Fatalf("slow_down 后应增加轮询间隔, 实际总耗时 %v", elapsed)
+	}
+}
+
+func TestPollDeviceToken_Stops(t *testing.T) {
+	devicePollUnit = time.Millisecond
+	defer func() { devicePollUnit = time.Second }()
+
+	for _, errorCode := range []string{"access_denied", "expired_token"} {
+		server := newStubServer(serveDevice(t, []string{`{"error":"` + errorCode + `"}`}))
+		client := newTestClient(t, server.URL, withoutSecret)
+		_, err := client.PollDeviceToken(context.Background(), &DeviceAuthorization{DeviceCode: "dc", Interval: 1})
+		var oauthError *OAuthError
+		if !errors.As(err, &oauthError) || oauthError.ErrorCode != errorCode {
+			t.Fatalf("期望 %s, 实际 %v", errorCode, err)
+		}
+		server.Close()
+	}
+
+	server := newStubServer(serveDevice(t, []string{`{"error":"authorization_pending"}`}))
+	defer server.Close()
+	client := newTestClient(t, server.URL, withoutSecret)
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := client.PollDeviceToken(ctx, &DeviceAuthorization{DeviceCode: "dc", Interval: 1}); err != context.DeadlineExceeded {
+		t.Fatalf("期望 context.DeadlineExceeded, 实际 %v", err)
+	}
+	if server.hits("/oidc/token") == 0 {
+		t.Fatal("ctx 结束前应持续轮询")
+	}
+
+	expired := &DeviceAuthorization{DeviceCode: "dc", Interval: 1, ExpiresIn: 1, Expiry: time.Now()}
+	_, err := client.PollDeviceToken(context.Background(), expired)
+	var oauthError *OAuthError
+	if !errors.As(err, &oauthError) || oauthError.ErrorCode != "expired_token" {
+		t.Fatalf("device_code 过期后应停止轮询, 实际 %v", err)
+	}
+}
@@ -0,0 +1,201 @@
+package authentication
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Authing/authing-golang-sdk/v3/dto"
+	"github.com/Authing/authing-golang-sdk/v3/util"
+	"github.com/valyala/fasthttp"
+)
+
+const DISCOVERY_PATH = "/oidc/.well-known/openid-configuration"
+
+// discoveryRetryInterval 获取元数据失败并继续使用缓存时，距下次重新获取的间隔
+const discoveryRetryInterval = time.Minute
+
+// protocolEndpoints 协议相关的端点地址
+type protocolEndpoints struct {
+	Issuer              string
+	Authorization       string
+	Token               string
+	Userinfo            string
+	Jwks                string
+	EndSession          string
+	Introspection       string
+	Revocation          string
+	DeviceAuthorization string
+	PushedAuthorization string
+}
+
+type discoveryCache struct {
+	mutex     sync.Mutex
+	metadata  *dto.OidcDiscoveryMetadata
+	expiresAt time.Time
+	// err 没有缓存时最近一次获取失败的错误，在 expiresAt 之前直接返回
+	err error
+	// fetching 正在获取元数据时不为空，获取完成后关闭
+	fetching chan struct{}
+}
+
+// Discover 获取 OIDC 服务发现元数据，结果按 DiscoveryCacheTTL 缓存；
+// 获取失败时若存在已缓存的元数据则继续使用，否则返回错误，两种情况下都在 1 分钟后再次尝试获取。
+// 同一时间只有一个请求获取元数据，存在缓存时其余请求直接使用缓存，否则等待获取结果。
+// 元数据中声明的端点认证方式不包含初始化时指定的认证方式时返回错误
+func (client *AuthenticationClient) Discover(ctx context.Context) (*dto.OidcDiscoveryMetadata, error) {
+	cache := client.discovery
+	for {
+		cache.mutex.Lock()
+		if time.Now().Before(cache.expiresAt) {
+			metadata, err := cache.metadata, cache.err
+			cache.mutex.Unlock()
+			if metadata != nil {
+				return metadata, nil
+			}
+			return nil, err
+		}
+		fetching := cache.fetching
+		if fetching == nil {
+			break
+		}
+		metadata := cache.metadata
+		cache.mutex.Unlock()
+		if metadata != nil {
+			return metadata, nil
+		}
+		select {
+		case <-fetching:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	fetching := make(chan struct{})
+	cache.fetching = fetching
+	cache.mutex.Unlock()
+
+	metadata, err := client.fetchDiscovery(ctx)
+	if err == nil {
+		err = client.validateDiscovery(metadata)
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	cache.fetching = nil
+	close(fetching)
+	if err != nil {
+		// 调用方取消的请求不代表服务端不可用，不记录失败
+		if ctx.Err() == nil {
+			cache.expiresAt = time.Now().Add(discoveryRetryInterval)
+		}
+		if cache.metadata != nil {
+			return cache.metadata, nil
+		}
+		cache.err = err
+		return nil, err
+	}
+	cache.metadata, cache.err = metadata, nil
+	cache.expiresAt = time.Now().Add(client.options.DiscoveryCacheTTL)
+	return metadata, nil
+}
+
+func (client *AuthenticationClient) fetchDiscovery(ctx context.Context) (*dto.OidcDiscoveryMetadata, error) {
+	discoveryUrl := client.options.DiscoveryUrl
+	if discoveryUrl == "" {
+		discoveryUrl = client.getUrl(DISCOVERY_PATH)
+	}
+	res, err := client.SendProtocolHttpRequestWithContext(ctx, &ProtocolRequestOption{
+		Url:     discoveryUrl,
+		Method:  fasthttp.MethodGet,
+		Headers: client.getReqHeaders(nil),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("获取 OIDC 服务发现元数据失败: %w", err)
+	}
+	if res.StatusCode != 200 {
+		return nil, fmt.Errorf("获取 OIDC 服务发现元数据失败[%d]:%s", res.StatusCode, res.Body)
+	}
+	var metadata dto.OidcDiscoveryMetadata
+	if err = json.Unmarshal(res.Body, &metadata); err != nil {
+		return nil, fmt.Errorf("无法解析 OIDC 服务发现元数据: %w", err)
+	}
+	if metadata.Issuer == "" || metadata.TokenEndpoint == "" {
+		return nil, fmt.Errorf("OIDC 服务发现元数据缺少 issuer 或 token_endpoint")
+	}
+	return &metadata, nil
+}
+
+func (client *AuthenticationClient) validateDiscovery(metadata *dto.OidcDiscoveryMetadata) error {
+	checks := []struct {
+		name      string
+		method    TokenAuthMethodEnum
+		supported []string
+	}{
+		{"TokenEndPointAuthMethod", client.options.TokenEndPointAuthMethod, metadata.TokenEndpointAuthMethodsSupported},
+		{"IntrospectionEndPointAuthMethod", client.options.IntrospectionEndPointAuthMethod, metadata.IntrospectionEndpointAuthMethodsSupported},
+		{"RevocationEndPointAuthMethod", client.options.RevocationEndPointAuthMethod, metadata.RevocationEndpointAuthMethodsSupported},
+	}
+	for _, check := range checks {
+		if check.method == "" || len(check.supported) == 0 {
+			continue
+		}
+		if !util.ContainsString(check.supported, string(check.method)) {
+			return fmt.Errorf("%s %s 不被服务端支持，可选值为 %v", check.name, check.method, check.supported)
+		}
+	}
+	return nil
+}
+
+// checkCodeChallengeMethod 启用服务发现时检查 PKCE 方式是否被服务端支持
+func (client *AuthenticationClient) checkCodeChallengeMethod(ctx context.Context, method CodeChallengeMethodEnum) error {
+	if method == "" || !client.options.EnableDiscovery {
+		return nil
+	}
+	metadata, err := client.Discover(ctx)
+	if err != nil {
+		return err
+	}
+	supported := metadata.CodeChallengeMethodsSupported
+	if len(supported) > 0 && !util.ContainsString(supported, string(method)) {
+		return fmt.Errorf("code_challenge_method %s 不被服务端支持，可选值为 %v", method, supported)
+	}
+	return nil
+}
+
+// endpoints 返回协议对应的端点地址，启用服务发现时 OIDC 端点以元数据为准
+func (client *AuthenticationClient) endpoints(ctx context.Context, protocol ProtocolEnum) (*protocolEndpoints, error) {
+	endpoints := &protocolEndpoints{
+		Issuer:              client.getUrl("/oidc"),
+		Authorization:       client.getUrl(fmt.Sprintf("/%s/auth", protocol)),
+		Token:               client.getUrl(fmt.Sprintf("/%s/token", protocol)),
+		Userinfo:            client.getUrl("/oidc/me"),
+		Jwks:                client.getUrl(JWK_PATH),
+		EndSession:          client.getUrl("/oidc/session/end"),
+		Introspection:       client.getUrl(fmt.Sprintf("/%s/token/introspection", protocol)),
+		Revocation:          client.getUrl(fmt.Sprintf("/%s/token/revocation", protocol)),
+		DeviceAuthorization: client.getUrl(fmt.Sprintf("/%s/device/auth", protocol)),
+		PushedAuthorization: client.getUrl(fmt.Sprintf("/%s/request", protocol)),
+	}
+	if !client.options.EnableDiscovery {
+		return endpoints, nil
+	}
+	metadata, err := client.Discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	endpoints.Issuer = metadata.Issuer
+	endpoints.Jwks = util.GetValueOrDefault(metadata.JwksUri, endpoints.Jwks)
+	endpoints.Userinfo = util.GetValueOrDefault(metadata.UserinfoEndpoint, endpoints.Userinfo)
+	endpoints.EndSession = util.GetValueOrDefault(metadata.EndSessionEndpoint, endpoints.EndSession)
+	if protocol == OIDC {
+		endpoints.Authorization = util.GetValueOrDefault(metadata.AuthorizationEndpoint, endpoints.Authorization)
+		endpoints.Token = metadata.TokenEndpoint
+		endpoints.Introspection = util.GetValueOrDefault(metadata.IntrospectionEndpoint, endpoints.Introspection)
+		endpoints.Revocation = util.GetValueOrDefault(metadata.RevocationEndpoint, endpoints.Revocation)
+		endpoints.DeviceAuthorization = util.GetValueOrDefault(metadata.DeviceAuthorizationEndpoint, endpoints.DeviceAuthorization)
+		endpoints.PushedAuthorization = util.GetValueOrDefault(metadata.PushedAuthorizationRequestEndpoint, endpoints.PushedAuthorization)
+	}
+	return endpoints, nil
+}
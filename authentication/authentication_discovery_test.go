@@ -0,0 +1,132 @@
+package authentication
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Authing/authing-golang-sdk/v3/util"
+)
+
+// serveDiscovery 返回服务发现元数据，端点均位于 /custom 下
+func serveDiscovery(authMethods string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case DISCOVERY_PATH:
+			fmt.Fprintf(w, `{
+				"issuer": "%[1]s/custom",
+				"authorization_endpoint": "%[1]s/custom/authorize",
+				"token_endpoint": "%[1]s/custom/token",
+				"userinfo_endpoint": "%[1]s/custom/userinfo",
+				"end_session_endpoint": "%[1]s/custom/logout",
+				"token_endpoint_auth_methods_supported": [%[2]s]
+			}`, "http://"+r.Host, authMethods)
+		case "/custom/token":
+			w.Write([]byte(`{"access_token":"at"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}
+}
+
+// enableDiscovery 开启服务发现，且获取元数据失败时不重试
+func enableDiscovery(options *AuthenticationClientOptions) {
+	options.EnableDiscovery = true
+	options.RetryPolicy = &util.RetryPolicy{MaxAttempts: 1}
+}
+
+func TestDiscovery_RoutesEndpoints(t *testing.T) {
+	server := newStubServer(serveDiscovery(`"client_secret_post","none"`))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL, enableDiscovery)
+	result, err := client.BuildAuthorizeUrlByOidc(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(result.Url, server.URL+"/custom/authorize?") {
+		t.Fatalf("授权地址未使用服务发现元数据: %s", result.Url)
+	}
+	logoutUrl, err := client.BuildLogoutUrl(&BuildLogoutURLParams{})
+	if err != nil || logoutUrl != server.URL+"/custom/logout" {
+		t.Fatalf("登出地址未使用服务发现元数据: %s %v", logoutUrl, err)
+	}
+	resp, err := client.GetAccessTokenByCode("code")
+	if err != nil || resp.AccessToken != "at" {
+		t.Fatalf("token 端点未使用服务发现元数据: %+v %v", resp, err)
+	}
+	if n := server.hits(DISCOVERY_PATH); n != 1 {
+		t.Fatalf("服务发现元数据应被缓存, 实际请求 %d 次", n)
+	}
+}
+
+func TestDiscovery_UnsupportedAuthMethod(t *testing.T) {
+	server := newStubServer(serveDiscovery(`"client_secret_basic"`))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL, enableDiscovery, func(options *AuthenticationClientOptions) {
+		options.TokenEndPointAuthMethod = ClientSecretPost
+	})
+	if _, err := client.Discover(context.Background()); err == nil || !strings.Contains(err.Error(), "TokenEndPointAuthMethod") {
+		t.Fatalf("期望认证方式校验失败, 实际 %v", err)
+	}
+}
+
+func TestDiscovery_StaleMetadataBacksOff(t *testing.T) {
+	var server *stubServer
+	server = newStubServer(func(w http.ResponseWriter, r *http.Request) {
+		if server.hits("") > 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte(`{"issuer":"https://example.authing.cn/oidc","token_endpoint":"https://example.authing.cn/oidc/token"}`))
+	})
+	defer server.Close()
+
+	client := newTestClient(t, server.URL, enableDiscovery, func(options *AuthenticationClientOptions) {
+		options.DiscoveryCacheTTL = time.Millisecond
+	})
+	if _, err := client.Discover(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(5 * time.Millisecond)
+	for i := 0; i < 3; i++ {
+		if metadata, err := client.Discover(context.Background()); err != nil || metadata.Issuer != "https://example.authing.cn/oidc" {
+			t.Fatalf("获取失败时应继续使用缓存的元数据: %+v %v", metadata, err)
+		}
+	}
+	if n := server.hits(""); n != 2 {
+		t.Fatalf("获取失败后应等待一段时间再重试, 实际请求 %d 次", n)
+	}
+}
+
+func TestDiscovery_FailureWithoutCache(t *testing.T) {
+	server := newStubServer(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+		w.WriteHeader(http.StatusBadGateway)
+	})
+	defer server.Close()
+
+	client := newTestClient(t, server.URL, enableDiscovery)
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.Discover(context.Background()); err == nil {
+				t.Error("获取元数据失败时应返回错误")
+			}
+		}()
+	}
+	wg.Wait()
+	if _, err := client.Discover(context.Background()); err == nil {
+		t.Fatal("获取元数据失败时应返回错误")
+	}
+	if n := server.hits(""); n != 1 {
+		t.Fatalf("并发请求应只获取一次元数据，失败后应等待一段时间再重试, 实际请求 %d 次", n)
+	}
+}
@@ -0,0 +1,363 @@
+package authentication
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Authing/authing-golang-sdk/v3/util"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const dpopProofType = "dpop+jwt"
+
+// ErrInvalidDPoPProof DPoP proof 校验失败
+var ErrInvalidDPoPProof = errors.New("DPoP proof 无效")
+
+// dpopSigner 持有 DPoP 密钥并记录各服务端下发的 nonce
+type dpopSigner struct {
+	key        *ecdsa.PrivateKey
+	jwk        map[string]interface{}
+	thumbprint string
+	mutex      sync.Mutex
+	nonces     map[string]string
+}
+
+func newDPoPSigner(pemKey string) (*dpopSigner, error) {
+	var key *ecdsa.PrivateKey
+	var err error
+	if pemKey != "" {
+		key, err = jwt.ParseECPrivateKeyFromPEM([]byte(pemKey))
+		if err != nil {
+			return nil, fmt.Errorf("无法解析 DPoPPrivateKey: %w", err)
+		}
+		if key.Curve != elliptic.P256() {
+			return nil, errors.New("DPoPPrivateKey 仅支持 P-256 曲线的 EC 私钥")
+		}
+	} else if key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader); err != nil {
+		return nil, err
+	}
+	jwk := ecPublicJWK(&key.PublicKey)
+	thumbprint, err := jwkThumbprint(jwk)
+	if err != nil {
+		return nil, err
+	}
+	return &dpopSigner{key: key, jwk: jwk, thumbprint: thumbprint, nonces: map[string]string{}}, nil
+}
+
+func ecPublicJWK(key *ecdsa.PublicKey) map[string]interface{} {
+	size := (key.Curve.Params().BitSize + 7) / 8
+	return map[string]interface{}{
+		"kty": "EC",
+		"crv": key.Curve.Params().Name,
+		"x":   base64.RawURLEncoding.EncodeToString(key.X.FillBytes(make([]byte, size))),
+		"y":   base64.RawURLEncoding.EncodeToString(key.Y.FillBytes(make([]byte, size))),
+	}
+}
+
+// jwkThumbprint 按 RFC 7638 计算公钥 JWK 的 SHA-256 指纹
+func jwkThumbprint(jwk map[string]interface{}) (string, error) {
+	var members []string
+	switch jwk["kty"] {
+	case "EC":
+		members = []string{"crv", "kty", "x", "y"}
+	case "RSA":
+		members = []string{"e", "kty", "n"}
+	default:
+		return "", fmt.Errorf("不支持的 JWK 类型: %v", jwk["kty"])
+	}
+	parts := make([]string, 0, len(members))
+	for _, member := range members {
+		value, ok := jwk[member].(string)
+		if !ok || value == "" {
+			return "", fmt.Errorf("JWK 缺少 %s", member)
+		}
+		encoded, _ := json.Marshal(value)
+		parts = append(parts, fmt.Sprintf(`"%s":%s`, member, encoded))
+	}
+	sum := sha256.Sum256([]byte("{" + strings.Join(parts, ",") + "}"))
+	return base64.RawURLEncoding.EncodeToString(sum[:]), nil
+}
+
+// dpopTarget 返回 htu（去除 query 与 fragment 的请求地址）与记录 nonce 使用的服务端标识
+func dpopTarget(rawUrl string) (htu string, origin string) {
+	parsed, err := url.Parse(rawUrl)
+	if err != nil {
+		return rawUrl, rawUrl
+	}
+	parsed.RawQuery, parsed.Fragment = "", ""
+	return parsed.String(), parsed.Scheme + "://" + parsed.Host
+}
+
+func accessTokenHash(accessToken string) string {
+	sum := sha256.Sum256([]byte(accessToken))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// proof 生成 DPoP proof，accessToken 不为空时附加 ath
+func (signer *dpopSigner) proof(method string, rawUrl string, accessToken string) (string, error) {
+	htu, origin := dpopTarget(rawUrl)
+	claims := jwt.MapClaims{
+		"jti": util.RandStringImpr(32),
+		"htm": method,
+		"htu": htu,
+		"iat": time.Now().Unix(),
+	}
+	signer.mutex.Lock()
+	if nonce := signer.nonces[origin]; nonce != "" {
+		claims["nonce"] = nonce
+	}
+	signer.mutex.Unlock()
+	if accessToken != "" {
+		claims["ath"] = accessTokenHash(accessToken)
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+	token.Header["typ"] = dpopProofType
+	token.Header["jwk"] = signer.jwk
+	return token.SignedString(signer.key)
+}
+
+// updateNonce 记录响应中的 DPoP-Nonce，返回是否下发了新的 nonce
+func (signer *dpopSigner) updateNonce(rawUrl string, resp *ResponseData) bool {
+	if resp == nil || resp.Header == nil {
+		return false
+	}
+	nonce := string(resp.Header.Peek("DPoP-Nonce"))
+	if nonce == "" {
+		return false
+	}
+	_, origin := dpopTarget(rawUrl)
+	signer.mutex.Lock()
+	defer signer.mutex.Unlock()
+	changed := signer.nonces[origin] != nonce
+	signer.nonces[origin] = nonce
+	return changed
+}
+
+// requiresDPoPNonce 判断服务端是否要求使用 DPoP-Nonce 中的 nonce 重新发送请求：
+// 授权服务器返回 400 与 use_dpop_nonce 错误，资源服务器返回 401 与包含 use_dpop_nonce 的 WWW-Authenticate
+func requiresDPoPNonce(resp *ResponseData) bool {
+	switch resp.StatusCode {
+	case 400:
+		var oauthError OAuthError
+		json.Unmarshal(resp.Body, &oauthError)
+		return oauthError.ErrorCode == "use_dpop_nonce"
+	case 401:
+		return resp.Header != nil && strings.Contains(string(resp.Header.Peek("WWW-Authenticate")), "use_dpop_nonce")
+	}
+	return false
+}
+
+// DPoPThumbprint 返回 DPoP 公钥的 JWK SHA-256 指纹，即 token 中 cnf.jkt 的期望值；未启用 DPoP 时返回空字符串
+func (client *AuthenticationClient) DPoPThumbprint() string {
+	if client.dpop == nil {
+		return ""
+	}
+	return client.dpop.thumbprint
+}
+
+// sendWithDPoP 发送 build 构造的请求，启用 DPoP 时附加 proof（accessToken 不为空时包含 ath），
+// 服务端要求使用新的 nonce 时重新构造请求并重试一次
+func (client *AuthenticationClient) sendWithDPoP(ctx context.Context, accessToken string, build func() (*ProtocolRequestOption, error)) (*ResponseData, error) {
+	for attempt := 0; ; attempt++ {
+		option, err := build()
+		if err != nil {
+			return nil, err
+		}
+		if client.dpop == nil {
+			return client.SendProtocolHttpRequestWithContext(ctx, option)
+		}
+		proof, err := client.dpop.proof(option.Method, option.Url, accessToken)
+		if err != nil {
+			return nil, err
+		}
+		option.Headers["DPoP"] = proof
+		resp, err := client.SendProtocolHttpRequestWithContext(ctx, option)
+		if err != nil {
+			return resp, err
+		}
+		if client.dpop.updateNonce(option.Url, resp) && attempt == 0 && requiresDPoPNonce(resp) {
+			continue
+		}
+		return resp, nil
+	}
+}
+
+type DPoPProofOptions struct {
+	/**
+	请求方法，必填
+	*/
+	Method string
+	/**
+	请求地址，必填，比较时忽略 query 与 fragment
+	*/
+	Url string
+	/**
+	请求携带的 access token，不为空时校验 ath
+	*/
+	AccessToken string
+	/**
+	access token 中 cnf.jkt 的值，不为空时校验 proof 的公钥指纹
+	*/
+	JwkThumbprint string
+	/**
+	期望的 nonce，不为空时校验
+	*/
+	Nonce string
+	/**
+	proof 的 iat 与当前时间允许的最大偏差，默认为 5 分钟
+	*/
+	MaxAge time.Duration
+	/**
+	判断 jti 是否已使用过，用于防止重放，为空时不检查
+	*/
+	IsReplay func(jti string) bool
+}
+
+// DPoPProof 校验通过的 DPoP proof
+type DPoPProof struct {
+	Jti           string
+	Htm           string
+	Htu           string
+	IssuedAt      time.Time
+	JwkThumbprint string
+}
+
+func invalidDPoPProof(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s", ErrInvalidDPoPProof, fmt.Sprintf(format, args...))
+}
+
+// VerifyDPoPProof 资源服务器校验请求头 DPoP 中的 proof：签名、typ、htm、htu、iat、ath、nonce 以及公钥是否与 cnf.jkt 一致，
+// 校验失败时返回的错误可通过 errors.Is 与 ErrInvalidDPoPProof 判断
+func VerifyDPoPProof(proof string, options DPoPProofOptions) (*DPoPProof, error) {
+	if options.MaxAge <= 0 {
+		options.MaxAge = 5 * time.Minute
+	}
+	var thumbprint string
+	claims := jwt.MapClaims{}
+	_, err := jwt.NewParser(jwt.WithValidMethods([]string{"ES256", "ES384", "ES512", "RS256", "RS384", "RS512", "PS256", "PS384", "PS512"})).
+		ParseWithClaims(proof, claims, func(token *jwt.Token) (interface{}, error) {
+			if token.Header["typ"] != dpopProofType {
+				return nil, fmt.Errorf("typ 应为 %s", dpopProofType)
+			}
+			jwk, ok := token.Header["jwk"].(map[string]interface{})
+			if !ok {
+				return nil, errors.New("缺少 jwk")
+			}
+			if _, ok = jwk["d"]; ok {
+				return nil, errors.New("jwk 不能包含私钥")
+			}
+			key, err := publicKeyFromJWK(jwk)
+			if err != nil {
+				return nil, err
+			}
+			if thumbprint, err = jwkThumbprint(jwk); err != nil {
+				return nil, err
+			}
+			return key, nil
+		})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidDPoPProof, err)
+	}
+
+	result := &DPoPProof{JwkThumbprint: thumbprint}
+	result.Jti, _ = claims["jti"].(string)
+	result.Htm, _ = claims["htm"].(string)
+	result.Htu, _ = claims["htu"].(string)
+	if result.Jti == "" {
+		return nil, invalidDPoPProof("缺少 jti")
+	}
+	if result.Htm != options.Method {
+		return nil, invalidDPoPProof("htm 不匹配, 期望 %s, 实际 %s", options.Method, result.Htm)
+	}
+	expectedHtu, _ := dpopTarget(options.Url)
+	if htu, _ := dpopTarget(result.Htu); htu != expectedHtu {
+		return nil, invalidDPoPProof("htu 不匹配, 期望 %s, 实际 %s", expectedHtu, result.Htu)
+	}
+	issuedAt, err := claims.GetIssuedAt()
+	if err != nil || issuedAt == nil {
+		return nil, invalidDPoPProof("缺少 iat")
+	}
+	result.IssuedAt = issuedAt.Time
+	if skew := time.Since(issuedAt.Time); skew > options.MaxAge || skew < -options.MaxAge {
+		return nil, invalidDPoPProof("iat 超出允许范围")
+	}
+	if options.Nonce != "" && claims["nonce"] != options.Nonce {
+		return nil, invalidDPoPProof("nonce 不匹配")
+	}
+	if options.AccessToken != "" && claims["ath"] != accessTokenHash(options.AccessToken) {
+		return nil, invalidDPoPProof("ath 不匹配")
+	}
+	if options.JwkThumbprint != "" && options.JwkThumbprint != thumbprint {
+		return nil, invalidDPoPProof("公钥与 access token 绑定的 jkt 不一致")
+	}
+	if options.IsReplay != nil && options.IsReplay(result.Jti) {
+		return nil, invalidDPoPProof("jti 已使用")
+	}
+	return result, nil
+}
+
+// minDPoPRSAKeyBits DPoP proof 中 RSA 公钥的最小长度
+const minDPoPRSAKeyBits = 2048
+
+func publicKeyFromJWK(jwk map[string]interface{}) (crypto.PublicKey, error) {
+	decode := func(member string) (*big.Int, error) {
+		value, _ := jwk[member].(string)
+		b, err := base64.RawURLEncoding.DecodeString(value)
+		if err != nil || len(b) == 0 {
+			return nil, fmt.Errorf("jwk 的 %s 无效", member)
+		}
+		return new(big.Int).SetBytes(b), nil
+	}
+	switch jwk["kty"] {
+	case "EC":
+		curves := map[interface{}]elliptic.Curve{"P-256": elliptic.P256(), "P-384": elliptic.P384(), "P-521": elliptic.P521()}
+		curve, ok := curves[jwk["crv"]]
+		if !ok {
+			return nil, fmt.Errorf("不支持的曲线: %v", jwk["crv"])
+		}
+		x, err := decode("x")
+		if err != nil {
+			return nil, err
+		}
+		y, err := decode("y")
+		if err != nil {
+			return nil, err
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, errors.New("jwk 公钥不在曲线上")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	case "RSA":
+		n, err := decode("n")
+		if err != nil {
+			return nil, err
+		}
+		e, err := decode("e")
+		if err != nil {
+			return nil, err
+		}
+		if n.BitLen() < minDPoPRSAKeyBits {
+			return nil, fmt.Errorf("RSA 公钥长度不能小于 %d 位", minDPoPRSAKeyBits)
+		}
+		if !e.IsInt64() || e.Int64() <= 1 || e.Int64() > math.MaxInt32 || e.Bit(0) == 0 {
+			return nil, errors.New("RSA 公钥的 e 无效")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	}
+	return nil, fmt.Errorf("不支持的 JWK 类型: %v", jwk["kty"])
+}
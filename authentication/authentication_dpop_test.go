@@ -0,0 +1,196 @@
+package authentication
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// enableDPoP 开启 DPoP
+func enableDPoP(options *AuthenticationClientOptions) {
+	options.EnableDPoP = true
+}
+
+func TestDPoP_TokenAndUserInfoWithNonce(t *testing.T) {
+	var client *AuthenticationClient
+	server := newStubServer(func(w http.ResponseWriter, r *http.Request) {
+		accessToken := ""
+		if r.URL.Path == "/oidc/me" {
+			if !strings.HasPrefix(r.Header.Get("Authorization"), "DPoP ") {
+				t.Errorf("用户信息请求应使用 DPoP 方式: %s", r.Header.Get("Authorization"))
+			}
+			accessToken = strings.TrimPrefix(r.Header.Get("Authorization"), "DPoP ")
+		}
+		_, err := VerifyDPoPProof(r.Header.Get("DPoP"), DPoPProofOptions{
+			Method:        r.Method,
+			Url:           "http://" + r.Host + r.URL.Path,
+			AccessToken:   accessToken,
+			JwkThumbprint: client.DPoPThumbprint(),
+		})
+		if err != nil {
+			t.Errorf("%s: proof 校验失败: %v", r.URL.Path, err)
+		}
+		if _, err = VerifyDPoPProof(r.Header.Get("DPoP"), DPoPProofOptions{Method: r.Method, Url: "http://" + r.Host + r.URL.Path, Nonce: "n-" + r.URL.Path}); err != nil {
+			w.Header().Set("DPoP-Nonce", "n-"+r.URL.Path)
+			if r.URL.Path == "/oidc/me" {
+				w.Header().Set("WWW-Authenticate", `DPoP error="use_dpop_nonce"`)
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":"use_dpop_nonce"}`))
+			return
+		}
+		if r.URL.Path == "/oidc/me" {
+			w.Write([]byte(`{"sub":"user-1"}`))
+			return
+		}
+		w.Write([]byte(`{"access_token":"at","token_type":"DPoP","refresh_token":"rt","expires_in":3600}`))
+	})
+	defer server.Close()
+	client = newTestClient(t, server.URL, enableDPoP)
+	ctx := context.Background()
+
+	tokenSet, err := client.GetAccessTokenByCodeWithContext(ctx, &CodeToTokenParams{Code: "code"})
+	if err != nil || tokenSet.TokenType != "DPoP" {
+		t.Fatalf("期望获得 DPoP token: %+v %v", tokenSet, err)
+	}
+	if _, err = client.GetNewAccessTokenByRefreshTokenWithContext(ctx, "rt"); err != nil {
+		t.Fatal(err)
+	}
+	userInfo, err := client.GetUserInfoWithContext(ctx, tokenSet.AccessToken)
+	if err != nil || userInfo.Subject != "user-1" {
+		t.Fatalf("获取用户信息失败: %+v %v", userInfo, err)
+	}
+	var paths []string
+	for _, request := range server.requests("") {
+		paths = append(paths, request.Path)
+	}
+	expected := "/oidc/token,/oidc/token,/oidc/token,/oidc/me,/oidc/me"
+	if actual := strings.Join(paths, ","); actual != expected {
+		t.Fatalf("收到 use_dpop_nonce 后应重试一次且之后复用 nonce, 期望 %s, 实际 %s", expected, actual)
+	}
+}
+
+func TestDPoP_RetriesOnlyOnce(t *testing.T) {
+	server := newStubServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("DPoP-Nonce", time.Now().String())
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error":"use_dpop_nonce"}`))
+	})
+	defer server.Close()
+	client := newTestClient(t, server.URL, enableDPoP)
+
+	_, err := client.GetAccessTokenByCodeWithContext(context.Background(), &CodeToTokenParams{Code: "code"})
+	var oauthError *OAuthError
+	if !errors.As(err, &oauthError) || oauthError.ErrorCode != "use_dpop_nonce" || server.hits("") != 2 {
+		t.Fatalf("应只重试一次: %d %v", server.hits(""), err)
+	}
+}
+
+func TestBearerMiddleware_DPoPBoundToken(t *testing.T) {
+	client := newTestClient(t, "http://127.0.0.1:0", enableDPoP)
+	other := newTestClient(t, "http://127.0.0.1:0", enableDPoP)
+	claims := AccessTokenClaims{}
+	claims.Issuer = testBearerIssuer
+	claims.Subject = "user"
+	claims.ExpiresAt = jwt.NewNumericDate(time.Now().Add(time.Hour))
+	claims.Cnf = &TokenConfirmation{Jkt: client.DPoPThumbprint()}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	used := map[string]bool{}
+	handler := client.BearerMiddleware(&BearerOptions{
+		Mode: BearerOfflineOnly,
+		DPoPIsReplay: func(jti string) bool {
+			replay := used[jti]
+			used[jti] = true
+			return replay
+		},
+	})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	proof := func(signer *AuthenticationClient, url string) string {
+		proof, err := signer.dpop.proof(http.MethodGet, url, token)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return proof
+	}
+	validProof := proof(client, "http://example.com/resource")
+	cases := []struct {
+		name      string
+		scheme    string
+		proof     string
+		status    int
+		errorCode string
+	}{
+		{"有效 proof", "DPoP", validProof, http.StatusOK, ""},
+		{"重放 proof", "DPoP", validProof, http.StatusUnauthorized, "invalid_dpop_proof"},
+		{"缺少 proof", "DPoP", "", http.StatusUnauthorized, "invalid_dpop_proof"},
+		{"使用 Bearer 方式", "Bearer", proof(client, "http://example.com/resource"), http.StatusUnauthorized, "invalid_token"},
+		{"htu 不匹配", "DPoP", proof(client, "http://example.com/other"), http.StatusUnauthorized, "invalid_dpop_proof"},
+		{"其他密钥", "DPoP", proof(other, "http://example.com/resource"), http.StatusUnauthorized, "invalid_dpop_proof"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "http://example.com/resource?q=1", nil)
+			r.Header.Set("Authorization", c.scheme+" "+token)
+			if c.proof != "" {
+				r.Header.Set("DPoP", c.proof)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+			if w.Code != c.status || !strings.Contains(w.Header().Get("WWW-Authenticate"), c.errorCode) {
+				t.Fatalf("期望 %d %s, 实际 %d %s", c.status, c.errorCode, w.Code, w.Header().Get("WWW-Authenticate"))
+			}
+		})
+	}
+}
+
+func TestJwkThumbprint_RFC7638(t *testing.T) {
+	thumbprint, err := jwkThumbprint(map[string]interface{}{
+		"kty": "RSA",
+		"n": "0vx7agoebGcQSuuPiLJXZptN9nndrQmbXEps2aiAFbWhM78LhWx4cbbfAAtVT86zwu1RK7aPFFxuhDR1L6tSoc_BJECPebWKRXjBZCiFV4n3oknjhMs" +
+			"tn64tZ_2W-5JsGY4Hc5n9yBXArwl93lqt7_RN5w6Cf0h4QyQ5v-65YGjQR0_FDW2QvzqY368QQMicAtaSqzs8KJZgnYb9c7d0zgdAZHzu6qMQvRL5hajrn1n9" +
+			"1CbOpbISD08qNLyrdkt-bFTWhAI4vMQFh6WeZu0fM4lFd2NcRwr3XPksINHaQ-G_xBniIqbw0Ls1jF44-csFCur-kEgU8awapJzKnqDKgw",
+		"e": "AQAB",
+	})
+	if err != nil || thumbprint != "NzbLsXh8uDCcd-6MNwXF4W_7noWXFZAfHkxZsRGC9Xs" {
+		t.Fatalf("指纹不符合 RFC 7638 示例: %s %v", thumbprint, err)
+	}
+}
+
+func TestPublicKeyFromJWK_RejectsWeakRSAKeys(t *testing.T) {
+	key := newTestRSAKey(t)
+	encode := func(n *big.Int) string {
+		return base64.RawURLEncoding.EncodeToString(n.Bytes())
+	}
+	n := encode(key.N)
+	cases := []struct {
+		name  string
+		n     string
+		e     *big.Int
+		valid bool
+	}{
+		{"2048 位", n, big.NewInt(65537), true},
+		{"512 位", encode(new(big.Int).Rsh(key.N, 1536)), big.NewInt(65537), false},
+		{"e 为 1", n, big.NewInt(1), false},
+		{"e 为偶数", n, big.NewInt(65536), false},
+		{"e 超出 int32", n, big.NewInt(1<<40 + 1), false},
+	}
+	for _, c := range cases {
+		_, err := publicKeyFromJWK(map[string]interface{}{"kty": "RSA", "n": c.n, "e": encode(c.e)})
+		if (err == nil) != c.valid {
+			t.Fatalf("%s: 期望 valid=%v, 实际 %v", c.name, c.valid, err)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package authentication
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+)
+
+// newTestOptions 创建测试用的 client 配置，默认 AppId 为 app、AppSecret 为 secret、端点认证方式为 none，
+// overrides 依次修改默认配置
+func newTestOptions(host string, overrides ...func(options *AuthenticationClientOptions)) *AuthenticationClientOptions {
+	options := &AuthenticationClientOptions{
+		AppId:                   "app",
+		AppSecret:               "secret",
+		AppHost:                 host,
+		RedirectUri:             "https://example.com/callback",
+		TokenEndPointAuthMethod: None,
+	}
+	for _, override := range overrides {
+		override(options)
+	}
+	return options
+}
+
+// newTestClient 使用 newTestOptions 的配置创建测试用的 client
+func newTestClient(t *testing.T, host string, overrides ...func(options *AuthenticationClientOptions)) *AuthenticationClient {
+	client, err := NewAuthenticationClient(newTestOptions(host, overrides...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+// withoutSecret 不配置 AppSecret
+func withoutSecret(options *AuthenticationClientOptions) {
+	options.AppSecret = ""
+}
+
+// withPrivateKeyJwt 使用 private_key_jwt 认证端点
+func withPrivateKeyJwt(key *rsa.PrivateKey, keyId string) func(options *AuthenticationClientOptions) {
+	return func(options *AuthenticationClientOptions) {
+		options.TokenEndPointAuthMethod = PrivateKeyJwt
+		options.PrivateKey = string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+		options.PrivateKeyId = keyId
+	}
+}
+
+// stubRequest 测试服务器收到的请求
+type stubRequest struct {
+	Path   string
+	Query  url.Values
+	Form   url.Values
+	Header http.Header
+}
+
+// stubServer 记录收到的请求后交给 handler 处理的测试服务器
+type stubServer struct {
+	*httptest.Server
+	mutex    sync.Mutex
+	received []stubRequest
+}
+
+func newStubServer(handler http.HandlerFunc) *stubServer {
+	server := &stubServer{}
+	server.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		server.mutex.Lock()
+		server.received = append(server.received, stubRequest{Path: r.URL.Path, Query: r.URL.Query(), Form: r.PostForm, Header: r.Header})
+		server.mutex.Unlock()
+		handler(w, r)
+	}))
+	return server
+}
+
+// stubResponse 返回固定状态码与响应体的 handler
+func stubResponse(status int, body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}
+}
+
+// requests 返回路径为 path 的请求，path 为空时返回全部请求
+func (server *stubServer) requests(path string) []stubRequest {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	var requests []stubRequest
+	for _, request := range server.received {
+		if path == "" || request.Path == path {
+			requests = append(requests, request)
+		}
+	}
+	return requests
+}
+
+// hits 返回路径为 path 的请求次数，path 为空时返回全部请求次数
+func (server *stubServer) hits(path string) int {
+	return len(server.requests(path))
+}
+
+// lastRequest 返回最近一次请求，没有请求时返回零值
+func (server *stubServer) lastRequest() stubRequest {
+	requests := server.requests("")
+	if len(requests) == 0 {
+		return stubRequest{}
+	}
+	return requests[len(requests)-1]
+}
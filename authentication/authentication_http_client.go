@@ -1,17 +1,32 @@
 package authentication
 
 import (
+	"context"
 	"crypto/tls"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/Authing/authing-golang-sdk/v3/constant"
+	"github.com/Authing/authing-golang-sdk/v3/dto"
 	"github.com/Authing/authing-golang-sdk/v3/util"
 	"github.com/valyala/fasthttp"
 )
 
 func (client *AuthenticationClient) SendHttpRequest(url string, method string, reqDto interface{}) ([]byte, error) {
+	b, err := client.SendHttpRequestWithContext(context.Background(), url, method, reqDto)
+	var authingError *dto.AuthingError
+	if errors.As(err, &authingError) {
+		return json.Marshal(authingError.Response())
+	}
+	return b, err
+}
+
+// SendHttpRequestWithContext 发送请求，ctx 被取消或到达截止时间时中止请求。
+// 网络错误以 *dto.AuthingError 返回，超时可通过 errors.Is(err, dto.ErrTimeout) 判断
+func (client *AuthenticationClient) SendHttpRequestWithContext(ctx context.Context, url string, method string, reqDto interface{}) ([]byte, error) {
+	path := url
 	req := fasthttp.AcquireRequest()
 	defer fasthttp.ReleaseRequest(req)
 
@@ -52,7 +67,7 @@ func (client *AuthenticationClient) SendHttpRequest(url string, method string, r
 		"/api/v3/signin-by-mobile",
 		"/api/v3/exchange-tokenset-with-qrcode-ticket",
 	}
-	if client.options.TokenEndPointAuthMethod == ClientSecretBasic && util.StringContains(endpointsToSendBasicHeader, url) {
+	if client.options.TokenEndPointAuthMethod == ClientSecretBasic && util.StringContains(endpointsToSendBasicHeader, path) {
 		req.Header.Add("authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s", client.options.AppId, client.options.AppSecret))))
 	} else if client.options.AccessToken != "" {
 		req.Header.Add("authorization", client.options.AccessToken)
@@ -63,27 +78,42 @@ func (client *AuthenticationClient) SendHttpRequest(url string, method string, r
 		req.SetBody(reqJsonBytes)
 	}
 
-	resp := fasthttp.AcquireResponse()
-	defer fasthttp.ReleaseResponse(resp)
+	res, err := client.roundTrip(&util.RoundTripRequest{
+		Context: ctx,
+		Path:    path,
+		Method:  method,
+		ReqDto:  reqDto,
+		Request: req,
+	})
+	if err != nil {
+		return nil, util.NewTransportError(err)
+	}
+	return res.Body, nil
+}
+
+// Use 添加请求拦截器，同时作用于 SendHttpRequest 与 SendProtocolHttpRequest，先添加的拦截器位于最外层；应在发起请求前调用
+func (client *AuthenticationClient) Use(interceptors ...util.Interceptor) {
+	client.interceptors = append(client.interceptors, interceptors...)
+}
+
+func (client *AuthenticationClient) roundTrip(request *util.RoundTripRequest) (*util.RoundTripResponse, error) {
+	roundTrip := util.NewRoundTrip(client.httpClient, client.options.RetryPolicy, client.options.ReadTimeout)
+	return util.ChainInterceptors(roundTrip, client.interceptors)(request)
+}
 
-	err = client.httpClient.DoTimeout(req, resp, client.options.ReadTimeout)
+// request 发送请求并将响应体解析到 response，响应体中 statusCode 非 2xx 时返回 *dto.AuthingError
+func (client *AuthenticationClient) request(ctx context.Context, url string, method string, reqDto interface{}, response interface{}) error {
+	b, err := client.SendHttpRequestWithContext(ctx, url, method, reqDto)
 	if err != nil {
-		resultMap := make(map[string]interface{})
-		if err == fasthttp.ErrTimeout {
-			resultMap["statusCode"] = 504
-			resultMap["message"] = "请求超时"
-		} else {
-			resultMap["statusCode"] = 500
-			resultMap["message"] = err.Error()
-		}
-		b, err := json.Marshal(resultMap)
-		if err != nil {
-			return nil, err
-		}
-		return b, err
+		return err
+	}
+	if err = util.CheckResponse(b); err != nil {
+		return err
+	}
+	if err = json.Unmarshal(b, response); err != nil {
+		return fmt.Errorf("解析响应失败: %w", err)
 	}
-	body := resp.Body()
-	return body, err
+	return nil
 }
 
 func (client *AuthenticationClient) createHttpClient() *fasthttp.Client {
@@ -0,0 +1,81 @@
+package authentication
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Authing/authing-golang-sdk/v3/dto"
+	"github.com/valyala/fasthttp"
+)
+
+// serveSlowly 延迟 delay 后返回成功响应，请求取消时立即返回
+func serveSlowly(delay time.Duration) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+		}
+		w.Write([]byte(`{"statusCode":200,"message":""}`))
+	}
+}
+
+func TestSendHttpRequestWithContext_Deadline(t *testing.T) {
+	server := newStubServer(serveSlowly(time.Second))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.SendHttpRequestWithContext(ctx, "/api/v3/get-profile", fasthttp.MethodGet, nil)
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Fatalf("请求未在 ctx 截止时间后中止, 耗时 %v", elapsed)
+	}
+	if !errors.Is(err, dto.ErrTimeout) {
+		t.Fatalf("期望 dto.ErrTimeout, 实际 %v", err)
+	}
+}
+
+func TestGetProfileWithContext_AuthingError(t *testing.T) {
+	server := newStubServer(stubResponse(http.StatusOK, `{"statusCode":401,"apiCode":2000,"message":"token 已失效","requestId":"req-1","data":{"userId":"u1"}}`))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	resp, err := client.GetProfileWithContext(context.Background(), &dto.GetProfileDto{})
+	if resp != nil {
+		t.Fatalf("出错时不应返回响应: %+v", resp)
+	}
+	var authingError *dto.AuthingError
+	if !errors.As(err, &authingError) {
+		t.Fatalf("期望 *dto.AuthingError, 实际 %v", err)
+	}
+	if authingError.StatusCode != 401 || authingError.ApiCode != 2000 || authingError.RequestId != "req-1" {
+		t.Fatalf("错误字段不符合预期: %+v", authingError)
+	}
+
+	legacy := client.GetProfile(&dto.GetProfileDto{})
+	if legacy == nil || legacy.StatusCode != 401 || legacy.Message != "token 已失效" || legacy.Data.UserId != "u1" {
+		t.Fatalf("旧版方法应返回带错误码的响应: %+v", legacy)
+	}
+}
+
+func TestSendProtocolHttpRequestWithContext_Cancel(t *testing.T) {
+	server := newStubServer(serveSlowly(time.Second))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	_, err := client.SendProtocolHttpRequestWithContext(ctx, &ProtocolRequestOption{
+		Url:    server.URL + "/oidc/me",
+		Method: fasthttp.MethodGet,
+	})
+	if err != context.Canceled {
+		t.Fatalf("期望 context.Canceled, 实际 %v", err)
+	}
+}
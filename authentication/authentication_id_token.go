@@ -0,0 +1,234 @@
+package authentication
+
+import (
+	"context"
+	"crypto"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Authing/authing-golang-sdk/v3/util"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var (
+	ErrIDTokenMalformed       = errors.New("id token 格式错误")
+	ErrIDTokenAlgorithm       = errors.New("id token 签名算法不被允许")
+	ErrIDTokenSignature       = errors.New("id token 签名无效")
+	ErrIDTokenExpired         = errors.New("id token 已过期")
+	ErrIDTokenNotValidYet     = errors.New("id token 尚未生效")
+	ErrIDTokenIssuedAt        = errors.New("id token 签发时间无效")
+	ErrIDTokenIssuer          = errors.New("id token 签发者不匹配")
+	ErrIDTokenAudience        = errors.New("id token 受众不匹配")
+	ErrIDTokenAuthorizedParty = errors.New("id token azp 不匹配")
+	ErrIDTokenNonce           = errors.New("id token nonce 不匹配")
+	ErrIDTokenAtHash          = errors.New("id token at_hash 不匹配")
+	ErrIDTokenSHash           = errors.New("id token s_hash 不匹配")
+	ErrIDTokenAuthTime        = errors.New("id token 认证时间超出 max_age")
+)
+
+// IDTokenError id token 校验失败，可通过 errors.Is 与 ErrIDToken* 判断失败原因
+type IDTokenError struct {
+	Err     error
+	Message string
+}
+
+func (e *IDTokenError) Error() string {
+	if e.Message == "" {
+		return e.Err.Error()
+	}
+	return e.Err.Error() + ": " + e.Message
+}
+
+func (e *IDTokenError) Unwrap() error {
+	return e.Err
+}
+
+func newIDTokenError(err error, format string, args ...interface{}) *IDTokenError {
+	return &IDTokenError{Err: err, Message: fmt.Sprintf(format, args...)}
+}
+
+type ValidationOptions struct {
+	/**
+	授权请求中的 nonce，不为空时校验 id token 中的 nonce
+	*/
+	Nonce string
+	/**
+	同时签发的 access token，不为空且 id token 包含 at_hash 时校验 at_hash
+	*/
+	AccessToken string
+	/**
+	授权请求中的 state，不为空且 id token 包含 s_hash 时校验 s_hash
+	*/
+	State string
+	/**
+	校验 exp、iat、nbf、auth_time 时允许的时钟偏差
+	*/
+	ClockSkew time.Duration
+	/**
+	用户认证后允许的最长时间，大于 0 时要求 id token 包含 auth_time 并进行校验
+	*/
+	MaxAge time.Duration
+	/**
+	期望的签发者，默认为服务发现元数据中的 issuer 或 AppHost + /oidc
+	*/
+	Issuer string
+	/**
+	允许的签名算法，默认为服务发现元数据中的 id_token_signing_alg_values_supported 或 RS256、HS256
+	*/
+	AllowedAlgs []string
+}
+
+// ValidateIDToken 按 OIDC 规范校验 id token 的签名、签名算法、iss、aud、azp、exp、iat、nonce、at_hash、s_hash 与 auth_time，
+// 校验失败时返回 *IDTokenError
+func (client *AuthenticationClient) ValidateIDToken(tokenStr string, options ValidationOptions) (*IDTokenClaims, error) {
+	return client.ValidateIDTokenWithContext(context.Background(), tokenStr, options)
+}
+
+// ValidateIDTokenWithContext 同 ValidateIDToken，ctx 用于控制获取服务发现元数据与 JWKS 的请求
+func (client *AuthenticationClient) ValidateIDTokenWithContext(ctx context.Context, tokenStr string, options ValidationOptions) (*IDTokenClaims, error) {
+	endpoints, err := client.endpoints(ctx, OIDC)
+	if err != nil {
+		return nil, err
+	}
+	issuer := options.Issuer
+	if issuer == "" {
+		issuer = endpoints.Issuer
+	}
+	algs := options.AllowedAlgs
+	if len(algs) == 0 {
+		algs, err = client.idTokenSigningAlgs(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	parser := jwt.NewParser(jwt.WithLeeway(options.ClockSkew), jwt.WithIssuedAt())
+	claims := &IDTokenClaims{}
+	token, err := parser.ParseWithClaims(tokenStr, claims, func(token *jwt.Token) (interface{}, error) {
+		alg, _ := token.Header["alg"].(string)
+		if !util.ContainsString(algs, alg) {
+			return nil, newIDTokenError(ErrIDTokenAlgorithm, "%s", alg)
+		}
+		return client.getKeyCommon(ctx, token)
+	})
+	if err != nil {
+		return nil, toIDTokenError(err)
+	}
+	if !token.Valid {
+		return nil, newIDTokenError(ErrIDTokenSignature, "")
+	}
+	if err = client.validateIDTokenClaims(token, claims, issuer, options); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// idTokenSigningAlgs 返回默认允许的 id token 签名算法，未配置 AppSecret 时去掉 HS256
+func (client *AuthenticationClient) idTokenSigningAlgs(ctx context.Context) ([]string, error) {
+	algs := []string{"RS256", ALG_HS256}
+	if client.options.IdTokenSignAlg != "" {
+		algs = []string{client.options.IdTokenSignAlg}
+	} else if client.options.EnableDiscovery {
+		metadata, err := client.Discover(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if len(metadata.IdTokenSigningAlgValuesSupported) > 0 {
+			algs = metadata.IdTokenSigningAlgValuesSupported
+		}
+	}
+	if client.options.AppSecret != "" {
+		return algs, nil
+	}
+	allowed := make([]string, 0, len(algs))
+	for _, alg := range algs {
+		if alg != ALG_HS256 {
+			allowed = append(allowed, alg)
+		}
+	}
+	return allowed, nil
+}
+
+func (client *AuthenticationClient) validateIDTokenClaims(token *jwt.Token, claims *IDTokenClaims, issuer string, options ValidationOptions) error {
+	now := time.Now()
+	if claims.ExpiresAt == nil {
+		return newIDTokenError(ErrIDTokenExpired, "缺少 exp")
+	}
+	if claims.IssuedAt == nil {
+		return newIDTokenError(ErrIDTokenIssuedAt, "缺少 iat")
+	}
+	if claims.Issuer != issuer {
+		return newIDTokenError(ErrIDTokenIssuer, "期望 %s, 实际 %s", issuer, claims.Issuer)
+	}
+	appId := client.options.AppId
+	if !util.ContainsString(claims.Audience, appId) {
+		return newIDTokenError(ErrIDTokenAudience, "%v 不包含 %s", []string(claims.Audience), appId)
+	}
+	if (len(claims.Audience) > 1 || claims.Azp != "") && claims.Azp != appId {
+		return newIDTokenError(ErrIDTokenAuthorizedParty, "期望 %s, 实际 %q", appId, claims.Azp)
+	}
+	if options.Nonce != "" && claims.Nonce != options.Nonce {
+		return newIDTokenError(ErrIDTokenNonce, "")
+	}
+	if options.AccessToken != "" && claims.AtHash != "" {
+		if expected, err := tokenHalfHash(token.Method.Alg(), options.AccessToken); err != nil || expected != claims.AtHash {
+			return newIDTokenError(ErrIDTokenAtHash, "")
+		}
+	}
+	if options.State != "" && claims.SHash != "" {
+		if expected, err := tokenHalfHash(token.Method.Alg(), options.State); err != nil || expected != claims.SHash {
+			return newIDTokenError(ErrIDTokenSHash, "")
+		}
+	}
+	if options.MaxAge > 0 {
+		if claims.AuthTime == nil {
+			return newIDTokenError(ErrIDTokenAuthTime, "缺少 auth_time")
+		}
+		if now.After(claims.AuthTime.Add(options.MaxAge + options.ClockSkew)) {
+			return newIDTokenError(ErrIDTokenAuthTime, "认证时间 %s", claims.AuthTime.Time)
+		}
+	}
+	return nil
+}
+
+func toIDTokenError(err error) error {
+	var idTokenError *IDTokenError
+	switch {
+	case errors.As(err, &idTokenError):
+		return idTokenError
+	case errors.Is(err, jwt.ErrTokenMalformed):
+		return newIDTokenError(ErrIDTokenMalformed, "%v", err)
+	case errors.Is(err, jwt.ErrTokenExpired):
+		return newIDTokenError(ErrIDTokenExpired, "")
+	case errors.Is(err, jwt.ErrTokenNotValidYet):
+		return newIDTokenError(ErrIDTokenNotValidYet, "")
+	case errors.Is(err, jwt.ErrTokenUsedBeforeIssued):
+		return newIDTokenError(ErrIDTokenIssuedAt, "签发时间晚于当前时间")
+	default:
+		return newIDTokenError(ErrIDTokenSignature, "%v", err)
+	}
+}
+
+// tokenHalfHash 计算 at_hash、s_hash：使用与签名算法对应的哈希算法，取前一半字节后 base64url 编码
+func tokenHalfHash(alg string, value string) (string, error) {
+	var hash crypto.Hash
+	switch {
+	case strings.HasSuffix(alg, "256"):
+		hash = crypto.SHA256
+	case strings.HasSuffix(alg, "384"):
+		hash = crypto.SHA384
+	case strings.HasSuffix(alg, "512"):
+		hash = crypto.SHA512
+	default:
+		return "", fmt.Errorf("不支持的签名算法 %s", alg)
+	}
+	h := hash.New()
+	h.Write([]byte(value))
+	sum := h.Sum(nil)
+	return base64.RawURLEncoding.EncodeToString(sum[:len(sum)/2]), nil
+}
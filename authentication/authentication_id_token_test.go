@@ -0,0 +1,111 @@
+package authentication
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const testIssuer = "https://example.authing.cn/oidc"
+
+func signTestIDToken(t *testing.T, override jwt.MapClaims) string {
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"iss":       testIssuer,
+		"aud":       "app",
+		"sub":       "user-1",
+		"iat":       now.Unix(),
+		"exp":       now.Add(time.Hour).Unix(),
+		"auth_time": now.Add(-time.Minute).Unix(),
+		"nonce":     "nonce-1",
+	}
+	for key, value := range override {
+		if value == nil {
+			delete(claims, key)
+		} else {
+			claims[key] = value
+		}
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func TestValidateIDToken(t *testing.T) {
+	client := newTestClient(t, "https://example.authing.cn")
+	atHash, _ := tokenHalfHash("HS256", "access-token")
+	now := time.Now()
+
+	cases := []struct {
+		name     string
+		claims   jwt.MapClaims
+		options  ValidationOptions
+		expected error
+	}{
+		{"合法", jwt.MapClaims{"at_hash": atHash}, ValidationOptions{Nonce: "nonce-1", AccessToken: "access-token", MaxAge: time.Hour}, nil},
+		{"签发者不匹配", jwt.MapClaims{"iss": "https://evil.example.com"}, ValidationOptions{}, ErrIDTokenIssuer},
+		{"受众不匹配", jwt.MapClaims{"aud": "other"}, ValidationOptions{}, ErrIDTokenAudience},
+		{"多受众缺少 azp", jwt.MapClaims{"aud": []string{"app", "other"}}, ValidationOptions{}, ErrIDTokenAuthorizedParty},
+		{"nonce 不匹配", nil, ValidationOptions{Nonce: "nonce-2"}, ErrIDTokenNonce},
+		{"at_hash 不匹配", jwt.MapClaims{"at_hash": atHash}, ValidationOptions{AccessToken: "other-token"}, ErrIDTokenAtHash},
+		{"已过期", jwt.MapClaims{"exp": now.Add(-time.Minute).Unix()}, ValidationOptions{}, ErrIDTokenExpired},
+		{"时钟偏差内未过期", jwt.MapClaims{"exp": now.Add(-time.Minute).Unix()}, ValidationOptions{ClockSkew: 2 * time.Minute}, nil},
+		{"签发时间在未来", jwt.MapClaims{"iat": now.Add(time.Hour).Unix()}, ValidationOptions{}, ErrIDTokenIssuedAt},
+		{"超出 max_age", jwt.MapClaims{"auth_time": now.Add(-2 * time.Hour).Unix()}, ValidationOptions{MaxAge: time.Hour}, ErrIDTokenAuthTime},
+		{"缺少 auth_time", jwt.MapClaims{"auth_time": nil}, ValidationOptions{MaxAge: time.Hour}, ErrIDTokenAuthTime},
+		{"签名算法不被允许", nil, ValidationOptions{AllowedAlgs: []string{"RS256"}}, ErrIDTokenAlgorithm},
+	}
+	for _, c := range cases {
+		claims, err := client.ValidateIDToken(signTestIDToken(t, c.claims), c.options)
+		if c.expected == nil {
+			if err != nil || claims.Subject != "user-1" {
+				t.Fatalf("%s: 期望校验通过, 实际 %v", c.name, err)
+			}
+			continue
+		}
+		var idTokenError *IDTokenError
+		if !errors.Is(err, c.expected) || !errors.As(err, &idTokenError) {
+			t.Fatalf("%s: 期望 %v, 实际 %v", c.name, c.expected, err)
+		}
+	}
+}
+
+func TestValidateIDToken_Signature(t *testing.T) {
+	client := newTestClient(t, "https://example.authing.cn")
+	token, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"iss": testIssuer}).SignedString([]byte("other"))
+	if _, err := client.ValidateIDToken(token, ValidationOptions{}); !errors.Is(err, ErrIDTokenSignature) {
+		t.Fatalf("期望 ErrIDTokenSignature, 实际 %v", err)
+	}
+	if _, err := client.ValidateIDToken("not-a-token", ValidationOptions{}); !errors.Is(err, ErrIDTokenMalformed) {
+		t.Fatalf("期望 ErrIDTokenMalformed, 实际 %v", err)
+	}
+}
+
+func TestValidateIDToken_EmptySecretRejectsHS256(t *testing.T) {
+	client := newTestClient(t, "https://example.authing.cn", withoutSecret)
+	now := time.Now()
+	forged, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"iss": testIssuer,
+		"aud": "app",
+		"sub": "user-1",
+		"iat": now.Unix(),
+		"exp": now.Add(time.Hour).Unix(),
+	}).SignedString([]byte(""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.ValidateIDToken(forged, ValidationOptions{}); !errors.Is(err, ErrIDTokenAlgorithm) {
+		t.Fatalf("未配置 AppSecret 时默认不应允许 HS256, 实际 %v", err)
+	}
+	client.options.IdTokenSignAlg = ALG_HS256
+	if _, err = client.ValidateIDToken(forged, ValidationOptions{}); !errors.Is(err, ErrIDTokenAlgorithm) {
+		t.Fatalf("未配置 AppSecret 时 IdTokenSignAlg 为 HS256 也不应允许, 实际 %v", err)
+	}
+	if _, err = client.ValidateIDToken(forged, ValidationOptions{AllowedAlgs: []string{ALG_HS256}}); err == nil {
+		t.Fatal("未配置 AppSecret 时不应通过 HS256 签名校验")
+	}
+}
@@ -0,0 +1,98 @@
+package authentication
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+)
+
+// introspectionCacheMaxSize 默认最多缓存的条目数
+const introspectionCacheMaxSize = 10000
+
+type introspectionCacheEntry struct {
+	claims    *AccessTokenClaims
+	expiresAt time.Time
+}
+
+// IntrospectionCache 缓存在线检查 token 的结果，以 token 的 SHA-256 摘要为键，不保存 token 明文。
+// 缓存时间不超过 TTL，也不超过 token 的 exp；条目数达到上限时先清理已过期的条目，仍然已满则淘汰最早过期的条目。并发安全
+type IntrospectionCache struct {
+	ttl        time.Duration
+	maxEntries int
+	mutex      sync.Mutex
+	entries    map[string]introspectionCacheEntry
+}
+
+// NewIntrospectionCache 创建检查结果缓存，ttl 为单条结果的最长缓存时间，最多缓存 10000 条
+func NewIntrospectionCache(ttl time.Duration) *IntrospectionCache {
+	return NewIntrospectionCacheWithSize(ttl, introspectionCacheMaxSize)
+}
+
+// NewIntrospectionCacheWithSize 同 NewIntrospectionCache，maxEntries 为最多缓存的条目数，小于等于 0 时使用默认值 10000
+func NewIntrospectionCacheWithSize(ttl time.Duration, maxEntries int) *IntrospectionCache {
+	if maxEntries <= 0 {
+		maxEntries = introspectionCacheMaxSize
+	}
+	return &IntrospectionCache{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		entries:    make(map[string]introspectionCacheEntry),
+	}
+}
+
+func introspectionCacheKey(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// Get 返回缓存的检查结果，claims 为 nil 表示 token 无效；found 为 false 表示没有可用的缓存
+func (cache *IntrospectionCache) Get(token string) (claims *AccessTokenClaims, found bool) {
+	key := introspectionCacheKey(token)
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	entry, ok := cache.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if !time.Now().Before(entry.expiresAt) {
+		delete(cache.entries, key)
+		return nil, false
+	}
+	return entry.claims, true
+}
+
+// Set 缓存检查结果，claims 为 nil 表示 token 无效
+func (cache *IntrospectionCache) Set(token string, claims *AccessTokenClaims) {
+	now := time.Now()
+	expiresAt := now.Add(cache.ttl)
+	if claims != nil && claims.ExpiresAt != nil && claims.ExpiresAt.Before(expiresAt) {
+		expiresAt = claims.ExpiresAt.Time
+	}
+	if !now.Before(expiresAt) {
+		return
+	}
+	key := introspectionCacheKey(token)
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	if _, ok := cache.entries[key]; !ok && len(cache.entries) >= cache.maxEntries {
+		cache.evict(now)
+	}
+	cache.entries[key] = introspectionCacheEntry{claims: claims, expiresAt: expiresAt}
+}
+
+// evict 清理已过期的条目，没有过期条目时淘汰最早过期的一条，调用方需持有锁
+func (cache *IntrospectionCache) evict(now time.Time) {
+	var soonestKey string
+	var soonest time.Time
+	for key, entry := range cache.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(cache.entries, key)
+		} else if soonestKey == "" || entry.expiresAt.Before(soonest) {
+			soonestKey, soonest = key, entry.expiresAt
+		}
+	}
+	if len(cache.entries) >= cache.maxEntries {
+		delete(cache.entries, soonestKey)
+	}
+}
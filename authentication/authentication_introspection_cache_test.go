@@ -0,0 +1,43 @@
+package authentication
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestIntrospectionCache_MaxEntries(t *testing.T) {
+	cache := NewIntrospectionCacheWithSize(time.Hour, 2)
+	claimsExpiringIn := func(d time.Duration) *AccessTokenClaims {
+		claims := &AccessTokenClaims{}
+		claims.ExpiresAt = jwt.NewNumericDate(time.Now().Add(d))
+		return claims
+	}
+	cache.Set("soon", claimsExpiringIn(time.Minute))
+	cache.Set("later", claimsExpiringIn(30*time.Minute))
+	cache.Set("later", claimsExpiringIn(30*time.Minute))
+	if len(cache.entries) != 2 {
+		t.Fatalf("更新已有条目不应淘汰其他条目, 实际 %d 条", len(cache.entries))
+	}
+	cache.Set("new", claimsExpiringIn(10*time.Minute))
+	if len(cache.entries) != 2 {
+		t.Fatalf("条目数不应超过上限, 实际 %d 条", len(cache.entries))
+	}
+	if _, found := cache.Get("soon"); found {
+		t.Fatal("应淘汰最早过期的条目")
+	}
+	for _, token := range []string{"later", "new"} {
+		if _, found := cache.Get(token); !found {
+			t.Fatalf("%s 不应被淘汰", token)
+		}
+	}
+
+	for i := 0; i < 100; i++ {
+		cache.Set(fmt.Sprintf("token-%d", i), claimsExpiringIn(time.Hour))
+	}
+	if len(cache.entries) != 2 {
+		t.Fatalf("大量不同 token 时条目数不应超过上限, 实际 %d 条", len(cache.entries))
+	}
+}
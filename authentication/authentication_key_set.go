@@ -0,0 +1,218 @@
+package authentication
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Authing/authing-golang-sdk/v3/util"
+	"github.com/MicahParks/keyfunc/v2"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/valyala/fasthttp"
+)
+
+type KeySetOptions struct {
+	/**
+	定期刷新 JWKS 的间隔，默认为 1 小时，小于 0 时不定期刷新
+	*/
+	RefreshInterval time.Duration
+	/**
+	遇到未知 kid 时两次刷新之间的最小间隔，默认为 1 分钟
+	*/
+	RefreshRateLimit time.Duration
+	/**
+	NewRemoteKeySet 获取 JWKS 使用的 HTTP 客户端。为空时使用首个使用该 KeySet 的 AuthenticationClient 的客户端，
+	遵循其 InsecureSkipVerify、CreateClientFunc 配置；单独使用时为默认客户端
+	*/
+	HttpClient *fasthttp.Client
+	/**
+	NewRemoteKeySet 获取 JWKS 的超时时间。为空时使用首个使用该 KeySet 的 AuthenticationClient 的 ReadTimeout，单独使用时为 10 秒
+	*/
+	Timeout time.Duration
+}
+
+// KeySet 缓存用于校验 token 签名的 JWKS，支持定期刷新与遇到未知 kid 时限频刷新，
+// 可在同一用户池的多个 AuthenticationClient 之间共享，并发安全
+type KeySet struct {
+	options   KeySetOptions
+	fetch     func(ctx context.Context) ([]byte, error)
+	mutex     sync.RWMutex
+	jwks      *keyfunc.JWKS
+	fetchedAt time.Time
+	// refreshMutex 保证同时只有一个请求刷新 JWKS
+	refreshMutex sync.Mutex
+	// unknownKidRefreshAt 最近一次因未知 kid 刷新的时间，用于限频
+	unknownKidRefreshAt time.Time
+	// refreshFailedAt 最近一次定期刷新失败的时间，RefreshRateLimit 内不再重试
+	refreshFailedAt time.Time
+	refreshVersion  int
+	// httpClient 与 timeout 为 NewRemoteKeySet 获取 JWKS 使用的配置，未指定时由 AuthenticationClient 绑定
+	httpClient *fasthttp.Client
+	timeout    time.Duration
+}
+
+// NewStaticKeySet 使用固定的 JWKS JSON 创建 KeySet，不会发起网络请求，适用于无法访问外网的私有化部署
+func NewStaticKeySet(jwksJSON []byte) (*KeySet, error) {
+	jwks, err := keyfunc.NewJSON(jwksJSON)
+	if err != nil {
+		return nil, fmt.Errorf("解析 JWKS 失败: %w", err)
+	}
+	return &KeySet{jwks: jwks, fetchedAt: time.Now()}, nil
+}
+
+// NewRemoteKeySet 创建从 jwksUri 获取 JWKS 的 KeySet，首次使用时获取
+func NewRemoteKeySet(jwksUri string, options *KeySetOptions) *KeySet {
+	keySet := newKeySet(nil, options)
+	keySet.httpClient, keySet.timeout = keySet.options.HttpClient, keySet.options.Timeout
+	keySet.fetch = func(ctx context.Context) ([]byte, error) {
+		httpClient, timeout := keySet.httpTransport(nil, 0)
+		req := fasthttp.AcquireRequest()
+		defer fasthttp.ReleaseRequest(req)
+		resp := fasthttp.AcquireResponse()
+		defer fasthttp.ReleaseResponse(resp)
+		req.SetRequestURI(jwksUri)
+		req.Header.SetMethod(fasthttp.MethodGet)
+		if err := util.DoWithContext(ctx, httpClient, req, resp, timeout); err != nil {
+			return nil, err
+		}
+		if resp.StatusCode() != fasthttp.StatusOK {
+			return nil, fmt.Errorf("[%d]:%s", resp.StatusCode(), resp.Body())
+		}
+		return append([]byte(nil), resp.Body()...), nil
+	}
+	return keySet
+}
+
+// httpTransport 返回获取 JWKS 使用的 HTTP 客户端与超时时间，尚未配置时使用传入的 httpClient 与 timeout，
+// 二者为空时使用默认值
+func (keySet *KeySet) httpTransport(httpClient *fasthttp.Client, timeout time.Duration) (*fasthttp.Client, time.Duration) {
+	keySet.mutex.Lock()
+	defer keySet.mutex.Unlock()
+	if keySet.httpClient == nil {
+		if httpClient == nil {
+			httpClient = &fasthttp.Client{}
+		}
+		keySet.httpClient = httpClient
+	}
+	if keySet.timeout <= 0 {
+		if timeout <= 0 {
+			timeout = 10 * time.Second
+		}
+		keySet.timeout = timeout
+	}
+	return keySet.httpClient, keySet.timeout
+}
+
+func newKeySet(fetch func(ctx context.Context) ([]byte, error), options *KeySetOptions) *KeySet {
+	keySet := &KeySet{fetch: fetch}
+	if options != nil {
+		keySet.options = *options
+	}
+	if keySet.options.RefreshInterval == 0 {
+		keySet.options.RefreshInterval = time.Hour
+	}
+	if keySet.options.RefreshRateLimit == 0 {
+		keySet.options.RefreshRateLimit = time.Minute
+	}
+	return keySet
+}
+
+// Keyfunc 返回用于 jwt 解析的 jwt.Keyfunc
+func (keySet *KeySet) Keyfunc(ctx context.Context) jwt.Keyfunc {
+	return func(token *jwt.Token) (interface{}, error) {
+		return keySet.Key(ctx, token)
+	}
+}
+
+// Key 根据 token 头部的 kid 与 alg 查找公钥，kid 不存在时在限频范围内刷新 JWKS 后重试
+func (keySet *KeySet) Key(ctx context.Context, token *jwt.Token) (interface{}, error) {
+	jwks, version, err := keySet.current(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if jwks == nil {
+		return nil, errors.New("KeySet 未初始化，请使用 NewStaticKeySet 或 NewRemoteKeySet 创建")
+	}
+	key, err := jwks.Keyfunc(token)
+	if err == nil || !errors.Is(err, keyfunc.ErrKIDNotFound) || keySet.fetch == nil {
+		return key, err
+	}
+	if jwks, _, err = keySet.refresh(ctx, version, true); err != nil {
+		return nil, err
+	}
+	return jwks.Keyfunc(token)
+}
+
+// Refresh 立即从远端获取 JWKS，静态 KeySet 调用时不做任何操作
+func (keySet *KeySet) Refresh(ctx context.Context) error {
+	if keySet.fetch == nil {
+		return nil
+	}
+	keySet.mutex.RLock()
+	version := keySet.refreshVersion
+	keySet.mutex.RUnlock()
+	_, _, err := keySet.refresh(ctx, version, false)
+	return err
+}
+
+func (keySet *KeySet) current(ctx context.Context) (*keyfunc.JWKS, int, error) {
+	keySet.mutex.RLock()
+	jwks, fetchedAt, failedAt, version := keySet.jwks, keySet.fetchedAt, keySet.refreshFailedAt, keySet.refreshVersion
+	keySet.mutex.RUnlock()
+	if keySet.fetch == nil {
+		return jwks, version, nil
+	}
+	interval := keySet.options.RefreshInterval
+	if jwks != nil && (interval < 0 || time.Since(fetchedAt) < interval || time.Since(failedAt) < keySet.options.RefreshRateLimit) {
+		return jwks, version, nil
+	}
+	refreshed, refreshedVersion, err := keySet.refresh(ctx, version, false)
+	if err != nil {
+		// 定期刷新失败时继续使用旧的 JWKS，并在 RefreshRateLimit 之后再重试
+		if jwks != nil {
+			keySet.mutex.Lock()
+			keySet.refreshFailedAt = time.Now()
+			keySet.mutex.Unlock()
+			return jwks, version, nil
+		}
+		return nil, version, err
+	}
+	return refreshed, refreshedVersion, nil
+}
+
+// refresh 获取新的 JWKS；若等待期间其他请求已完成刷新（版本号变化）则直接复用结果
+func (keySet *KeySet) refresh(ctx context.Context, version int, rateLimited bool) (*keyfunc.JWKS, int, error) {
+	keySet.refreshMutex.Lock()
+	defer keySet.refreshMutex.Unlock()
+
+	keySet.mutex.RLock()
+	jwks, latestVersion := keySet.jwks, keySet.refreshVersion
+	keySet.mutex.RUnlock()
+	if latestVersion != version && jwks != nil {
+		return jwks, latestVersion, nil
+	}
+	if rateLimited {
+		if time.Since(keySet.unknownKidRefreshAt) < keySet.options.RefreshRateLimit {
+			return jwks, latestVersion, nil
+		}
+		keySet.unknownKidRefreshAt = time.Now()
+	}
+
+	body, err := keySet.fetch(ctx)
+	if err != nil {
+		return nil, latestVersion, fmt.Errorf("获取 jwk 密钥失败: %w", err)
+	}
+	jwks, err = keyfunc.NewJSON(body)
+	if err != nil {
+		return nil, latestVersion, fmt.Errorf("获取 jwk 密钥失败: %w", err)
+	}
+	keySet.mutex.Lock()
+	keySet.jwks = jwks
+	keySet.fetchedAt = time.Now()
+	keySet.refreshVersion++
+	latestVersion = keySet.refreshVersion
+	keySet.mutex.Unlock()
+	return jwks, latestVersion, nil
+}
@@ -0,0 +1,195 @@
+package authentication
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func newTestRSAKey(t *testing.T) *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func testJWKS(keys map[string]*rsa.PrivateKey) []byte {
+	jwks := `{"keys":[`
+	first := true
+	for kid, key := range keys {
+		if !first {
+			jwks += ","
+		}
+		first = false
+		jwks += fmt.Sprintf(`{"kty":"RSA","alg":"RS256","use":"sig","kid":"%s","n":"%s","e":"%s"}`, kid,
+			base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()))
+	}
+	return []byte(jwks + "]}")
+}
+
+func signTestRS256(t *testing.T, key *rsa.PrivateKey, kid string) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{"sub": "user-1"})
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed
+}
+
+func TestStaticKeySet(t *testing.T) {
+	key := newTestRSAKey(t)
+	keySet, err := NewStaticKeySet(testJWKS(map[string]*rsa.PrivateKey{"k1": key}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = jwt.Parse(signTestRS256(t, key, "k1"), keySet.Keyfunc(context.Background())); err != nil {
+		t.Fatalf("静态 JWKS 校验失败: %v", err)
+	}
+}
+
+// withKeySet 使用指定的 KeySet
+func withKeySet(keySet *KeySet) func(options *AuthenticationClientOptions) {
+	return func(options *AuthenticationClientOptions) {
+		options.KeySet = keySet
+	}
+}
+
+func TestRemoteKeySet_RotationAndRateLimit(t *testing.T) {
+	key1, key2, key3 := newTestRSAKey(t), newTestRSAKey(t), newTestRSAKey(t)
+	var mutex sync.Mutex
+	served := map[string]*rsa.PrivateKey{"k1": key1}
+	server := newStubServer(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		w.Write(testJWKS(served))
+	})
+	defer server.Close()
+
+	keySet := NewRemoteKeySet(server.URL, &KeySetOptions{RefreshRateLimit: time.Hour})
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := jwt.Parse(signTestRS256(t, key1, "k1"), keySet.Keyfunc(ctx)); err != nil {
+				t.Errorf("校验失败: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	if n := server.hits(""); n != 1 {
+		t.Fatalf("并发请求应只获取一次 JWKS, 实际 %d 次", n)
+	}
+
+	// 签名密钥轮换后，遇到未知 kid 时刷新 JWKS
+	mutex.Lock()
+	served = map[string]*rsa.PrivateKey{"k1": key1, "k2": key2}
+	mutex.Unlock()
+	if _, err := jwt.Parse(signTestRS256(t, key2, "k2"), keySet.Keyfunc(ctx)); err != nil {
+		t.Fatalf("密钥轮换后校验失败: %v", err)
+	}
+	if n := server.hits(""); n != 2 {
+		t.Fatalf("未知 kid 应触发刷新, 实际请求 %d 次", n)
+	}
+
+	// 限频时间内不再刷新
+	if _, err := jwt.Parse(signTestRS256(t, key3, "k3"), keySet.Keyfunc(ctx)); err == nil {
+		t.Fatal("未知 kid 应校验失败")
+	}
+	if n := server.hits(""); n != 2 {
+		t.Fatalf("限频时间内不应刷新, 实际请求 %d 次", n)
+	}
+}
+
+func TestKeySet_SharedAcrossClients(t *testing.T) {
+	key := newTestRSAKey(t)
+	server := newStubServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(testJWKS(map[string]*rsa.PrivateKey{"k1": key}))
+	})
+	defer server.Close()
+
+	client1 := newTestClient(t, server.URL)
+	client2 := newTestClient(t, server.URL, withKeySet(client1.KeySet()))
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{"iss": server.URL + "/oidc", "sub": "user-1", "exp": time.Now().Add(time.Hour).Unix()})
+	token.Header["kid"] = "k1"
+	accessToken, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, client := range []*AuthenticationClient{client1, client2} {
+		if _, err := client.IntrospectAccessTokenOffline(accessToken); err != nil {
+			t.Fatalf("校验 access token 失败: %v", err)
+		}
+	}
+	if n := server.hits(""); n != 1 {
+		t.Fatalf("共享 KeySet 应只获取一次 JWKS, 实际 %d 次", n)
+	}
+}
+
+func TestRemoteKeySet_RefreshFailureBacksOff(t *testing.T) {
+	key := newTestRSAKey(t)
+	var server *stubServer
+	server = newStubServer(func(w http.ResponseWriter, r *http.Request) {
+		if server.hits("") > 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write(testJWKS(map[string]*rsa.PrivateKey{"k1": key}))
+	})
+	defer server.Close()
+
+	keySet := NewRemoteKeySet(server.URL, &KeySetOptions{RefreshInterval: time.Millisecond, RefreshRateLimit: time.Hour})
+	ctx := context.Background()
+	for i := 0; i < 5; i++ {
+		if _, err := jwt.Parse(signTestRS256(t, key, "k1"), keySet.Keyfunc(ctx)); err != nil {
+			t.Fatalf("刷新失败时应继续使用旧的 JWKS: %v", err)
+		}
+		time.Sleep(2 * time.Millisecond)
+	}
+	if n := server.hits(""); n != 2 {
+		t.Fatalf("定期刷新失败后应等待 RefreshRateLimit 再重试, 实际请求 %d 次", n)
+	}
+}
+
+func TestKeySet_ZeroValue(t *testing.T) {
+	key := newTestRSAKey(t)
+	if _, err := jwt.Parse(signTestRS256(t, key, "k1"), (&KeySet{}).Keyfunc(context.Background())); err == nil {
+		t.Fatal("未初始化的 KeySet 应返回错误")
+	}
+}
+
+func TestRemoteKeySet_UsesClientHttpClient(t *testing.T) {
+	key := newTestRSAKey(t)
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(testJWKS(map[string]*rsa.PrivateKey{"k1": key}))
+	}))
+	defer server.Close()
+	ctx := context.Background()
+
+	if _, err := jwt.Parse(signTestRS256(t, key, "k1"), NewRemoteKeySet(server.URL, nil).Keyfunc(ctx)); err == nil {
+		t.Fatal("默认客户端不应信任自签名证书")
+	}
+
+	keySet := NewRemoteKeySet(server.URL, nil)
+	newTestClient(t, server.URL, withKeySet(keySet), func(options *AuthenticationClientOptions) {
+		options.InsecureSkipVerify = true
+	})
+	if _, err := jwt.Parse(signTestRS256(t, key, "k1"), keySet.Keyfunc(ctx)); err != nil {
+		t.Fatalf("获取 JWKS 应使用 client 的 InsecureSkipVerify 配置: %v", err)
+	}
+}
@@ -0,0 +1,182 @@
+package authentication
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/Authing/authing-golang-sdk/v3/util"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// BackChannelLogoutEvent logout token 的 events 中必须包含的事件
+const BackChannelLogoutEvent = "http://schemas.openid.net/event/backchannel-logout"
+
+var (
+	ErrInvalidLogoutToken        = errors.New("logout token 无效")
+	ErrInvalidFrontChannelLogout = errors.New("前端登出请求无效")
+)
+
+// LogoutTokenClaims 后端登出通知中 logout token 的 claims，Sid 与 Subject 至少有一个不为空
+type LogoutTokenClaims struct {
+	jwt.RegisteredClaims
+	Sid    string                 `json:"sid,omitempty"`
+	Events map[string]interface{} `json:"events,omitempty"`
+}
+
+// logoutTokenPayload 用于判断 logout token 是否包含 nonce，包含时必须拒绝
+type logoutTokenPayload struct {
+	LogoutTokenClaims
+	Nonce *string `json:"nonce,omitempty"`
+}
+
+func invalidLogoutToken(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s", ErrInvalidLogoutToken, fmt.Sprintf(format, args...))
+}
+
+// ValidateLogoutToken 按 OIDC Back-Channel Logout 规范校验 logout token 的签名、iss、aud、iat、exp、jti、events、sid/sub 与 nonce，
+// 配置了 LogoutTokenIsReplay 时同时检查 jti 是否已使用过。校验失败时返回的错误可通过 errors.Is 与 ErrInvalidLogoutToken 判断
+func (client *AuthenticationClient) ValidateLogoutToken(ctx context.Context, tokenStr string) (*LogoutTokenClaims, error) {
+	endpoints, err := client.endpoints(ctx, OIDC)
+	if err != nil {
+		return nil, err
+	}
+	algs, err := client.idTokenSigningAlgs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	payload := &logoutTokenPayload{}
+	_, err = jwt.NewParser(jwt.WithIssuedAt()).ParseWithClaims(tokenStr, payload, func(token *jwt.Token) (interface{}, error) {
+		alg, _ := token.Header["alg"].(string)
+		if !util.ContainsString(algs, alg) {
+			return nil, fmt.Errorf("签名算法 %s 不被允许", alg)
+		}
+		return client.getKeyCommon(ctx, token)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidLogoutToken, err)
+	}
+	claims := &payload.LogoutTokenClaims
+	if claims.Issuer != endpoints.Issuer {
+		return nil, invalidLogoutToken("签发者不匹配, 期望 %s, 实际 %s", endpoints.Issuer, claims.Issuer)
+	}
+	if !util.ContainsString(claims.Audience, client.options.AppId) {
+		return nil, invalidLogoutToken("受众 %v 不包含 %s", []string(claims.Audience), client.options.AppId)
+	}
+	if claims.IssuedAt == nil {
+		return nil, invalidLogoutToken("缺少 iat")
+	}
+	if claims.ExpiresAt == nil {
+		return nil, invalidLogoutToken("缺少 exp")
+	}
+	if claims.ID == "" {
+		return nil, invalidLogoutToken("缺少 jti")
+	}
+	if _, ok := claims.Events[BackChannelLogoutEvent].(map[string]interface{}); !ok {
+		return nil, invalidLogoutToken("events 中缺少 %s", BackChannelLogoutEvent)
+	}
+	if claims.Sid == "" && claims.Subject == "" {
+		return nil, invalidLogoutToken("sid 与 sub 不能同时为空")
+	}
+	if payload.Nonce != nil {
+		return nil, invalidLogoutToken("不能包含 nonce")
+	}
+	if client.options.LogoutTokenIsReplay != nil && client.options.LogoutTokenIsReplay(claims.ID) {
+		return nil, invalidLogoutToken("jti %s 已使用过", claims.ID)
+	}
+	return claims, nil
+}
+
+// BackChannelLogoutHandler 接收认证服务器的后端登出通知，校验 logout token 后调用 onLogout 注销对应的会话。
+// onLogout 应根据 claims.Sid 或 claims.Subject 注销会话，返回错误时响应 400；防止重放可配置 LogoutTokenIsReplay
+func (client *AuthenticationClient) BackChannelLogoutHandler(onLogout func(ctx context.Context, claims *LogoutTokenClaims) error) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Cache-Control", "no-store")
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			writeLogoutError(w, err)
+			return
+		}
+		claims, err := client.ValidateLogoutToken(r.Context(), r.PostForm.Get("logout_token"))
+		if err != nil {
+			writeLogoutError(w, err)
+			return
+		}
+		if err = onLogout(r.Context(), claims); err != nil {
+			writeLogoutError(w, err)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+func writeLogoutError(w http.ResponseWriter, err error) {
+	errorCode := "invalid_request"
+	if !errors.Is(err, ErrInvalidLogoutToken) {
+		errorCode = "logout_failed"
+	}
+	body, _ := json.Marshal(map[string]string{
+		"error":             errorCode,
+		"error_description": err.Error(),
+	})
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusBadRequest)
+	w.Write(body)
+}
+
+// FrontChannelLogoutRequest 前端登出请求携带的参数
+type FrontChannelLogoutRequest struct {
+	Issuer string
+	Sid    string
+}
+
+// ParseFrontChannelLogout 解析并校验前端登出请求的 iss 与 sid 参数：两者需同时出现或同时缺省，iss 需与签发者一致
+func (client *AuthenticationClient) ParseFrontChannelLogout(r *http.Request) (*FrontChannelLogoutRequest, error) {
+	query := r.URL.Query()
+	request := &FrontChannelLogoutRequest{
+		Issuer: query.Get("iss"),
+		Sid:    query.Get("sid"),
+	}
+	if request.Issuer == "" && request.Sid == "" {
+		return request, nil
+	}
+	if request.Issuer == "" || request.Sid == "" {
+		return nil, fmt.Errorf("%w: iss 与 sid 需同时提供", ErrInvalidFrontChannelLogout)
+	}
+	endpoints, err := client.endpoints(r.Context(), OIDC)
+	if err != nil {
+		return nil, err
+	}
+	if request.Issuer != endpoints.Issuer {
+		return nil, fmt.Errorf("%w: 签发者不匹配, 期望 %s, 实际 %s", ErrInvalidFrontChannelLogout, endpoints.Issuer, request.Issuer)
+	}
+	return request, nil
+}
+
+// MatchSession 判断登出请求是否针对 sid 对应的会话，请求未携带 sid 时视为注销当前会话
+func (request *FrontChannelLogoutRequest) MatchSession(sid string) bool {
+	return request.Sid == "" || request.Sid == sid
+}
+
+// FrontChannelLogoutHandler 处理认证服务器通过 iframe 发起的前端登出请求，校验参数后调用 onLogout 清除当前浏览器的会话
+func (client *AuthenticationClient) FrontChannelLogoutHandler(onLogout func(w http.ResponseWriter, r *http.Request, request *FrontChannelLogoutRequest) error) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Cache-Control", "no-cache, no-store")
+		w.Header().Set("Pragma", "no-cache")
+		request, err := client.ParseFrontChannelLogout(r)
+		if err == nil {
+			err = onLogout(w, r, request)
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+}
@@ -0,0 +1,147 @@
+package authentication
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func signTestLogoutToken(t *testing.T, override jwt.MapClaims) string {
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"iss":    testIssuer,
+		"aud":    "app",
+		"sub":    "user-1",
+		"sid":    "sid-1",
+		"jti":    "jti-1",
+		"iat":    now.Unix(),
+		"exp":    now.Add(2 * time.Minute).Unix(),
+		"events": map[string]interface{}{BackChannelLogoutEvent: map[string]interface{}{}},
+	}
+	for key, value := range override {
+		if value == nil {
+			delete(claims, key)
+		} else {
+			claims[key] = value
+		}
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func TestValidateLogoutToken(t *testing.T) {
+	client := newTestClient(t, "https://example.authing.cn")
+	claims, err := client.ValidateLogoutToken(context.Background(), signTestLogoutToken(t, nil))
+	if err != nil || claims.Sid != "sid-1" || claims.Subject != "user-1" || claims.ID != "jti-1" {
+		t.Fatalf("校验应通过: %+v %v", claims, err)
+	}
+
+	cases := map[string]jwt.MapClaims{
+		"签发者不匹配":       {"iss": "https://other.authing.cn/oidc"},
+		"受众不匹配":        {"aud": "other"},
+		"缺少 events":    {"events": nil},
+		"events 错误":    {"events": map[string]interface{}{BackChannelLogoutEvent: "yes"}},
+		"缺少 sid 与 sub": {"sid": nil, "sub": nil},
+		"包含 nonce":     {"nonce": "n"},
+		"缺少 iat":       {"iat": nil},
+		"缺少 exp":       {"exp": nil},
+		"缺少 jti":       {"jti": nil},
+		"已过期":          {"exp": time.Now().Add(-time.Minute).Unix()},
+	}
+	for name, override := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := client.ValidateLogoutToken(context.Background(), signTestLogoutToken(t, override))
+			if !errors.Is(err, ErrInvalidLogoutToken) {
+				t.Fatalf("期望 ErrInvalidLogoutToken, 实际 %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateLogoutToken_Replay(t *testing.T) {
+	client := newTestClient(t, "https://example.authing.cn")
+	used := map[string]bool{}
+	client.options.LogoutTokenIsReplay = func(jti string) bool {
+		replay := used[jti]
+		used[jti] = true
+		return replay
+	}
+	token := signTestLogoutToken(t, nil)
+	if _, err := client.ValidateLogoutToken(context.Background(), token); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.ValidateLogoutToken(context.Background(), token); !errors.Is(err, ErrInvalidLogoutToken) {
+		t.Fatalf("重放的 logout token 应校验失败: %v", err)
+	}
+}
+
+func TestBackChannelLogoutHandler(t *testing.T) {
+	client := newTestClient(t, "https://example.authing.cn")
+	var revoked []string
+	handler := client.BackChannelLogoutHandler(func(ctx context.Context, claims *LogoutTokenClaims) error {
+		revoked = append(revoked, claims.Sid)
+		return nil
+	})
+
+	post := func(token string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPost, "/backchannel-logout", strings.NewReader(url.Values{"logout_token": {token}}.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+	if w := post(signTestLogoutToken(t, nil)); w.Code != http.StatusOK || w.Header().Get("Cache-Control") != "no-store" {
+		t.Fatalf("期望 200, 实际 %d %s", w.Code, w.Body.String())
+	}
+	if len(revoked) != 1 || revoked[0] != "sid-1" {
+		t.Fatalf("应注销 sid-1 对应的会话: %v", revoked)
+	}
+	if w := post(signTestIDToken(t, nil)); w.Code != http.StatusBadRequest || !strings.Contains(w.Body.String(), "invalid_request") {
+		t.Fatalf("id token 不能用作 logout token: %d %s", w.Code, w.Body.String())
+	}
+	if len(revoked) != 1 {
+		t.Fatal("校验失败时不应注销会话")
+	}
+}
+
+func TestFrontChannelLogout(t *testing.T) {
+	client := newTestClient(t, "https://example.authing.cn")
+	var loggedOut *FrontChannelLogoutRequest
+	handler := client.FrontChannelLogoutHandler(func(w http.ResponseWriter, r *http.Request, request *FrontChannelLogoutRequest) error {
+		loggedOut = request
+		return nil
+	})
+
+	cases := []struct {
+		query  string
+		status int
+	}{
+		{"iss=" + url.QueryEscape(testIssuer) + "&sid=sid-1", http.StatusOK},
+		{"", http.StatusOK},
+		{"sid=sid-1", http.StatusBadRequest},
+		{"iss=" + url.QueryEscape("https://other.authing.cn/oidc") + "&sid=sid-1", http.StatusBadRequest},
+	}
+	for _, c := range cases {
+		loggedOut = nil
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/frontchannel-logout?"+c.query, nil))
+		if w.Code != c.status || (c.status == http.StatusOK) != (loggedOut != nil) {
+			t.Fatalf("%q: 期望 %d, 实际 %d", c.query, c.status, w.Code)
+		}
+	}
+
+	request, err := client.ParseFrontChannelLogout(httptest.NewRequest(http.MethodGet, "/?iss="+url.QueryEscape(testIssuer)+"&sid=sid-1", nil))
+	if err != nil || !request.MatchSession("sid-1") || request.MatchSession("sid-2") {
+		t.Fatalf("sid 匹配结果不符合预期: %+v %v", request, err)
+	}
+}
@@ -0,0 +1,342 @@
+package authentication
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/valyala/fasthttp"
+)
+
+type BearerVerifyMode int
+
+const (
+	// BearerOfflineWithIntrospection 优先离线校验 JWT，token 不是 JWT 时在线检查
+	BearerOfflineWithIntrospection BearerVerifyMode = iota
+	// BearerOfflineOnly 仅离线校验 JWT
+	BearerOfflineOnly
+	// BearerIntrospectionOnly 仅在线检查
+	BearerIntrospectionOnly
+)
+
+// accessTokenClaimsKey fasthttp.RequestCtx 的 UserValue 仅支持字符串键
+const accessTokenClaimsKey = "authing.accessTokenClaims"
+
+type accessTokenClaimsContextKey struct{}
+
+type BearerOptions struct {
+	/**
+	校验方式，默认为离线校验失败时在线检查
+	*/
+	Mode BearerVerifyMode
+	/**
+	访问资源所需的 scope，token 需包含全部 scope
+	*/
+	RequiredScopes []string
+	/**
+	期望的 aud，为空时不校验
+	*/
+	Audience string
+	/**
+	期望的 iss，为空时使用服务发现元数据中的 issuer，未启用服务发现时为 AppHost + /oidc
+	*/
+	Issuer string
+	/**
+	离线校验允许的签名算法，为空时允许 RS256，配置了 AppSecret 时同时允许 HS256
+	*/
+	AllowedAlgs []string
+	/**
+	离线校验无法获取公钥（例如 JWKS 获取失败、kid 不存在）时是否改为在线检查，默认为 false。
+	开启后 JWKS 不可用期间每个请求都会在线检查，建议同时配置 Cache
+	*/
+	IntrospectOnKeyError bool
+	/**
+	在线检查结果缓存，为空时使用 AuthenticationClientOptions.IntrospectionCache，两者都为空时不缓存
+	*/
+	Cache *IntrospectionCache
+	/**
+	判断 DPoP proof 的 jti 是否已使用过，用于防止重放，为空时不检查
+	*/
+	DPoPIsReplay func(jti string) bool
+}
+
+// BearerError 按 RFC 6750 描述的 bearer token 校验错误
+type BearerError struct {
+	StatusCode  int
+	ErrorCode   string
+	Description string
+	Err         error
+}
+
+func (e *BearerError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s: %s: %v", e.ErrorCode, e.Description, e.Err)
+	}
+	return e.ErrorCode + ": " + e.Description
+}
+
+func (e *BearerError) Unwrap() error {
+	return e.Err
+}
+
+// WWWAuthenticate 返回响应头 WWW-Authenticate 的值
+func (e *BearerError) WWWAuthenticate() string {
+	scheme := "Bearer"
+	if e.ErrorCode == "invalid_dpop_proof" {
+		scheme = "DPoP"
+	}
+	if e.ErrorCode == "" {
+		return scheme
+	}
+	return fmt.Sprintf(`%s error="%s", error_description="%s"`, scheme, e.ErrorCode, strings.ReplaceAll(e.Description, `"`, `'`))
+}
+
+func invalidToken(description string, err error) *BearerError {
+	return &BearerError{StatusCode: http.StatusUnauthorized, ErrorCode: "invalid_token", Description: description, Err: err}
+}
+
+// ContextWithAccessTokenClaims 将 claims 写入 ctx
+func ContextWithAccessTokenClaims(ctx context.Context, claims *AccessTokenClaims) context.Context {
+	return context.WithValue(ctx, accessTokenClaimsContextKey{}, claims)
+}
+
+// AccessTokenClaimsFromContext 读取 BearerMiddleware 写入请求 context 的 claims
+func AccessTokenClaimsFromContext(ctx context.Context) (*AccessTokenClaims, bool) {
+	claims, ok := ctx.Value(accessTokenClaimsContextKey{}).(*AccessTokenClaims)
+	return claims, ok
+}
+
+// AccessTokenClaimsFromRequestCtx 读取 BearerFastHTTPMiddleware 写入的 claims
+func AccessTokenClaimsFromRequestCtx(ctx *fasthttp.RequestCtx) (*AccessTokenClaims, bool) {
+	claims, ok := ctx.UserValue(accessTokenClaimsKey).(*AccessTokenClaims)
+	return claims, ok
+}
+
+// HasScope 判断 access token 是否包含 scope
+func (claims *AccessTokenClaims) HasScope(scope string) bool {
+	for _, value := range strings.Fields(claims.Scope) {
+		if value == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// ExtractBearerToken 从 Authorization 请求头中提取 token，支持 Bearer 与 DPoP 两种方式
+func ExtractBearerToken(authorization string) (string, error) {
+	_, token, err := parseAuthorization(authorization)
+	return token, err
+}
+
+func parseAuthorization(authorization string) (scheme string, token string, err error) {
+	if authorization == "" {
+		return "", "", &BearerError{StatusCode: http.StatusUnauthorized, Description: "缺少 Authorization 请求头"}
+	}
+	parts := strings.SplitN(authorization, " ", 2)
+	scheme = strings.ToLower(parts[0])
+	if len(parts) != 2 || (scheme != "bearer" && scheme != "dpop") || strings.TrimSpace(parts[1]) == "" {
+		return "", "", &BearerError{StatusCode: http.StatusBadRequest, ErrorCode: "invalid_request", Description: "Authorization 请求头格式错误"}
+	}
+	return scheme, strings.TrimSpace(parts[1]), nil
+}
+
+// verifyRequest 校验请求中的 token，token 绑定了 DPoP 密钥（cnf.jkt）时同时校验请求头 DPoP 中的 proof
+func (client *AuthenticationClient) verifyRequest(ctx context.Context, authorization string, dpopProof string, method string, requestUrl string, options *BearerOptions) (*AccessTokenClaims, error) {
+	scheme, token, err := parseAuthorization(authorization)
+	if err != nil {
+		return nil, err
+	}
+	claims, err := client.VerifyBearerToken(ctx, token, options)
+	if err != nil {
+		return nil, err
+	}
+	bound := claims.Cnf != nil && claims.Cnf.Jkt != ""
+	if bound != (scheme == "dpop") {
+		return nil, invalidToken("token 与 DPoP 的使用方式不匹配", nil)
+	}
+	if !bound {
+		return claims, nil
+	}
+	proofOptions := DPoPProofOptions{
+		Method:        method,
+		Url:           requestUrl,
+		AccessToken:   token,
+		JwkThumbprint: claims.Cnf.Jkt,
+	}
+	if options != nil {
+		proofOptions.IsReplay = options.DPoPIsReplay
+	}
+	if _, err = VerifyDPoPProof(dpopProof, proofOptions); err != nil {
+		return nil, &BearerError{StatusCode: http.StatusUnauthorized, ErrorCode: "invalid_dpop_proof", Description: "DPoP proof 无效", Err: err}
+	}
+	return claims, nil
+}
+
+// requestScheme 反向代理后通过 X-Forwarded-Proto 判断原始请求的协议
+func requestScheme(tls bool, forwardedProto string) string {
+	if forwardedProto != "" {
+		return strings.ToLower(strings.TrimSpace(strings.Split(forwardedProto, ",")[0]))
+	}
+	if tls {
+		return "https"
+	}
+	return "http"
+}
+
+// VerifyBearerToken 校验 access token 并检查 scope 与 aud，失败时返回 *BearerError
+func (client *AuthenticationClient) VerifyBearerToken(ctx context.Context, token string, options *BearerOptions) (*AccessTokenClaims, error) {
+	if options == nil {
+		options = &BearerOptions{}
+	}
+	var claims *AccessTokenClaims
+	var err error
+	switch options.Mode {
+	case BearerIntrospectionOnly:
+		claims, err = client.introspectClaims(ctx, token, options.Cache)
+	default:
+		claims, err = client.verifyAccessTokenOffline(ctx, token, options)
+		var bearerError *BearerError
+		if err != nil && !errors.As(err, &bearerError) {
+			offlineUnavailable := errors.Is(err, jwt.ErrTokenMalformed) ||
+				(options.IntrospectOnKeyError && errors.Is(err, jwt.ErrTokenUnverifiable))
+			if options.Mode != BearerOfflineWithIntrospection || !offlineUnavailable {
+				return nil, invalidToken("access token 无效", err)
+			}
+			claims, err = client.introspectClaims(ctx, token, options.Cache)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if options.Audience != "" && !claimsHasAudience(claims, options.Audience) {
+		return nil, invalidToken("access token 的 aud 不匹配", nil)
+	}
+	for _, scope := range options.RequiredScopes {
+		if !claims.HasScope(scope) {
+			return nil, &BearerError{
+				StatusCode:  http.StatusForbidden,
+				ErrorCode:   "insufficient_scope",
+				Description: "缺少 scope " + scope,
+			}
+		}
+	}
+	return claims, nil
+}
+
+// verifyAccessTokenOffline 按 BearerOptions 中的 Issuer 与 AllowedAlgs 离线校验 access token
+func (client *AuthenticationClient) verifyAccessTokenOffline(ctx context.Context, token string, options *BearerOptions) (*AccessTokenClaims, error) {
+	claims, err := client.parseAccessToken(ctx, token, options.Issuer, options.AllowedAlgs)
+	if errors.Is(err, errAccessTokenIssuer) {
+		return nil, &BearerError{StatusCode: http.StatusServiceUnavailable, ErrorCode: "temporarily_unavailable", Description: "获取 issuer 失败", Err: err}
+	}
+	if errors.Is(err, errNotAccessToken) {
+		return nil, invalidToken(err.Error(), nil)
+	}
+	return claims, err
+}
+
+func claimsHasAudience(claims *AccessTokenClaims, audience string) bool {
+	for _, value := range claims.Audience {
+		if value == audience {
+			return true
+		}
+	}
+	return false
+}
+
+// introspectClaims 在线检查 token，cache 为空时使用 client 配置的 IntrospectionCache，有缓存时优先使用缓存结果
+func (client *AuthenticationClient) introspectClaims(ctx context.Context, token string, cache *IntrospectionCache) (*AccessTokenClaims, error) {
+	if cache == nil {
+		cache = client.options.IntrospectionCache
+	}
+	if cache != nil {
+		if claims, found := cache.Get(token); found {
+			if claims == nil {
+				return nil, invalidToken("access token 已失效", nil)
+			}
+			return claims, nil
+		}
+	}
+	body, err := client.introspect(ctx, token)
+	if err != nil {
+		return nil, &BearerError{StatusCode: http.StatusServiceUnavailable, ErrorCode: "temporarily_unavailable", Description: "在线检查 token 失败", Err: err}
+	}
+	var result struct {
+		Active bool `json:"active"`
+		AccessTokenClaims
+	}
+	if err = json.Unmarshal(body, &result); err != nil {
+		return nil, &BearerError{StatusCode: http.StatusServiceUnavailable, ErrorCode: "temporarily_unavailable", Description: "无法解析在线检查结果", Err: err}
+	}
+	var claims *AccessTokenClaims
+	if result.Active {
+		claims = &result.AccessTokenClaims
+	}
+	if cache != nil {
+		cache.Set(token, claims)
+	}
+	if claims == nil {
+		return nil, invalidToken("access token 已失效", nil)
+	}
+	return claims, nil
+}
+
+func toBearerError(err error) *BearerError {
+	var bearerError *BearerError
+	if errors.As(err, &bearerError) {
+		return bearerError
+	}
+	return invalidToken("access token 无效", err)
+}
+
+func writeBearerError(err error, setHeader func(key, value string), writeBody func(statusCode int, body []byte)) {
+	bearerError := toBearerError(err)
+	setHeader("WWW-Authenticate", bearerError.WWWAuthenticate())
+	setHeader("Content-Type", "application/json; charset=utf-8")
+	body, _ := json.Marshal(map[string]string{
+		"error":             bearerError.ErrorCode,
+		"error_description": bearerError.Description,
+	})
+	writeBody(bearerError.StatusCode, body)
+}
+
+// BearerMiddleware 返回保护 net/http 资源的中间件，校验通过后可通过 AccessTokenClaimsFromContext 获取 claims；
+// token 绑定了 DPoP 密钥时要求使用 DPoP 方式并校验 proof
+func (client *AuthenticationClient) BearerMiddleware(options *BearerOptions) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestUrl := requestScheme(r.TLS != nil, r.Header.Get("X-Forwarded-Proto")) + "://" + r.Host + r.URL.Path
+			claims, err := client.verifyRequest(r.Context(), r.Header.Get("Authorization"), r.Header.Get("DPoP"), r.Method, requestUrl, options)
+			if err != nil {
+				writeBearerError(err, w.Header().Set, func(statusCode int, body []byte) {
+					w.WriteHeader(statusCode)
+					w.Write(body)
+				})
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(ContextWithAccessTokenClaims(r.Context(), claims)))
+		})
+	}
+}
+
+// BearerFastHTTPMiddleware 返回保护 fasthttp 资源的 RequestHandler，校验通过后可通过 AccessTokenClaimsFromRequestCtx 获取 claims
+func (client *AuthenticationClient) BearerFastHTTPMiddleware(next fasthttp.RequestHandler, options *BearerOptions) fasthttp.RequestHandler {
+	return func(ctx *fasthttp.RequestCtx) {
+		header := &ctx.Request.Header
+		requestUrl := requestScheme(ctx.IsTLS(), string(header.Peek("X-Forwarded-Proto"))) + "://" + string(ctx.Host()) + string(ctx.Path())
+		claims, err := client.verifyRequest(ctx, string(header.Peek("Authorization")), string(header.Peek("DPoP")), string(ctx.Method()), requestUrl, options)
+		if err != nil {
+			writeBearerError(err, ctx.Response.Header.Set, func(statusCode int, body []byte) {
+				ctx.SetStatusCode(statusCode)
+				ctx.SetBody(body)
+			})
+			return
+		}
+		ctx.SetUserValue(accessTokenClaimsKey, claims)
+		next(ctx)
+	}
+}
@@ -0,0 +1,229 @@
+package authentication
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/valyala/fasthttp"
+)
+
+func signTestAccessToken(t *testing.T, issuer string, scope string, expiresIn time.Duration) string {
+	claims := AccessTokenClaims{}
+	claims.Issuer = issuer
+	claims.Subject = "user"
+	claims.Audience = jwt.ClaimStrings{"api"}
+	claims.ExpiresAt = jwt.NewNumericDate(time.Now().Add(expiresIn))
+	claims.Scope = scope
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+// serveIntrospection 只认为 opaque 是有效的 token
+func serveIntrospection(w http.ResponseWriter, r *http.Request) {
+	if r.PostForm.Get("token") != "opaque" {
+		w.Write([]byte(`{"active":false}`))
+		return
+	}
+	w.Write([]byte(`{"active":true,"sub":"user","aud":"api","scope":"openid read","exp":` +
+		strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10) + `}`))
+}
+
+func serveBearer(handler http.Handler, authorization string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodGet, "/resource", nil)
+	if authorization != "" {
+		r.Header.Set("Authorization", authorization)
+	}
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	return w
+}
+
+func TestBearerMiddleware(t *testing.T) {
+	server := newStubServer(serveIntrospection)
+	defer server.Close()
+	client := newTestClient(t, server.URL)
+
+	handler := client.BearerMiddleware(&BearerOptions{
+		RequiredScopes: []string{"read"},
+		Audience:       "api",
+		Cache:          NewIntrospectionCache(time.Minute),
+	})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		claims, ok := AccessTokenClaimsFromContext(r.Context())
+		if !ok {
+			t.Error("context 中缺少 claims")
+			return
+		}
+		w.Write([]byte(claims.Subject))
+	}))
+
+	if w := serveBearer(handler, "Bearer "+signTestAccessToken(t, server.URL+"/oidc", "openid read", time.Hour)); w.Code != http.StatusOK || w.Body.String() != "user" {
+		t.Fatalf("离线校验应通过: %d %s", w.Code, w.Body.String())
+	}
+	if server.hits("") != 0 {
+		t.Fatal("离线校验通过时不应在线检查")
+	}
+
+	for i := 0; i < 2; i++ {
+		if w := serveBearer(handler, "Bearer opaque"); w.Code != http.StatusOK || w.Body.String() != "user" {
+			t.Fatalf("非 JWT token 应在线检查: %d %s", w.Code, w.Body.String())
+		}
+	}
+	if n := server.hits(""); n != 1 {
+		t.Fatalf("检查结果应被缓存, 实际请求 %d 次", n)
+	}
+
+	cases := []struct {
+		name          string
+		authorization string
+		status        int
+		errorCode     string
+	}{
+		{"缺少请求头", "", http.StatusUnauthorized, ""},
+		{"格式错误", "Basic abc", http.StatusBadRequest, "invalid_request"},
+		{"scope 不足", "Bearer " + signTestAccessToken(t, server.URL+"/oidc", "openid", time.Hour), http.StatusForbidden, "insufficient_scope"},
+		{"已过期", "Bearer " + signTestAccessToken(t, server.URL+"/oidc", "read", -time.Minute), http.StatusUnauthorized, "invalid_token"},
+		{"已失效", "Bearer revoked", http.StatusUnauthorized, "invalid_token"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			w := serveBearer(handler, c.authorization)
+			if w.Code != c.status {
+				t.Fatalf("期望状态码 %d, 实际 %d", c.status, w.Code)
+			}
+			challenge := w.Header().Get("WWW-Authenticate")
+			if !strings.HasPrefix(challenge, "Bearer") || !strings.Contains(challenge, c.errorCode) {
+				t.Fatalf("WWW-Authenticate 不符合预期: %q", challenge)
+			}
+		})
+	}
+}
+
+func TestVerifyBearerToken_OfflineOnly(t *testing.T) {
+	server := newStubServer(serveIntrospection)
+	defer server.Close()
+	client := newTestClient(t, server.URL)
+
+	_, err := client.VerifyBearerToken(context.Background(), "opaque", &BearerOptions{Mode: BearerOfflineOnly})
+	var bearerError *BearerError
+	if !errors.As(err, &bearerError) || bearerError.ErrorCode != "invalid_token" || !errors.Is(err, jwt.ErrTokenMalformed) {
+		t.Fatalf("期望 invalid_token, 实际 %v", err)
+	}
+	if server.hits("") != 0 {
+		t.Fatal("仅离线校验时不应在线检查")
+	}
+
+	claims, err := client.VerifyBearerToken(context.Background(), "opaque", &BearerOptions{Mode: BearerIntrospectionOnly, Audience: "api"})
+	if err != nil || claims.Subject != "user" || !claims.HasScope("read") {
+		t.Fatalf("在线检查结果不符合预期: %+v %v", claims, err)
+	}
+}
+
+const testBearerIssuer = "http://127.0.0.1:0/oidc"
+
+func TestBearerFastHTTPMiddleware(t *testing.T) {
+	client := newTestClient(t, "http://127.0.0.1:0")
+	handler := client.BearerFastHTTPMiddleware(func(ctx *fasthttp.RequestCtx) {
+		claims, _ := AccessTokenClaimsFromRequestCtx(ctx)
+		ctx.SetBodyString(claims.Subject)
+	}, &BearerOptions{Mode: BearerOfflineOnly, RequiredScopes: []string{"read"}})
+
+	ctx := &fasthttp.RequestCtx{}
+	ctx.Request.Header.Set("Authorization", "Bearer "+signTestAccessToken(t, testBearerIssuer, "read", time.Hour))
+	handler(ctx)
+	if ctx.Response.StatusCode() != fasthttp.StatusOK || string(ctx.Response.Body()) != "user" {
+		t.Fatalf("校验应通过: %d %s", ctx.Response.StatusCode(), ctx.Response.Body())
+	}
+
+	ctx = &fasthttp.RequestCtx{}
+	ctx.Request.Header.Set("Authorization", "Bearer "+signTestAccessToken(t, testBearerIssuer, "openid", time.Hour))
+	handler(ctx)
+	if ctx.Response.StatusCode() != fasthttp.StatusForbidden ||
+		!strings.Contains(string(ctx.Response.Header.Peek("WWW-Authenticate")), "insufficient_scope") {
+		t.Fatalf("scope 不足时应返回 403: %d", ctx.Response.StatusCode())
+	}
+}
+
+func TestVerifyBearerToken_RejectsOtherTokens(t *testing.T) {
+	server := newStubServer(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/introspection") {
+			w.Write([]byte(`{"active":true,"sub":"user","aud":"api"}`))
+			return
+		}
+		w.Write([]byte(`{"keys":[]}`))
+	})
+	defer server.Close()
+	client := newTestClient(t, server.URL)
+	issuer := server.URL + "/oidc"
+	sign := func(method jwt.SigningMethod, key interface{}, header map[string]interface{}, claims jwt.MapClaims) string {
+		token := jwt.NewWithClaims(method, claims)
+		for name, value := range header {
+			token.Header[name] = value
+		}
+		signed, err := token.SignedString(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return signed
+	}
+	exp := time.Now().Add(time.Hour).Unix()
+
+	cases := []struct {
+		name    string
+		token   string
+		options *BearerOptions
+	}{
+		{"id token", sign(jwt.SigningMethodHS256, []byte("secret"), nil, jwt.MapClaims{"iss": issuer, "aud": "app", "sub": "user", "nonce": "n", "exp": exp}), &BearerOptions{Audience: "app"}},
+		{"签发者不匹配", sign(jwt.SigningMethodHS256, []byte("secret"), nil, jwt.MapClaims{"iss": "https://evil.example.com/oidc", "aud": "api", "sub": "user", "exp": exp}), &BearerOptions{}},
+		{"签名算法不被允许", sign(jwt.SigningMethodHS256, []byte("secret"), nil, jwt.MapClaims{"iss": issuer, "aud": "api", "sub": "user", "exp": exp}), &BearerOptions{AllowedAlgs: []string{"RS256"}}},
+		{"typ 不匹配", sign(jwt.SigningMethodHS256, []byte("secret"), map[string]interface{}{"typ": "logout+jwt"}, jwt.MapClaims{"iss": issuer, "aud": "api", "sub": "user", "exp": exp}), &BearerOptions{}},
+		{"未知 kid", sign(jwt.SigningMethodRS256, newTestRSAKey(t), map[string]interface{}{"kid": "unknown"}, jwt.MapClaims{"iss": issuer, "aud": "api", "sub": "user", "exp": exp}), &BearerOptions{}},
+		{"缺少 exp", sign(jwt.SigningMethodHS256, []byte("secret"), nil, jwt.MapClaims{"iss": issuer, "aud": "api", "sub": "user"}), &BearerOptions{}},
+		{"包含 at_hash", sign(jwt.SigningMethodHS256, []byte("secret"), nil, jwt.MapClaims{"iss": issuer, "aud": "app", "sub": "user", "at_hash": "h", "exp": exp}), &BearerOptions{}},
+	}
+	for _, c := range cases {
+		var bearerError *BearerError
+		if _, err := client.VerifyBearerToken(context.Background(), c.token, c.options); !errors.As(err, &bearerError) || bearerError.ErrorCode != "invalid_token" {
+			t.Fatalf("%s: 期望 invalid_token, 实际 %v", c.name, err)
+		}
+	}
+	introspections := func() int {
+		return server.hits("/oidc/token/introspection")
+	}
+	if n := introspections(); n != 0 {
+		t.Fatalf("离线校验失败时默认不应在线检查, 实际请求 %d 次", n)
+	}
+	if _, err := client.VerifyBearerToken(context.Background(), cases[4].token, &BearerOptions{Audience: "api", IntrospectOnKeyError: true}); err != nil || introspections() != 1 {
+		t.Fatalf("开启 IntrospectOnKeyError 后无法获取公钥时应在线检查: %v", err)
+	}
+	for _, c := range cases {
+		if _, err := client.IntrospectAccessTokenOffline(c.token); err == nil && c.options.AllowedAlgs == nil {
+			t.Fatalf("%s: IntrospectAccessTokenOffline 应与中间件使用相同的校验规则", c.name)
+		}
+	}
+
+	// Authing 签发的 access token 的 aud 为 AppId，且不包含 nonce 等 id token 的 claims
+	accessToken := sign(jwt.SigningMethodHS256, []byte("secret"), map[string]interface{}{"typ": "at+jwt"},
+		jwt.MapClaims{"iss": issuer, "aud": "app", "sub": "user", "scope": "openid profile", "jti": "j", "iat": time.Now().Unix(), "exp": exp})
+	if claims, err := client.VerifyBearerToken(context.Background(), accessToken, &BearerOptions{}); err != nil || claims.Subject != "user" {
+		t.Fatalf("默认配置下应接受 aud 为 AppId 的 access token: %v", err)
+	}
+	if _, err := client.IntrospectAccessTokenOffline(accessToken); err != nil {
+		t.Fatalf("IntrospectAccessTokenOffline 应接受 access token: %v", err)
+	}
+
+	noSecret := newTestClient(t, server.URL, withoutSecret)
+	forged := sign(jwt.SigningMethodHS256, []byte(""), nil, jwt.MapClaims{"iss": issuer, "aud": "api", "sub": "user", "scope": "read", "exp": exp})
+	if _, err := noSecret.VerifyBearerToken(context.Background(), forged, &BearerOptions{RequiredScopes: []string{"read"}}); err == nil {
+		t.Fatal("未配置 AppSecret 时不应接受 HS256 签名")
+	}
+}
@@ -3,6 +3,7 @@ package authentication
 import (
 	"time"
 
+	"github.com/Authing/authing-golang-sdk/v3/util"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/valyala/fasthttp"
 )
@@ -57,20 +58,84 @@ type AuthenticationClientOptions struct {
 	TokenEndPointAuthMethod TokenAuthMethodEnum
 
 	/**
-	检测 token 端点认证方式
+	private_key_jwt 认证方式使用的 PEM 格式私钥，RSA 私钥使用 RS256 签名，EC（P-256）私钥使用 ES256 签名
+	*/
+	PrivateKey string
+
+	/**
+	private_key_jwt 认证方式中 client assertion 头部的 kid，与应用配置的公钥对应
+	*/
+	PrivateKeyId string
+
+	/**
+	检测 token 端点认证方式，为空时使用 TokenEndPointAuthMethod
 	*/
 	IntrospectionEndPointAuthMethod TokenAuthMethodEnum
 
 	/**
-	撤销 token 端点认证方式
+	撤销 token 端点认证方式，为空时使用 TokenEndPointAuthMethod
 	*/
 	RevocationEndPointAuthMethod TokenAuthMethodEnum
 
+	/**
+	在线检查 token 结果的缓存，为空时不缓存；BearerOptions 未指定 Cache 时 Bearer 中间件也使用该缓存。
+	缓存命中时 IntrospectToken 的结果由缓存的 claims 生成，不包含 token_type
+	*/
+	IntrospectionCache *IntrospectionCache
+
 	/**
 	协议类型，默认为 oidc
 	*/
 	Protocol ProtocolEnum
 
+	/**
+	应用配置的 id token 签名算法，例如 RS256、HS256。为空时使用服务发现元数据中声明的算法，
+	未启用服务发现时允许 RS256 与 HS256；未配置 AppSecret 时始终不允许 HS256
+	*/
+	IdTokenSignAlg string
+
+	/**
+	用于校验 token 签名的 KeySet，可在同一用户池的多个 client 之间共享；
+	为空时从 jwks_uri 获取，无法访问 jwks_uri 时可使用 NewStaticKeySet 传入固定的 JWKS
+	*/
+	KeySet *KeySet
+
+	/**
+	KeySet 为空时创建 KeySet 使用的刷新配置
+	*/
+	KeySetOptions *KeySetOptions
+
+	/**
+	是否启用 OIDC 服务发现，启用后 OIDC 相关端点从服务发现元数据中读取
+	*/
+	EnableDiscovery bool
+
+	/**
+	OIDC 服务发现地址，默认为 AppHost + /oidc/.well-known/openid-configuration
+	*/
+	DiscoveryUrl string
+
+	/**
+	OIDC 服务发现元数据缓存时间，默认为 24 小时
+	*/
+	DiscoveryCacheTTL time.Duration
+
+	/**
+	判断 logout token 的 jti 是否已使用过，用于防止后端登出通知被重放，为空时不检查。
+	实现需记录 jti 直到 logout token 过期，多实例部署时应使用共享存储
+	*/
+	LogoutTokenIsReplay func(jti string) bool
+
+	/**
+	是否启用 DPoP（RFC 9449），启用后 token 与用户信息请求会附加 DPoP proof，签发的 token 与密钥绑定
+	*/
+	EnableDPoP bool
+
+	/**
+	DPoP 使用的 PEM 格式 EC（P-256）私钥，为空时自动生成；多实例部署或持久化 token 时需固定该私钥
+	*/
+	DPoPPrivateKey string
+
 	/**
 	请求超时时间
 	*/
@@ -91,22 +156,83 @@ type AuthenticationClientOptions struct {
 	 * 自定义 Client 创建函数
 	 */
 	CreateClientFunc func(options *AuthenticationClientOptions) *fasthttp.Client
+	/**
+	 * 请求重试策略，默认为 util.DefaultRetryPolicy()；设置 MaxAttempts 为 1 可关闭重试
+	 */
+	RetryPolicy *util.RetryPolicy
 }
 
 type AuthUrlResult struct {
 	Url   string
 	State string
 	Nonce string
+	/**
+	使用 PKCE 时的 code_verifier，需在换取 token 时通过 CodeToTokenParams 传入
+	*/
+	CodeVerifier string
 }
 
 type OIDCAuthURLParams struct {
-	RedirectUri  string
-	State        string
-	Nonce        string
-	Scope        string
+	RedirectUri string
+	State       string
+	Nonce       string
+	Scope       string
+	/**
+	默认为 code，可选 code id_token、id_token token 等混合或隐式模式
+	*/
 	ResponseType string
+	/**
+	默认为 query，可选 fragment、form_post
+	*/
 	ResponseMode string
-	Forced       bool
+	/**
+	是否强制用户重新登录，Prompt 不为空时忽略
+	*/
+	Forced bool
+	/**
+	prompt 参数，例如 none、login、consent
+	*/
+	Prompt string
+	/**
+	提示认证服务器用户的登录标识，例如邮箱或手机号
+	*/
+	LoginHint string
+	/**
+	登录页面的语言偏好，多个值以空格分隔，例如 zh-CN en-US
+	*/
+	UiLocales string
+	/**
+	请求的认证上下文类引用，多个值以空格分隔
+	*/
+	AcrValues string
+	/**
+	用户距上次认证的最大秒数，超过后需要重新认证，为 nil 时不传
+	*/
+	MaxAge *int
+	/**
+	租户 ID
+	*/
+	TenantId string
+	/**
+	其他自定义参数，不会覆盖上述标准参数
+	*/
+	ExtraParams map[string]string
+	/**
+	PKCE 方式，可选 S256 或 plain，为空时不使用 PKCE
+	*/
+	CodeChallengeMethod CodeChallengeMethodEnum
+	/**
+	PKCE 的 code_verifier，为空时自动生成
+	*/
+	CodeVerifier string
+	/**
+	是否先将授权参数推送到 PAR 端点（RFC 9126），返回的授权地址只包含 client_id 与 request_uri
+	*/
+	PushedAuthorizationRequest bool
+	/**
+	是否将授权参数放入签名的 request 对象（RFC 9101），配置了 PrivateKey 时使用私钥签名，否则使用 AppSecret 以 HS256 签名
+	*/
+	SignedRequestObject bool
 }
 
 type OAuth2AuthURLParams struct {
@@ -117,12 +243,30 @@ type OAuth2AuthURLParams struct {
 	*/
 	Scope        string
 	ResponseType string
+	/**
+	PKCE 方式，可选 S256 或 plain，为空时不使用 PKCE
+	*/
+	CodeChallengeMethod CodeChallengeMethodEnum
+	/**
+	PKCE 的 code_verifier，为空时自动生成
+	*/
+	CodeVerifier string
 }
 
 type CodeToTokenParams struct {
-	Code        string
+	Code string
+	/**
+	授权请求中使用的 redirect_uri，为空时使用初始化时传入的 RedirectUri
+	*/
 	RedirectUri string
-	Nonce       string
+	/**
+	授权请求中使用的 nonce，不为空时会校验 id token 中的 nonce
+	*/
+	Nonce string
+	/**
+	授权请求使用 PKCE 时的 code_verifier
+	*/
+	CodeVerifier string
 }
 
 type OIDCTokenResponse struct {
@@ -136,36 +280,62 @@ type OIDCTokenResponse struct {
 }
 
 type UserInfoCommon struct {
-	Name              string `json:"name,omitempty"`
-	Nickname          string `json:"nickname,omitempty"`
-	GivenName         string `json:"given_name,omitempty"`
-	FamilyName        string `json:"family_name,omitempty"`
-	Birthdate         string `json:"birthdate,omitempty"`
-	Gender            string `json:"gender,omitempty"` //'M' | 'F' | 'U'
-	Picture           string `json:"picture,omitempty"`
-	UpdatedAt         string `json:"updated_at,omitempty"`
-	Zoneinfo          string `json:"zoneinfo,omitempty"`
-	PreferredUsername string `json:"preferred_username,omitempty"`
-	Locale            string `json:"locale,omitempty"`
-}
-type UserInfo struct { // TODO 允许扩展
+	Name                string `json:"name,omitempty"`
+	Nickname            string `json:"nickname,omitempty"`
+	GivenName           string `json:"given_name,omitempty"`
+	FamilyName          string `json:"family_name,omitempty"`
+	Birthdate           string `json:"birthdate,omitempty"`
+	Gender              string `json:"gender,omitempty"` //'M' | 'F' | 'U'
+	Picture             string `json:"picture,omitempty"`
+	UpdatedAt           string `json:"updated_at,omitempty"`
+	Zoneinfo            string `json:"zoneinfo,omitempty"`
+	PreferredUsername   string `json:"preferred_username,omitempty"`
+	Locale              string `json:"locale,omitempty"`
+	Email               string `json:"email,omitempty"`
+	EmailVerified       bool   `json:"email_verified,omitempty"`
+	PhoneNumber         string `json:"phone_number,omitempty"`
+	PhoneNumberVerified bool   `json:"phone_number_verified,omitempty"`
+}
+type UserInfo struct {
 	Subject string `json:"sub,omitempty"` // 用户 ID
 	UserInfoCommon
+	/**
+	用户信息中的全部 claims，包括未映射到上述字段的自定义 claims
+	*/
+	Claims map[string]interface{} `json:"-"`
 }
 
 type IDTokenExtended struct {
-	Nonce  string `json:"nonce,omitempty"`
-	AtHash string `json:"at_hash,omitempty"`
-	SHash  string `json:"s_hash,omitempty"`
+	Nonce    string           `json:"nonce,omitempty"`
+	AtHash   string           `json:"at_hash,omitempty"`
+	SHash    string           `json:"s_hash,omitempty"`
+	Azp      string           `json:"azp,omitempty"`
+	AuthTime *jwt.NumericDate `json:"auth_time,omitempty"`
 }
 
 type IDTokenClaims struct {
 	UserInfoCommon
 	IDTokenExtended
 	jwt.RegisteredClaims
+	/**
+	id token 中的全部 claims，包括未映射到上述字段的自定义 claims
+	*/
+	Claims map[string]interface{} `json:"-"`
 }
 type AccessTokenExtended struct {
-	Scope string `json:"scope,omitempty"`
+	Scope    string `json:"scope,omitempty"`
+	ClientId string `json:"client_id,omitempty"`
+	/**
+	token 绑定的密钥信息，使用 DPoP 时包含 jkt
+	*/
+	Cnf *TokenConfirmation `json:"cnf,omitempty"`
+}
+
+type TokenConfirmation struct {
+	/**
+	DPoP 公钥的 JWK SHA-256 指纹（RFC 7638）
+	*/
+	Jkt string `json:"jkt,omitempty"`
 }
 
 type AccessTokenClaims struct {
@@ -194,9 +364,26 @@ type TokenAuthMethodEnum string
 const (
 	ClientSecretPost  = "client_secret_post"
 	ClientSecretBasic = "client_secret_basic"
+	ClientSecretJwt   = "client_secret_jwt"
+	PrivateKeyJwt     = "private_key_jwt"
 	None              = "none"
 )
 
+// TokenTypeHintEnum 撤销或检查 token 时的 token_type_hint（RFC 7009）
+type TokenTypeHintEnum string
+
+const (
+	AccessTokenHint  TokenTypeHintEnum = "access_token"
+	RefreshTokenHint TokenTypeHintEnum = "refresh_token"
+)
+
+type CodeChallengeMethodEnum string
+
+const (
+	CodeChallengeS256  CodeChallengeMethodEnum = util.PKCEMethodS256
+	CodeChallengePlain CodeChallengeMethodEnum = util.PKCEMethodPlain
+)
+
 type ProtocolEnum string
 
 const (
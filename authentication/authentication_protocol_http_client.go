@@ -1,6 +1,7 @@
 package authentication
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -30,6 +31,11 @@ type ResponseData struct {
 }
 
 func (client AuthenticationClient) SendProtocolHttpRequest(option *ProtocolRequestOption) (*ResponseData, error) {
+	return client.SendProtocolHttpRequestWithContext(context.Background(), option)
+}
+
+// SendProtocolHttpRequestWithContext 发送协议请求，ctx 被取消或到达截止时间时中止请求
+func (client AuthenticationClient) SendProtocolHttpRequestWithContext(ctx context.Context, option *ProtocolRequestOption) (*ResponseData, error) {
 	req := fasthttp.AcquireRequest()
 	defer fasthttp.ReleaseRequest(req)
 
@@ -48,9 +54,6 @@ func (client AuthenticationClient) SendProtocolHttpRequest(option *ProtocolReque
 		req.Header.Add(key, value)
 	}
 
-	resp := fasthttp.AcquireResponse()
-	defer fasthttp.ReleaseResponse(resp)
-
 	switch method {
 	case fasthttp.MethodPost:
 		if option.ContentType == Json {
@@ -75,20 +78,21 @@ func (client AuthenticationClient) SendProtocolHttpRequest(option *ProtocolReque
 		}, fmt.Errorf("不支持的请求类型")
 	}
 
-	err := client.httpClient.DoTimeout(req, resp, client.options.ReadTimeout)
+	res, err := client.roundTrip(&util.RoundTripRequest{
+		Context: ctx,
+		Path:    string(req.URI().Path()),
+		Method:  method,
+		ReqDto:  reqDto,
+		Request: req,
+	})
 	if err != nil {
 		return &ResponseData{
 			StatusCode: 500,
 		}, err
 	}
-	statusCode := resp.StatusCode()
-	body := resp.Body()
-
-	header := fasthttp.ResponseHeader{}
-	resp.Header.CopyTo(&header)
 	return &ResponseData{
-		Body:       body,
-		Header:     &header,
-		StatusCode: statusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+		StatusCode: res.StatusCode,
 	}, nil
 }
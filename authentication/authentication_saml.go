@@ -0,0 +1,684 @@
+package authentication
+
+import (
+	"bytes"
+	"compress/flate"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"html"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Authing/authing-golang-sdk/v3/util"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	samlAssertionNamespace = "urn:oasis:names:tc:SAML:2.0:assertion"
+	samlProtocolNamespace  = "urn:oasis:names:tc:SAML:2.0:protocol"
+	samlMetadataNamespace  = "urn:oasis:names:tc:SAML:2.0:metadata"
+	samlStatusSuccess      = "urn:oasis:names:tc:SAML:2.0:status:Success"
+	samlBearerConfirmation = "urn:oasis:names:tc:SAML:2.0:cm:bearer"
+	samlTimeFormat         = "2006-01-02T15:04:05Z"
+)
+
+// SamlBindingEnum SAML 2.0 协议绑定
+type SamlBindingEnum string
+
+const (
+	SamlHTTPRedirect SamlBindingEnum = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect"
+	SamlHTTPPost     SamlBindingEnum = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+)
+
+var (
+	ErrSamlMalformed    = errors.New("SAML 响应格式错误")
+	ErrSamlSignature    = errors.New("SAML 签名无效")
+	ErrSamlStatus       = errors.New("SAML 认证失败")
+	ErrSamlIssuer       = errors.New("SAML 签发者不匹配")
+	ErrSamlDestination  = errors.New("SAML 接收地址不匹配")
+	ErrSamlInResponseTo = errors.New("SAML InResponseTo 不匹配")
+	ErrSamlAudience     = errors.New("SAML 受众不匹配")
+	ErrSamlExpired      = errors.New("SAML 断言已过期")
+	ErrSamlNotValidYet  = errors.New("SAML 断言尚未生效")
+	ErrSamlReplay       = errors.New("SAML 断言已使用过")
+)
+
+// SamlError SAML 响应校验失败，可通过 errors.Is 与 ErrSaml* 判断失败原因
+type SamlError struct {
+	Err     error
+	Message string
+}
+
+func (e *SamlError) Error() string {
+	if e.Message == "" {
+		return e.Err.Error()
+	}
+	return e.Err.Error() + ": " + e.Message
+}
+
+func (e *SamlError) Unwrap() error {
+	return e.Err
+}
+
+func newSamlError(err error, format string, args ...interface{}) *SamlError {
+	return &SamlError{Err: err, Message: fmt.Sprintf(format, args...)}
+}
+
+type SamlServiceProviderOptions struct {
+	/**
+	必填，SP 的 entityID，即断言中要求的 Audience
+	*/
+	EntityId string
+	/**
+	必填，接收 SAMLResponse 的地址（HTTP-POST 绑定）
+	*/
+	AssertionConsumerServiceUrl string
+	/**
+	SP 的 PEM 格式证书，与 PrivateKey 同时配置时 AuthnRequest 会被签名并在元数据中声明
+	*/
+	Certificate string
+	/**
+	SP 的 PEM 格式 RSA 私钥
+	*/
+	PrivateKey string
+	/**
+	IdP 元数据，配置后不再请求 IdPMetadataUrl
+	*/
+	IdPMetadata []byte
+	/**
+	IdP 元数据地址，默认为 AppHost + /api/v2/saml-idp/{AppId}/metadata
+	*/
+	IdPMetadataUrl string
+	/**
+	IdP 元数据缓存时间，默认为 24 小时
+	*/
+	IdPMetadataCacheTTL time.Duration
+	/**
+	请求的 NameID 格式，为空时不指定
+	*/
+	NameIdFormat string
+	/**
+	校验 NotBefore、NotOnOrAfter 时允许的时钟偏差
+	*/
+	ClockSkew time.Duration
+	/**
+	是否接受 IdP 发起的登录（不包含 InResponseTo 的响应）；开启时建议配置 AssertionIsReplay
+	*/
+	AllowIdPInitiated bool
+	/**
+	判断断言 ID 是否已使用过，用于防止同一 SAMLResponse 在 NotOnOrAfter 之前被重放，为空时不检查。
+	实现需记录断言 ID 直到断言过期，多实例部署时应使用共享存储
+	*/
+	AssertionIsReplay func(assertionId string) bool
+}
+
+// SamlIdPMetadata 从 IdP 元数据中读取的信息
+type SamlIdPMetadata struct {
+	EntityId string
+	/**
+	各绑定对应的单点登录地址
+	*/
+	SingleSignOnServices map[SamlBindingEnum]string
+	/**
+	用于校验签名的证书
+	*/
+	Certificates []*x509.Certificate
+}
+
+// samlMetadataRetryInterval 获取 IdP 元数据失败并继续使用缓存时，距下次重新获取的间隔
+const samlMetadataRetryInterval = time.Minute
+
+type samlMetadataCache struct {
+	mutex     sync.Mutex
+	metadata  *SamlIdPMetadata
+	expiresAt time.Time
+}
+
+// SamlServiceProvider SAML 2.0 SP，负责生成 SP 元数据、构造 AuthnRequest 与校验 SAMLResponse
+type SamlServiceProvider struct {
+	client      *AuthenticationClient
+	options     SamlServiceProviderOptions
+	privateKey  *rsa.PrivateKey
+	certificate *x509.Certificate
+	metadata    *samlMetadataCache
+}
+
+// NewSamlServiceProvider 创建 SAML SP，IdP 为当前 client 对应的 Authing 应用
+func (client *AuthenticationClient) NewSamlServiceProvider(options *SamlServiceProviderOptions) (*SamlServiceProvider, error) {
+	if options == nil || options.EntityId == "" || options.AssertionConsumerServiceUrl == "" {
+		return nil, errors.New("EntityId 与 AssertionConsumerServiceUrl 不能为空")
+	}
+	sp := &SamlServiceProvider{client: client, options: *options, metadata: &samlMetadataCache{}}
+	if sp.options.IdPMetadataCacheTTL <= 0 {
+		sp.options.IdPMetadataCacheTTL = 24 * time.Hour
+	}
+	if (options.Certificate == "") != (options.PrivateKey == "") {
+		return nil, errors.New("Certificate 与 PrivateKey 需同时配置")
+	}
+	if options.PrivateKey != "" {
+		privateKey, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(options.PrivateKey))
+		if err != nil {
+			return nil, fmt.Errorf("无法解析 PrivateKey，需为 PEM 格式的 RSA 私钥: %w", err)
+		}
+		certificate, err := parseSamlCertificate(options.Certificate)
+		if err != nil {
+			return nil, err
+		}
+		sp.privateKey, sp.certificate = privateKey, certificate
+	}
+	if options.IdPMetadata != nil {
+		metadata, err := parseSamlIdPMetadata(options.IdPMetadata)
+		if err != nil {
+			return nil, err
+		}
+		sp.metadata.metadata = metadata
+	}
+	return sp, nil
+}
+
+// parseSamlCertificate 解析 PEM 格式或元数据中 base64 格式的 X.509 证书
+func parseSamlCertificate(value string) (*x509.Certificate, error) {
+	var der []byte
+	if block, _ := pem.Decode([]byte(value)); block != nil {
+		der = block.Bytes
+	} else {
+		decoded, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(value), ""))
+		if err != nil {
+			return nil, fmt.Errorf("无法解析证书: %w", err)
+		}
+		der = decoded
+	}
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("无法解析证书: %w", err)
+	}
+	return certificate, nil
+}
+
+// Metadata 生成 SP 元数据 XML，用于在 Authing 控制台配置 SAML 应用
+func (sp *SamlServiceProvider) Metadata() []byte {
+	var buf bytes.Buffer
+	buf.WriteString(`<md:EntityDescriptor xmlns:md="` + samlMetadataNamespace + `" entityID="` + xmlEscape(sp.options.EntityId) + `">`)
+	fmt.Fprintf(&buf, `<md:SPSSODescriptor AuthnRequestsSigned="%t" WantAssertionsSigned="true" protocolSupportEnumeration="%s">`, sp.certificate != nil, samlProtocolNamespace)
+	if sp.certificate != nil {
+		buf.WriteString(`<md:KeyDescriptor use="signing"><ds:KeyInfo xmlns:ds="` + xmlDSigNamespace + `"><ds:X509Data><ds:X509Certificate>` +
+			base64.StdEncoding.EncodeToString(sp.certificate.Raw) + `</ds:X509Certificate></ds:X509Data></ds:KeyInfo></md:KeyDescriptor>`)
+	}
+	if sp.options.NameIdFormat != "" {
+		buf.WriteString(`<md:NameIDFormat>` + xmlEscape(sp.options.NameIdFormat) + `</md:NameIDFormat>`)
+	}
+	buf.WriteString(`<md:AssertionConsumerService Binding="` + string(SamlHTTPPost) + `" Location="` + xmlEscape(sp.options.AssertionConsumerServiceUrl) + `" index="0" isDefault="true"></md:AssertionConsumerService>`)
+	buf.WriteString(`</md:SPSSODescriptor></md:EntityDescriptor>`)
+	return buf.Bytes()
+}
+
+type samlEntityDescriptor struct {
+	XMLName          xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:metadata EntityDescriptor"`
+	EntityId         string   `xml:"entityID,attr"`
+	IDPSSODescriptor *struct {
+		KeyDescriptors []struct {
+			Use          string   `xml:"use,attr"`
+			Certificates []string `xml:"KeyInfo>X509Data>X509Certificate"`
+		} `xml:"KeyDescriptor"`
+		SingleSignOnServices []struct {
+			Binding  string `xml:"Binding,attr"`
+			Location string `xml:"Location,attr"`
+		} `xml:"SingleSignOnService"`
+	} `xml:"IDPSSODescriptor"`
+}
+
+// parseSamlIdPMetadata 解析 IdP 元数据，只读取 use 为 signing 或未指定 use 的证书
+func parseSamlIdPMetadata(data []byte) (*SamlIdPMetadata, error) {
+	var descriptor samlEntityDescriptor
+	if err := xml.Unmarshal(data, &descriptor); err != nil {
+		return nil, fmt.Errorf("无法解析 IdP 元数据: %w", err)
+	}
+	if descriptor.EntityId == "" || descriptor.IDPSSODescriptor == nil {
+		return nil, errors.New("IdP 元数据缺少 entityID 或 IDPSSODescriptor")
+	}
+	metadata := &SamlIdPMetadata{EntityId: descriptor.EntityId, SingleSignOnServices: map[SamlBindingEnum]string{}}
+	for _, service := range descriptor.IDPSSODescriptor.SingleSignOnServices {
+		metadata.SingleSignOnServices[SamlBindingEnum(service.Binding)] = service.Location
+	}
+	for _, keyDescriptor := range descriptor.IDPSSODescriptor.KeyDescriptors {
+		if keyDescriptor.Use != "" && keyDescriptor.Use != "signing" {
+			continue
+		}
+		for _, value := range keyDescriptor.Certificates {
+			certificate, err := parseSamlCertificate(value)
+			if err != nil {
+				return nil, err
+			}
+			metadata.Certificates = append(metadata.Certificates, certificate)
+		}
+	}
+	if len(metadata.Certificates) == 0 {
+		return nil, errors.New("IdP 元数据缺少签名证书")
+	}
+	return metadata, nil
+}
+
+// IdPMetadata 获取 IdP 元数据，结果按 IdPMetadataCacheTTL 缓存；获取失败时若存在已缓存的元数据则继续使用，并在 1 分钟后再次尝试获取。
+// 配置了 IdPMetadata 时直接返回创建 SP 时解析的结果
+func (sp *SamlServiceProvider) IdPMetadata(ctx context.Context) (*SamlIdPMetadata, error) {
+	if sp.options.IdPMetadata != nil {
+		return sp.metadata.metadata, nil
+	}
+	cache := sp.metadata
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	if cache.metadata != nil && time.Now().Before(cache.expiresAt) {
+		return cache.metadata, nil
+	}
+	metadata, err := sp.fetchIdPMetadata(ctx)
+	if err != nil {
+		if cache.metadata != nil {
+			cache.expiresAt = time.Now().Add(samlMetadataRetryInterval)
+			return cache.metadata, nil
+		}
+		return nil, err
+	}
+	cache.metadata = metadata
+	cache.expiresAt = time.Now().Add(sp.options.IdPMetadataCacheTTL)
+	return metadata, nil
+}
+
+func (sp *SamlServiceProvider) fetchIdPMetadata(ctx context.Context) (*SamlIdPMetadata, error) {
+	metadataUrl := sp.options.IdPMetadataUrl
+	if metadataUrl == "" {
+		metadataUrl = sp.client.BuildAuthorizeUrlBySaml() + "/metadata"
+	}
+	res, err := sp.client.SendProtocolHttpRequestWithContext(ctx, &ProtocolRequestOption{
+		Url:     metadataUrl,
+		Method:  fasthttp.MethodGet,
+		Headers: sp.client.getReqHeaders(nil),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("获取 IdP 元数据失败: %w", err)
+	}
+	if res.StatusCode != 200 {
+		return nil, fmt.Errorf("获取 IdP 元数据失败[%d]:%s", res.StatusCode, res.Body)
+	}
+	return parseSamlIdPMetadata(res.Body)
+}
+
+// SamlAuthnRequest 构造好的 AuthnRequest
+type SamlAuthnRequest struct {
+	/**
+	请求 ID，需保存在会话中，校验 SAMLResponse 时作为 InResponseTo 传入
+	*/
+	Id      string
+	Binding SamlBindingEnum
+	/**
+	HTTP-Redirect 绑定时为完整的跳转地址；HTTP-POST 绑定时为表单提交地址
+	*/
+	Url string
+	/**
+	HTTP-POST 绑定时表单中的 SAMLRequest 字段
+	*/
+	SAMLRequest string
+	RelayState  string
+}
+
+// PostForm 返回 HTTP-POST 绑定使用的自动提交 HTML 表单
+func (request *SamlAuthnRequest) PostForm() string {
+	var buf bytes.Buffer
+	buf.WriteString(`<!DOCTYPE html><html><body onload="document.forms[0].submit()">`)
+	buf.WriteString(`<form method="post" action="` + html.EscapeString(request.Url) + `">`)
+	buf.WriteString(`<input type="hidden" name="SAMLRequest" value="` + html.EscapeString(request.SAMLRequest) + `"/>`)
+	if request.RelayState != "" {
+		buf.WriteString(`<input type="hidden" name="RelayState" value="` + html.EscapeString(request.RelayState) + `"/>`)
+	}
+	buf.WriteString(`<noscript><input type="submit" value="Continue"/></noscript></form></body></html>`)
+	return buf.String()
+}
+
+func newSamlId() (string, error) {
+	id := make([]byte, 20)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+	return "_" + hex.EncodeToString(id), nil
+}
+
+// BuildAuthnRequest 构造 AuthnRequest，配置了私钥时 HTTP-Redirect 绑定对查询参数签名，HTTP-POST 绑定使用 XML 签名。
+// 单点登录地址从 IdP 元数据中读取，元数据未声明对应绑定时使用 BuildAuthorizeUrlBySaml
+func (sp *SamlServiceProvider) BuildAuthnRequest(ctx context.Context, binding SamlBindingEnum, relayState string) (*SamlAuthnRequest, error) {
+	if binding != SamlHTTPRedirect && binding != SamlHTTPPost {
+		return nil, fmt.Errorf("不支持的绑定 %s", binding)
+	}
+	metadata, err := sp.IdPMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	destination := metadata.SingleSignOnServices[binding]
+	if destination == "" {
+		destination = sp.client.BuildAuthorizeUrlBySaml()
+	}
+	id, err := newSamlId()
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	buf.WriteString(`<samlp:AuthnRequest xmlns:samlp="` + samlProtocolNamespace + `" xmlns:saml="` + samlAssertionNamespace + `"`)
+	fmt.Fprintf(&buf, ` ID="%s" Version="2.0" IssueInstant="%s" Destination="%s" AssertionConsumerServiceURL="%s" ProtocolBinding="%s">`,
+		id, time.Now().UTC().Format(samlTimeFormat), xmlEscape(destination), xmlEscape(sp.options.AssertionConsumerServiceUrl), SamlHTTPPost)
+	buf.WriteString(`<saml:Issuer>` + xmlEscape(sp.options.EntityId) + `</saml:Issuer>`)
+	if sp.options.NameIdFormat != "" {
+		buf.WriteString(`<samlp:NameIDPolicy Format="` + xmlEscape(sp.options.NameIdFormat) + `" AllowCreate="true"></samlp:NameIDPolicy>`)
+	}
+	buf.WriteString(`</samlp:AuthnRequest>`)
+
+	request := &SamlAuthnRequest{Id: id, Binding: binding, Url: destination, RelayState: relayState}
+	if binding == SamlHTTPPost {
+		document := buf.Bytes()
+		if sp.privateKey != nil {
+			if document, err = signEnvelopedXML(document, id, sp.privateKey, sp.certificate); err != nil {
+				return nil, err
+			}
+		}
+		request.SAMLRequest = base64.StdEncoding.EncodeToString(document)
+		return request, nil
+	}
+
+	var deflated bytes.Buffer
+	writer, _ := flate.NewWriter(&deflated, flate.DefaultCompression)
+	writer.Write(buf.Bytes())
+	writer.Close()
+	query := "SAMLRequest=" + url.QueryEscape(base64.StdEncoding.EncodeToString(deflated.Bytes()))
+	if relayState != "" {
+		query += "&RelayState=" + url.QueryEscape(relayState)
+	}
+	if sp.privateKey != nil {
+		query += "&SigAlg=" + url.QueryEscape(xmlDSigRSASHA256)
+		signature, err := rsa.SignPKCS1v15(rand.Reader, sp.privateKey, crypto.SHA256, digestXML(crypto.SHA256, []byte(query)))
+		if err != nil {
+			return nil, err
+		}
+		query += "&Signature=" + url.QueryEscape(base64.StdEncoding.EncodeToString(signature))
+	}
+	separator := "?"
+	if strings.Contains(destination, "?") {
+		separator = "&"
+	}
+	request.Url = destination + separator + query
+	return request, nil
+}
+
+type samlStatusCode struct {
+	Value      string          `xml:"Value,attr"`
+	StatusCode *samlStatusCode `xml:"urn:oasis:names:tc:SAML:2.0:protocol StatusCode"`
+}
+
+type samlResponse struct {
+	XMLName      xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:protocol Response"`
+	Id           string   `xml:"ID,attr"`
+	InResponseTo string   `xml:"InResponseTo,attr"`
+	Destination  string   `xml:"Destination,attr"`
+	Issuer       string   `xml:"urn:oasis:names:tc:SAML:2.0:assertion Issuer"`
+	Status       struct {
+		StatusCode    samlStatusCode `xml:"urn:oasis:names:tc:SAML:2.0:protocol StatusCode"`
+		StatusMessage string         `xml:"urn:oasis:names:tc:SAML:2.0:protocol StatusMessage"`
+	} `xml:"urn:oasis:names:tc:SAML:2.0:protocol Status"`
+	Assertions []samlAssertion `xml:"urn:oasis:names:tc:SAML:2.0:assertion Assertion"`
+}
+
+type samlAssertion struct {
+	XMLName xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:assertion Assertion"`
+	Id      string   `xml:"ID,attr"`
+	Issuer  string   `xml:"urn:oasis:names:tc:SAML:2.0:assertion Issuer"`
+	Subject struct {
+		NameId struct {
+			Format string `xml:"Format,attr"`
+			Value  string `xml:",chardata"`
+		} `xml:"urn:oasis:names:tc:SAML:2.0:assertion NameID"`
+		SubjectConfirmations []struct {
+			Method string `xml:"Method,attr"`
+			Data   struct {
+				NotOnOrAfter time.Time `xml:"NotOnOrAfter,attr"`
+				Recipient    string    `xml:"Recipient,attr"`
+				InResponseTo string    `xml:"InResponseTo,attr"`
+			} `xml:"urn:oasis:names:tc:SAML:2.0:assertion SubjectConfirmationData"`
+		} `xml:"urn:oasis:names:tc:SAML:2.0:assertion SubjectConfirmation"`
+	} `xml:"urn:oasis:names:tc:SAML:2.0:assertion Subject"`
+	Conditions *struct {
+		NotBefore            time.Time `xml:"NotBefore,attr"`
+		NotOnOrAfter         time.Time `xml:"NotOnOrAfter,attr"`
+		AudienceRestrictions []struct {
+			Audiences []string `xml:"urn:oasis:names:tc:SAML:2.0:assertion Audience"`
+		} `xml:"urn:oasis:names:tc:SAML:2.0:assertion AudienceRestriction"`
+	} `xml:"urn:oasis:names:tc:SAML:2.0:assertion Conditions"`
+	AuthnStatements []struct {
+		SessionIndex string `xml:"SessionIndex,attr"`
+	} `xml:"urn:oasis:names:tc:SAML:2.0:assertion AuthnStatement"`
+	AttributeStatements []struct {
+		Attributes []struct {
+			Name         string   `xml:"Name,attr"`
+			FriendlyName string   `xml:"FriendlyName,attr"`
+			Values       []string `xml:"urn:oasis:names:tc:SAML:2.0:assertion AttributeValue"`
+		} `xml:"urn:oasis:names:tc:SAML:2.0:assertion Attribute"`
+	} `xml:"urn:oasis:names:tc:SAML:2.0:assertion AttributeStatement"`
+}
+
+// SamlAttributes 断言中的属性，同时以 Name 与 FriendlyName 为键
+type SamlAttributes map[string][]string
+
+// Get 返回属性的第一个值，属性不存在时返回空字符串
+func (attributes SamlAttributes) Get(name string) string {
+	if values := attributes[name]; len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// SamlUser SAMLResponse 校验通过后得到的用户信息
+type SamlUser struct {
+	NameId       string
+	NameIdFormat string
+	SessionIndex string
+	Issuer       string
+	Attributes   SamlAttributes
+}
+
+// DecodeAttributes 将属性映射到调用方定义的结构体，字段通过 saml 标签指定属性名，
+// 支持 string（取第一个值）与 []string 类型的字段，例如 Email string `saml:"email"`
+func (user *SamlUser) DecodeAttributes(v interface{}) error {
+	value := reflect.ValueOf(v)
+	if value.Kind() != reflect.Ptr || value.Elem().Kind() != reflect.Struct {
+		return errors.New("v 必须为结构体指针")
+	}
+	value = value.Elem()
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		name := field.Tag.Get("saml")
+		if name == "" || name == "-" {
+			continue
+		}
+		switch {
+		case field.Type.Kind() == reflect.String:
+			value.Field(i).SetString(user.Attributes.Get(name))
+		case field.Type == reflect.TypeOf([]string(nil)):
+			value.Field(i).Set(reflect.ValueOf(append([]string(nil), user.Attributes[name]...)))
+		default:
+			return fmt.Errorf("字段 %s 的类型 %s 不支持映射 SAML 属性", field.Name, field.Type)
+		}
+	}
+	return nil
+}
+
+// ParseResponse 校验 HTTP-POST 绑定收到的 SAMLResponse（encodedResponse 为表单中的 base64 值） 并返回用户信息，requestIds 为本次会话发出的 AuthnRequest ID。
+// 校验 IdP 元数据证书的 XML 签名（响应或断言至少一个被签名）、状态、签发者、Destination、Recipient、
+// InResponseTo、Audience、NotBefore 与 NotOnOrAfter，配置了 AssertionIsReplay 时检查断言 ID 是否已使用过；不支持加密断言
+func (sp *SamlServiceProvider) ParseResponse(ctx context.Context, encodedResponse string, requestIds ...string) (*SamlUser, error) {
+	document, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(encodedResponse), ""))
+	if err != nil {
+		return nil, newSamlError(ErrSamlMalformed, "%v", err)
+	}
+	root, err := parseXMLTree(document)
+	if err != nil {
+		return nil, newSamlError(ErrSamlMalformed, "%v", err)
+	}
+	if !root.is(samlProtocolNamespace, "Response") {
+		return nil, newSamlError(ErrSamlMalformed, "根元素不是 samlp:Response")
+	}
+	var response samlResponse
+	if err = xml.Unmarshal(canonicalizeXML(root, nil, nil), &response); err != nil {
+		return nil, newSamlError(ErrSamlMalformed, "%v", err)
+	}
+	if code := response.Status.StatusCode; code.Value != samlStatusSuccess {
+		if code.StatusCode != nil {
+			return nil, newSamlError(ErrSamlStatus, "%s %s %s", code.Value, code.StatusCode.Value, response.Status.StatusMessage)
+		}
+		return nil, newSamlError(ErrSamlStatus, "%s %s", code.Value, response.Status.StatusMessage)
+	}
+
+	metadata, err := sp.IdPMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	responseSigned := false
+	if content, err := verifyEnvelopedSignature(root, metadata.Certificates); err == nil {
+		responseSigned = true
+		response = samlResponse{}
+		if err = xml.Unmarshal(content, &response); err != nil {
+			return nil, newSamlError(ErrSamlMalformed, "%v", err)
+		}
+	} else if err != errXMLNotSigned {
+		return nil, newSamlError(ErrSamlSignature, "响应签名: %v", err)
+	}
+	if len(root.childElements(samlAssertionNamespace, "EncryptedAssertion")) > 0 {
+		return nil, newSamlError(ErrSamlMalformed, "不支持加密断言")
+	}
+	assertionNodes := root.childElements(samlAssertionNamespace, "Assertion")
+	if len(assertionNodes) != 1 || len(response.Assertions) != 1 {
+		return nil, newSamlError(ErrSamlMalformed, "响应必须只包含一个断言")
+	}
+	assertion := response.Assertions[0]
+	if content, err := verifyEnvelopedSignature(assertionNodes[0], metadata.Certificates); err == nil {
+		assertion = samlAssertion{}
+		if err = xml.Unmarshal(content, &assertion); err != nil {
+			return nil, newSamlError(ErrSamlMalformed, "%v", err)
+		}
+	} else if err != errXMLNotSigned {
+		return nil, newSamlError(ErrSamlSignature, "断言签名: %v", err)
+	} else if !responseSigned {
+		return nil, newSamlError(ErrSamlSignature, "响应与断言均未签名")
+	}
+
+	if err = sp.validateResponse(&response, &assertion, metadata, requestIds); err != nil {
+		return nil, err
+	}
+	if sp.options.AssertionIsReplay != nil && sp.options.AssertionIsReplay(assertion.Id) {
+		return nil, newSamlError(ErrSamlReplay, "%s", assertion.Id)
+	}
+	user := &SamlUser{
+		NameId:       strings.TrimSpace(assertion.Subject.NameId.Value),
+		NameIdFormat: assertion.Subject.NameId.Format,
+		Issuer:       assertion.Issuer,
+		Attributes:   SamlAttributes{},
+	}
+	if len(assertion.AuthnStatements) > 0 {
+		user.SessionIndex = assertion.AuthnStatements[0].SessionIndex
+	}
+	for _, statement := range assertion.AttributeStatements {
+		for _, attribute := range statement.Attributes {
+			values := make([]string, 0, len(attribute.Values))
+			for _, value := range attribute.Values {
+				values = append(values, strings.TrimSpace(value))
+			}
+			user.Attributes[attribute.Name] = append(user.Attributes[attribute.Name], values...)
+			if attribute.FriendlyName != "" && attribute.FriendlyName != attribute.Name {
+				user.Attributes[attribute.FriendlyName] = append(user.Attributes[attribute.FriendlyName], values...)
+			}
+		}
+	}
+	return user, nil
+}
+
+func (sp *SamlServiceProvider) validateResponse(response *samlResponse, assertion *samlAssertion, metadata *SamlIdPMetadata, requestIds []string) error {
+	now := time.Now()
+	skew := sp.options.ClockSkew
+	acs := sp.options.AssertionConsumerServiceUrl
+	if response.Issuer != "" && response.Issuer != metadata.EntityId {
+		return newSamlError(ErrSamlIssuer, "期望 %s, 实际 %s", metadata.EntityId, response.Issuer)
+	}
+	if assertion.Issuer != metadata.EntityId {
+		return newSamlError(ErrSamlIssuer, "期望 %s, 实际 %s", metadata.EntityId, assertion.Issuer)
+	}
+	if response.Destination != "" && response.Destination != acs {
+		return newSamlError(ErrSamlDestination, "期望 %s, 实际 %s", acs, response.Destination)
+	}
+	if err := sp.checkInResponseTo(response.InResponseTo, requestIds); err != nil {
+		return err
+	}
+
+	confirmed := false
+	var confirmationErr error
+	for _, confirmation := range assertion.Subject.SubjectConfirmations {
+		if confirmation.Method != samlBearerConfirmation {
+			continue
+		}
+		data := confirmation.Data
+		switch {
+		case data.Recipient != acs:
+			confirmationErr = newSamlError(ErrSamlDestination, "Recipient 期望 %s, 实际 %s", acs, data.Recipient)
+		case data.NotOnOrAfter.IsZero() || !now.Before(data.NotOnOrAfter.Add(skew)):
+			confirmationErr = newSamlError(ErrSamlExpired, "SubjectConfirmationData NotOnOrAfter %s", data.NotOnOrAfter)
+		default:
+			confirmationErr = sp.checkInResponseTo(data.InResponseTo, requestIds)
+		}
+		if confirmationErr == nil {
+			confirmed = true
+			break
+		}
+	}
+	if !confirmed {
+		if confirmationErr != nil {
+			return confirmationErr
+		}
+		return newSamlError(ErrSamlMalformed, "断言缺少 bearer SubjectConfirmation")
+	}
+
+	conditions := assertion.Conditions
+	if conditions == nil || len(conditions.AudienceRestrictions) == 0 {
+		return newSamlError(ErrSamlAudience, "断言缺少 AudienceRestriction")
+	}
+	if !conditions.NotBefore.IsZero() && now.Add(skew).Before(conditions.NotBefore) {
+		return newSamlError(ErrSamlNotValidYet, "NotBefore %s", conditions.NotBefore)
+	}
+	if !conditions.NotOnOrAfter.IsZero() && !now.Before(conditions.NotOnOrAfter.Add(skew)) {
+		return newSamlError(ErrSamlExpired, "NotOnOrAfter %s", conditions.NotOnOrAfter)
+	}
+	for _, restriction := range conditions.AudienceRestrictions {
+		if !util.ContainsString(restriction.Audiences, sp.options.EntityId) {
+			return newSamlError(ErrSamlAudience, "%v 不包含 %s", restriction.Audiences, sp.options.EntityId)
+		}
+	}
+	return nil
+}
+
+func (sp *SamlServiceProvider) checkInResponseTo(inResponseTo string, requestIds []string) error {
+	if inResponseTo == "" {
+		if len(requestIds) > 0 || !sp.options.AllowIdPInitiated {
+			return newSamlError(ErrSamlInResponseTo, "缺少 InResponseTo")
+		}
+		return nil
+	}
+	if !util.ContainsString(requestIds, inResponseTo) {
+		return newSamlError(ErrSamlInResponseTo, "%s", inResponseTo)
+	}
+	return nil
+}
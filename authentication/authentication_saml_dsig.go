@@ -0,0 +1,500 @@
+package authentication
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+const (
+	xmlNamespace          = "http://www.w3.org/XML/1998/namespace"
+	xmlDSigNamespace      = "http://www.w3.org/2000/09/xmldsig#"
+	xmlExcC14N            = "http://www.w3.org/2001/10/xml-exc-c14n#"
+	xmlEnvelopedSignature = "http://www.w3.org/2000/09/xmldsig#enveloped-signature"
+	xmlDSigRSASHA256      = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha256"
+	xmlDSigRSASHA512      = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha512"
+	xmlDSigDigestSHA256   = "http://www.w3.org/2001/04/xmlenc#sha256"
+	xmlDSigDigestSHA512   = "http://www.w3.org/2001/04/xmlenc#sha512"
+)
+
+// xmlDSigSignatureHashes 允许的签名算法，不接受已不安全的 rsa-sha1
+var xmlDSigSignatureHashes = map[string]crypto.Hash{
+	xmlDSigRSASHA256: crypto.SHA256,
+	xmlDSigRSASHA512: crypto.SHA512,
+}
+
+// xmlDSigDigestHashes 允许的摘要算法，不接受 sha1
+var xmlDSigDigestHashes = map[string]crypto.Hash{
+	xmlDSigDigestSHA256: crypto.SHA256,
+	xmlDSigDigestSHA512: crypto.SHA512,
+}
+
+// errXMLNotSigned 元素不包含 ds:Signature
+var errXMLNotSigned = errors.New("元素未签名")
+
+type xmlAttr struct {
+	prefix string
+	local  string
+	value  string
+}
+
+// xmlNode 保留命名空间前缀的 XML 元素树，用于 Exclusive XML Canonicalization（xml-exc-c14n）
+type xmlNode struct {
+	parent     *xmlNode
+	prefix     string
+	local      string
+	attrs      []xmlAttr
+	namespaces map[string]string
+	// children 中的元素为 *xmlNode 或 string（文本）
+	children []interface{}
+}
+
+// parseXMLTree 解析 XML 文档，忽略注释与处理指令，拒绝 DOCTYPE
+func parseXMLTree(data []byte) (*xmlNode, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	var root, current *xmlNode
+	for {
+		token, err := decoder.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			node := &xmlNode{parent: current, prefix: t.Name.Space, local: t.Name.Local, namespaces: map[string]string{}}
+			for _, attr := range t.Attr {
+				switch {
+				case attr.Name.Space == "xmlns":
+					node.namespaces[attr.Name.Local] = attr.Value
+				case attr.Name.Space == "" && attr.Name.Local == "xmlns":
+					node.namespaces[""] = attr.Value
+				default:
+					node.attrs = append(node.attrs, xmlAttr{prefix: attr.Name.Space, local: attr.Name.Local, value: attr.Value})
+				}
+			}
+			if current == nil {
+				if root != nil {
+					return nil, errors.New("XML 文档包含多个根元素")
+				}
+				root = node
+			} else {
+				current.children = append(current.children, node)
+			}
+			current = node
+		case xml.EndElement:
+			if current == nil || current.prefix != t.Name.Space || current.local != t.Name.Local {
+				return nil, fmt.Errorf("XML 结束标签 %s 不匹配", t.Name.Local)
+			}
+			current = current.parent
+		case xml.CharData:
+			if current != nil {
+				current.children = append(current.children, string(t))
+			}
+		case xml.Directive:
+			return nil, errors.New("XML 文档不允许包含 DOCTYPE")
+		}
+	}
+	if root == nil || current != nil {
+		return nil, errors.New("XML 文档不完整")
+	}
+	return root, nil
+}
+
+// lookupNamespace 返回前缀在当前元素作用域内对应的命名空间
+func (node *xmlNode) lookupNamespace(prefix string) (string, bool) {
+	if prefix == "xml" {
+		return xmlNamespace, true
+	}
+	for n := node; n != nil; n = n.parent {
+		if uri, ok := n.namespaces[prefix]; ok {
+			return uri, true
+		}
+	}
+	return "", prefix == ""
+}
+
+func (node *xmlNode) namespace() string {
+	uri, _ := node.lookupNamespace(node.prefix)
+	return uri
+}
+
+func (node *xmlNode) is(namespace, local string) bool {
+	return node.local == local && node.namespace() == namespace
+}
+
+func (node *xmlNode) attr(local string) string {
+	for _, attr := range node.attrs {
+		if attr.prefix == "" && attr.local == local {
+			return attr.value
+		}
+	}
+	return ""
+}
+
+func (node *xmlNode) childElements(namespace, local string) []*xmlNode {
+	var result []*xmlNode
+	for _, child := range node.children {
+		if element, ok := child.(*xmlNode); ok && element.is(namespace, local) {
+			result = append(result, element)
+		}
+	}
+	return result
+}
+
+func (node *xmlNode) childElement(namespace, local string) *xmlNode {
+	if children := node.childElements(namespace, local); len(children) > 0 {
+		return children[0]
+	}
+	return nil
+}
+
+func (node *xmlNode) text() string {
+	var buf bytes.Buffer
+	for _, child := range node.children {
+		if text, ok := child.(string); ok {
+			buf.WriteString(text)
+		}
+	}
+	return buf.String()
+}
+
+// findElementByID 查找 ID 属性等于 id 的元素
+func (node *xmlNode) findElementByID(id string) *xmlNode {
+	if node.attr("ID") == id {
+		return node
+	}
+	for _, child := range node.children {
+		if element, ok := child.(*xmlNode); ok {
+			if found := element.findElementByID(id); found != nil {
+				return found
+			}
+		}
+	}
+	return nil
+}
+
+func (node *xmlNode) qualifiedName() string {
+	if node.prefix == "" {
+		return node.local
+	}
+	return node.prefix + ":" + node.local
+}
+
+// canonicalizeXML 按 Exclusive XML Canonicalization 1.0（不含注释）输出以 node 为根的子树，
+// inclusivePrefixes 为 InclusiveNamespaces PrefixList，exclude 不为空时跳过该子元素（enveloped-signature 变换）
+func canonicalizeXML(node *xmlNode, inclusivePrefixes []string, exclude *xmlNode) []byte {
+	var buf bytes.Buffer
+	writeCanonicalXML(&buf, node, map[string]string{}, inclusivePrefixes, exclude)
+	return buf.Bytes()
+}
+
+func writeCanonicalXML(buf *bytes.Buffer, node *xmlNode, rendered map[string]string, inclusivePrefixes []string, exclude *xmlNode) {
+	prefixes := map[string]bool{node.prefix: true}
+	for _, attr := range node.attrs {
+		if attr.prefix != "" && attr.prefix != "xml" {
+			prefixes[attr.prefix] = true
+		}
+	}
+	for _, prefix := range inclusivePrefixes {
+		if prefix == "#default" {
+			prefix = ""
+		}
+		if _, ok := node.lookupNamespace(prefix); ok {
+			prefixes[prefix] = true
+		}
+	}
+
+	delete(prefixes, "xml")
+
+	var declared []string
+	next := rendered
+	for prefix := range prefixes {
+		uri, ok := node.lookupNamespace(prefix)
+		if !ok {
+			continue
+		}
+		previous, renderedBefore := rendered[prefix]
+		if prefix == "" && !renderedBefore {
+			previous, renderedBefore = "", true
+		}
+		if renderedBefore && previous == uri {
+			continue
+		}
+		if len(declared) == 0 {
+			next = make(map[string]string, len(rendered)+1)
+			for key, value := range rendered {
+				next[key] = value
+			}
+		}
+		next[prefix] = uri
+		declared = append(declared, prefix)
+	}
+	sort.Strings(declared)
+
+	attrs := make([]xmlAttr, len(node.attrs))
+	copy(attrs, node.attrs)
+	attrNamespace := func(attr xmlAttr) string {
+		if attr.prefix == "" {
+			return ""
+		}
+		uri, _ := node.lookupNamespace(attr.prefix)
+		return uri
+	}
+	sort.SliceStable(attrs, func(i, j int) bool {
+		nsI, nsJ := attrNamespace(attrs[i]), attrNamespace(attrs[j])
+		if nsI != nsJ {
+			return nsI < nsJ
+		}
+		return attrs[i].local < attrs[j].local
+	})
+
+	name := node.qualifiedName()
+	buf.WriteString("<" + name)
+	for _, prefix := range declared {
+		if prefix == "" {
+			buf.WriteString(` xmlns="`)
+		} else {
+			buf.WriteString(` xmlns:` + prefix + `="`)
+		}
+		writeCanonicalAttrValue(buf, next[prefix])
+		buf.WriteString(`"`)
+	}
+	for _, attr := range attrs {
+		buf.WriteString(" ")
+		if attr.prefix != "" {
+			buf.WriteString(attr.prefix + ":")
+		}
+		buf.WriteString(attr.local + `="`)
+		writeCanonicalAttrValue(buf, attr.value)
+		buf.WriteString(`"`)
+	}
+	buf.WriteString(">")
+	for _, child := range node.children {
+		switch c := child.(type) {
+		case string:
+			writeCanonicalText(buf, c)
+		case *xmlNode:
+			if c != exclude {
+				writeCanonicalXML(buf, c, next, inclusivePrefixes, exclude)
+			}
+		}
+	}
+	buf.WriteString("</" + name + ">")
+}
+
+func writeCanonicalText(buf *bytes.Buffer, text string) {
+	for _, r := range text {
+		switch r {
+		case '&':
+			buf.WriteString("&amp;")
+		case '<':
+			buf.WriteString("&lt;")
+		case '>':
+			buf.WriteString("&gt;")
+		case '\r':
+			buf.WriteString("&#xD;")
+		default:
+			buf.WriteRune(r)
+		}
+	}
+}
+
+func writeCanonicalAttrValue(buf *bytes.Buffer, value string) {
+	for _, r := range value {
+		switch r {
+		case '&':
+			buf.WriteString("&amp;")
+		case '<':
+			buf.WriteString("&lt;")
+		case '"':
+			buf.WriteString("&quot;")
+		case '\t':
+			buf.WriteString("&#x9;")
+		case '\n':
+			buf.WriteString("&#xA;")
+		case '\r':
+			buf.WriteString("&#xD;")
+		default:
+			buf.WriteRune(r)
+		}
+	}
+}
+
+func inclusiveNamespacePrefixes(node *xmlNode) []string {
+	if node == nil {
+		return nil
+	}
+	for _, child := range node.children {
+		if element, ok := child.(*xmlNode); ok && element.is(xmlExcC14N, "InclusiveNamespaces") {
+			return strings.Fields(element.attr("PrefixList"))
+		}
+	}
+	return nil
+}
+
+func digestXML(hash crypto.Hash, data []byte) []byte {
+	h := hash.New()
+	h.Write(data)
+	return h.Sum(nil)
+}
+
+// verifyEnvelopedSignature 使用 certificates 校验 element 的 enveloped 签名，
+// 只接受引用 element 自身 ID 的单个 Reference，返回 element 规范化后的内容（不含签名），调用方应只使用该内容
+func verifyEnvelopedSignature(element *xmlNode, certificates []*x509.Certificate) ([]byte, error) {
+	signatures := element.childElements(xmlDSigNamespace, "Signature")
+	if len(signatures) == 0 {
+		return nil, errXMLNotSigned
+	}
+	if len(signatures) > 1 {
+		return nil, errors.New("元素包含多个签名")
+	}
+	signature := signatures[0]
+	signedInfo := signature.childElement(xmlDSigNamespace, "SignedInfo")
+	if signedInfo == nil {
+		return nil, errors.New("签名缺少 SignedInfo")
+	}
+	c14nMethod := signedInfo.childElement(xmlDSigNamespace, "CanonicalizationMethod")
+	if c14nMethod == nil || c14nMethod.attr("Algorithm") != xmlExcC14N {
+		return nil, errors.New("不支持的规范化算法")
+	}
+	signatureMethod := signedInfo.childElement(xmlDSigNamespace, "SignatureMethod")
+	if signatureMethod == nil {
+		return nil, errors.New("签名缺少 SignatureMethod")
+	}
+	signatureHash, ok := xmlDSigSignatureHashes[signatureMethod.attr("Algorithm")]
+	if !ok {
+		return nil, fmt.Errorf("不支持的签名算法 %s", signatureMethod.attr("Algorithm"))
+	}
+	references := signedInfo.childElements(xmlDSigNamespace, "Reference")
+	if len(references) != 1 {
+		return nil, errors.New("签名必须只包含一个 Reference")
+	}
+	reference := references[0]
+	id := element.attr("ID")
+	if id == "" || reference.attr("URI") != "#"+id {
+		return nil, fmt.Errorf("签名引用 %s 与元素 ID %s 不一致", reference.attr("URI"), id)
+	}
+
+	var referencePrefixes []string
+	var canonicalized bool
+	if transforms := reference.childElement(xmlDSigNamespace, "Transforms"); transforms != nil {
+		for _, transform := range transforms.childElements(xmlDSigNamespace, "Transform") {
+			switch transform.attr("Algorithm") {
+			case xmlEnvelopedSignature:
+			case xmlExcC14N:
+				canonicalized = true
+				referencePrefixes = inclusiveNamespacePrefixes(transform)
+			default:
+				return nil, fmt.Errorf("不支持的签名变换 %s", transform.attr("Algorithm"))
+			}
+		}
+	}
+	if !canonicalized {
+		return nil, errors.New("签名引用缺少 exc-c14n 变换")
+	}
+	digestMethod := reference.childElement(xmlDSigNamespace, "DigestMethod")
+	digestValue := reference.childElement(xmlDSigNamespace, "DigestValue")
+	if digestMethod == nil || digestValue == nil {
+		return nil, errors.New("签名引用缺少 DigestMethod 或 DigestValue")
+	}
+	digestHash, ok := xmlDSigDigestHashes[digestMethod.attr("Algorithm")]
+	if !ok {
+		return nil, fmt.Errorf("不支持的摘要算法 %s", digestMethod.attr("Algorithm"))
+	}
+	expectedDigest, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(digestValue.text()), ""))
+	if err != nil {
+		return nil, fmt.Errorf("DigestValue 格式错误: %w", err)
+	}
+	content := canonicalizeXML(element, referencePrefixes, signature)
+	if !bytes.Equal(digestXML(digestHash, content), expectedDigest) {
+		return nil, errors.New("摘要不匹配")
+	}
+
+	signatureValue := signature.childElement(xmlDSigNamespace, "SignatureValue")
+	if signatureValue == nil {
+		return nil, errors.New("签名缺少 SignatureValue")
+	}
+	signatureBytes, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(signatureValue.text()), ""))
+	if err != nil {
+		return nil, fmt.Errorf("SignatureValue 格式错误: %w", err)
+	}
+	signedInfoDigest := digestXML(signatureHash, canonicalizeXML(signedInfo, inclusiveNamespacePrefixes(c14nMethod), nil))
+	for _, certificate := range certificates {
+		publicKey, ok := certificate.PublicKey.(*rsa.PublicKey)
+		if !ok {
+			continue
+		}
+		if rsa.VerifyPKCS1v15(publicKey, signatureHash, signedInfoDigest, signatureBytes) == nil {
+			return content, nil
+		}
+	}
+	return nil, errors.New("签名无效")
+}
+
+// signEnvelopedXML 为 document 中 ID 为 id 的元素添加 RSA-SHA256 enveloped 签名，
+// 签名插入到该元素的 Issuer 子元素之后，返回规范化后的文档
+func signEnvelopedXML(document []byte, id string, key *rsa.PrivateKey, certificate *x509.Certificate) ([]byte, error) {
+	root, err := parseXMLTree(document)
+	if err != nil {
+		return nil, err
+	}
+	element := root.findElementByID(id)
+	if element == nil {
+		return nil, fmt.Errorf("找不到 ID 为 %s 的元素", id)
+	}
+	digest := digestXML(crypto.SHA256, canonicalizeXML(element, nil, nil))
+	signedInfo := `<ds:SignedInfo xmlns:ds="` + xmlDSigNamespace + `">` +
+		`<ds:CanonicalizationMethod Algorithm="` + xmlExcC14N + `"></ds:CanonicalizationMethod>` +
+		`<ds:SignatureMethod Algorithm="` + xmlDSigRSASHA256 + `"></ds:SignatureMethod>` +
+		`<ds:Reference URI="#` + xmlEscape(id) + `"><ds:Transforms>` +
+		`<ds:Transform Algorithm="` + xmlEnvelopedSignature + `"></ds:Transform>` +
+		`<ds:Transform Algorithm="` + xmlExcC14N + `"></ds:Transform>` +
+		`</ds:Transforms><ds:DigestMethod Algorithm="` + xmlDSigDigestSHA256 + `"></ds:DigestMethod>` +
+		`<ds:DigestValue>` + base64.StdEncoding.EncodeToString(digest) + `</ds:DigestValue></ds:Reference></ds:SignedInfo>`
+	signedInfoNode, err := parseXMLTree([]byte(signedInfo))
+	if err != nil {
+		return nil, err
+	}
+	signatureBytes, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digestXML(crypto.SHA256, canonicalizeXML(signedInfoNode, nil, nil)))
+	if err != nil {
+		return nil, err
+	}
+	signature, err := parseXMLTree([]byte(`<ds:Signature xmlns:ds="` + xmlDSigNamespace + `">` + signedInfo +
+		`<ds:SignatureValue>` + base64.StdEncoding.EncodeToString(signatureBytes) + `</ds:SignatureValue>` +
+		`<ds:KeyInfo><ds:X509Data><ds:X509Certificate>` + base64.StdEncoding.EncodeToString(certificate.Raw) +
+		`</ds:X509Certificate></ds:X509Data></ds:KeyInfo></ds:Signature>`))
+	if err != nil {
+		return nil, err
+	}
+	signature.parent = element
+	index := 0
+	for i, child := range element.children {
+		if c, ok := child.(*xmlNode); ok && c.local == "Issuer" {
+			index = i + 1
+			break
+		}
+	}
+	children := append([]interface{}{}, element.children[:index]...)
+	children = append(children, signature)
+	element.children = append(children, element.children[index:]...)
+	return canonicalizeXML(root, nil, nil), nil
+}
+
+func xmlEscape(value string) string {
+	var buf bytes.Buffer
+	xml.EscapeText(&buf, []byte(value))
+	return buf.String()
+}
@@ -0,0 +1,97 @@
+package authentication
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestCertificate(t *testing.T, key *rsa.PrivateKey) *x509.Certificate {
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return certificate
+}
+
+func TestCanonicalizeXML(t *testing.T) {
+	root, err := parseXMLTree([]byte(`<?xml version="1.0"?>
+<a:Root xmlns:a="urn:a" xmlns:b="urn:b" xmlns="urn:default" z="1" b:y="2" a="3"><!-- comment --><Child attr="x &amp; &quot;y&quot;">t &lt; u<![CDATA[ > ]]></Child><b:Other/></a:Root>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `<a:Root xmlns:a="urn:a" xmlns:b="urn:b" a="3" z="1" b:y="2"><Child xmlns="urn:default" attr="x &amp; &quot;y&quot;">t &lt; u &gt; </Child><b:Other></b:Other></a:Root>`
+	if actual := string(canonicalizeXML(root, nil, nil)); actual != expected {
+		t.Fatalf("规范化结果不符合预期:\n%s\n%s", actual, expected)
+	}
+	child := root.children[0].(*xmlNode)
+	if actual := string(canonicalizeXML(child, nil, nil)); actual != `<Child xmlns="urn:default" attr="x &amp; &quot;y&quot;">t &lt; u &gt; </Child>` {
+		t.Fatalf("子树规范化结果不符合预期: %s", actual)
+	}
+	if actual := string(canonicalizeXML(child, []string{"b"}, nil)); !strings.HasPrefix(actual, `<Child xmlns="urn:default" xmlns:b="urn:b" attr=`) {
+		t.Fatalf("InclusiveNamespaces 中的前缀应输出: %s", actual)
+	}
+}
+
+func TestParseXMLTree_RejectsDoctype(t *testing.T) {
+	if _, err := parseXMLTree([]byte(`<!DOCTYPE foo [<!ENTITY x "y">]><foo>&x;</foo>`)); err == nil {
+		t.Fatal("应拒绝 DOCTYPE")
+	}
+}
+
+func TestSignEnvelopedXML(t *testing.T) {
+	key := newTestRSAKey(t)
+	certificate := newTestCertificate(t, key)
+	otherKey := newTestRSAKey(t)
+	document := `<p:Message xmlns:p="urn:p" xmlns:i="urn:i" ID="_1"><i:Issuer>issuer</i:Issuer><p:Body a="1">hello</p:Body></p:Message>`
+	signed, err := signEnvelopedXML([]byte(document), "_1", key, certificate)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	verify := func(document string, certificate *x509.Certificate) ([]byte, error) {
+		root, err := parseXMLTree([]byte(document))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return verifyEnvelopedSignature(root, []*x509.Certificate{certificate})
+	}
+	content, err := verify(string(signed), certificate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `<p:Message xmlns:p="urn:p" ID="_1"><i:Issuer xmlns:i="urn:i">issuer</i:Issuer><p:Body a="1">hello</p:Body></p:Message>`
+	if string(content) != expected {
+		t.Fatalf("校验结果应为去掉签名后的规范化内容: %s", content)
+	}
+	if !strings.Contains(string(signed), `</i:Issuer><ds:Signature`) {
+		t.Fatalf("签名应位于 Issuer 之后: %s", signed)
+	}
+	if _, err = verify(strings.Replace(string(signed), "hello", "hacked", 1), certificate); err == nil {
+		t.Fatal("内容被篡改时应校验失败")
+	}
+	if _, err = verify(string(signed), newTestCertificate(t, otherKey)); err == nil {
+		t.Fatal("使用其他证书时应校验失败")
+	}
+	if _, err = verify(strings.Replace(string(signed), `ID="_1"`, `ID="_2"`, 1), certificate); err == nil {
+		t.Fatal("引用与元素 ID 不一致时应校验失败")
+	}
+	if _, err = verify(document, certificate); err != errXMLNotSigned {
+		t.Fatalf("未签名时应返回 errXMLNotSigned: %v", err)
+	}
+}
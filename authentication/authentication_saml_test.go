@@ -0,0 +1,336 @@
+package authentication
+
+import (
+	"bytes"
+	"compress/flate"
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Authing/authing-golang-sdk/v3/util"
+)
+
+const (
+	testSamlIdPEntityId = "https://example.authing.cn/saml-idp/app"
+	testSamlSPEntityId  = "https://sp.example.com/metadata"
+	testSamlAcsUrl      = "https://sp.example.com/acs"
+)
+
+type samlTestIdP struct {
+	key         *rsa.PrivateKey
+	certificate *x509.Certificate
+}
+
+func newSamlTestIdP(t *testing.T) *samlTestIdP {
+	key := newTestRSAKey(t)
+	return &samlTestIdP{key: key, certificate: newTestCertificate(t, key)}
+}
+
+func (idp *samlTestIdP) metadata() []byte {
+	return []byte(`<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="` + testSamlIdPEntityId + `">
+  <md:IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <md:KeyDescriptor use="signing"><ds:KeyInfo xmlns:ds="http://www.w3.org/2000/09/xmldsig#"><ds:X509Data>
+      <ds:X509Certificate>` + base64.StdEncoding.EncodeToString(idp.certificate.Raw) + `</ds:X509Certificate>
+    </ds:X509Data></ds:KeyInfo></md:KeyDescriptor>
+    <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://example.authing.cn/saml-idp/app/sso"/>
+  </md:IDPSSODescriptor>
+</md:EntityDescriptor>`)
+}
+
+type samlTestResponse struct {
+	inResponseTo string
+	audience     string
+	notOnOrAfter time.Time
+	status       string
+	signResponse bool
+	noSignature  bool
+}
+
+// build 生成 SAMLResponse，默认只对断言签名
+func (idp *samlTestIdP) build(t *testing.T, params samlTestResponse) string {
+	now := time.Now().UTC()
+	if params.audience == "" {
+		params.audience = testSamlSPEntityId
+	}
+	if params.notOnOrAfter.IsZero() {
+		params.notOnOrAfter = now.Add(5 * time.Minute)
+	}
+	if params.status == "" {
+		params.status = samlStatusSuccess
+	}
+	replacer := strings.NewReplacer(
+		"{now}", now.Format(samlTimeFormat),
+		"{notBefore}", now.Add(-time.Minute).Format(samlTimeFormat),
+		"{notOnOrAfter}", params.notOnOrAfter.UTC().Format(samlTimeFormat),
+		"{inResponseTo}", params.inResponseTo,
+		"{audience}", params.audience,
+		"{status}", params.status,
+		"{idp}", testSamlIdPEntityId,
+		"{acs}", testSamlAcsUrl,
+	)
+	document := []byte(replacer.Replace(`<samlp:Response xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" ID="_response" Version="2.0" IssueInstant="{now}" Destination="{acs}" InResponseTo="{inResponseTo}">
+  <saml:Issuer>{idp}</saml:Issuer>
+  <samlp:Status><samlp:StatusCode Value="{status}"/></samlp:Status>
+  <saml:Assertion xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" ID="_assertion" Version="2.0" IssueInstant="{now}">
+    <saml:Issuer>{idp}</saml:Issuer>
+    <saml:Subject>
+      <saml:NameID Format="urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress">alice@example.com</saml:NameID>
+      <saml:SubjectConfirmation Method="urn:oasis:names:tc:SAML:2.0:cm:bearer">
+        <saml:SubjectConfirmationData NotOnOrAfter="{notOnOrAfter}" Recipient="{acs}" InResponseTo="{inResponseTo}"/>
+      </saml:SubjectConfirmation>
+    </saml:Subject>
+    <saml:Conditions NotBefore="{notBefore}" NotOnOrAfter="{notOnOrAfter}">
+      <saml:AudienceRestriction><saml:Audience>{audience}</saml:Audience></saml:AudienceRestriction>
+    </saml:Conditions>
+    <saml:AuthnStatement AuthnInstant="{now}" SessionIndex="session-1"/>
+    <saml:AttributeStatement>
+      <saml:Attribute Name="http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress" FriendlyName="email">
+        <saml:AttributeValue xsi:type="xs:string">alice@example.com</saml:AttributeValue>
+      </saml:Attribute>
+      <saml:Attribute Name="roles">
+        <saml:AttributeValue>admin</saml:AttributeValue>
+        <saml:AttributeValue>dev</saml:AttributeValue>
+      </saml:Attribute>
+    </saml:AttributeStatement>
+  </saml:Assertion>
+</samlp:Response>`))
+	var err error
+	if !params.noSignature && !params.signResponse {
+		document, err = signEnvelopedXML(document, "_assertion", idp.key, idp.certificate)
+	}
+	if params.signResponse {
+		document, err = signEnvelopedXML(document, "_response", idp.key, idp.certificate)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return base64.StdEncoding.EncodeToString(document)
+}
+
+func newTestSamlServiceProvider(t *testing.T, idp *samlTestIdP, spKey *rsa.PrivateKey) *SamlServiceProvider {
+	client := newTestClient(t, "https://example.authing.cn")
+	options := &SamlServiceProviderOptions{
+		EntityId:                    testSamlSPEntityId,
+		AssertionConsumerServiceUrl: testSamlAcsUrl,
+		IdPMetadata:                 idp.metadata(),
+		NameIdFormat:                "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress",
+	}
+	if spKey != nil {
+		options.PrivateKey = string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(spKey)}))
+		options.Certificate = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: newTestCertificate(t, spKey).Raw}))
+	}
+	sp, err := client.NewSamlServiceProvider(options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sp
+}
+
+func TestSamlServiceProvider_ParseResponse(t *testing.T) {
+	idp := newSamlTestIdP(t)
+	sp := newTestSamlServiceProvider(t, idp, nil)
+	ctx := context.Background()
+
+	for _, params := range []samlTestResponse{{inResponseTo: "_request"}, {inResponseTo: "_request", signResponse: true}} {
+		user, err := sp.ParseResponse(ctx, idp.build(t, params), "_request")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if user.NameId != "alice@example.com" || user.SessionIndex != "session-1" || user.Issuer != testSamlIdPEntityId ||
+			user.Attributes.Get("email") != "alice@example.com" || len(user.Attributes["roles"]) != 2 {
+			t.Fatalf("用户信息不符合预期: %+v", user)
+		}
+		var mapped struct {
+			Email string   `saml:"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress"`
+			Roles []string `saml:"roles"`
+		}
+		if err = user.DecodeAttributes(&mapped); err != nil || mapped.Email != "alice@example.com" || len(mapped.Roles) != 2 {
+			t.Fatalf("属性映射失败: %+v %v", mapped, err)
+		}
+	}
+
+	other := newSamlTestIdP(t)
+	tampered, _ := base64.StdEncoding.DecodeString(idp.build(t, samlTestResponse{inResponseTo: "_request"}))
+	cases := []struct {
+		name       string
+		response   string
+		requestIds []string
+		expected   error
+	}{
+		{"未签名", idp.build(t, samlTestResponse{inResponseTo: "_request", noSignature: true}), []string{"_request"}, ErrSamlSignature},
+		{"其他 IdP 签名", other.build(t, samlTestResponse{inResponseTo: "_request"}), []string{"_request"}, ErrSamlSignature},
+		{"内容被篡改", base64.StdEncoding.EncodeToString(bytes.Replace(tampered, []byte(">alice@example.com<"), []byte(">admin@example.com<"), 1)), []string{"_request"}, ErrSamlSignature},
+		{"InResponseTo 不匹配", idp.build(t, samlTestResponse{inResponseTo: "_other"}), []string{"_request"}, ErrSamlInResponseTo},
+		{"不允许 IdP 发起", idp.build(t, samlTestResponse{}), nil, ErrSamlInResponseTo},
+		{"受众不匹配", idp.build(t, samlTestResponse{inResponseTo: "_request", audience: "https://evil.example.com"}), []string{"_request"}, ErrSamlAudience},
+		{"已过期", idp.build(t, samlTestResponse{inResponseTo: "_request", notOnOrAfter: time.Now().Add(-time.Minute)}), []string{"_request"}, ErrSamlExpired},
+		{"认证失败", idp.build(t, samlTestResponse{inResponseTo: "_request", status: "urn:oasis:names:tc:SAML:2.0:status:Responder"}), []string{"_request"}, ErrSamlStatus},
+	}
+	for _, c := range cases {
+		if _, err := sp.ParseResponse(ctx, c.response, c.requestIds...); !errors.Is(err, c.expected) {
+			t.Fatalf("%s: 期望 %v, 实际 %v", c.name, c.expected, err)
+		}
+	}
+
+	sp.options.AllowIdPInitiated = true
+	if _, err := sp.ParseResponse(ctx, idp.build(t, samlTestResponse{})); err != nil {
+		t.Fatalf("允许 IdP 发起时应校验通过: %v", err)
+	}
+}
+
+func TestSamlServiceProvider_ParseResponseReplay(t *testing.T) {
+	idp := newSamlTestIdP(t)
+	sp := newTestSamlServiceProvider(t, idp, nil)
+	seen := map[string]bool{}
+	sp.options.AllowIdPInitiated = true
+	sp.options.AssertionIsReplay = func(assertionId string) bool {
+		if seen[assertionId] {
+			return true
+		}
+		seen[assertionId] = true
+		return false
+	}
+
+	response := idp.build(t, samlTestResponse{})
+	if _, err := sp.ParseResponse(context.Background(), response); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sp.ParseResponse(context.Background(), response); !errors.Is(err, ErrSamlReplay) {
+		t.Fatalf("重放的断言应被拒绝, 实际 %v", err)
+	}
+}
+
+func TestSamlServiceProvider_IdPMetadata(t *testing.T) {
+	idp := newSamlTestIdP(t)
+	client := newTestClient(t, "https://example.authing.cn")
+	if _, err := client.NewSamlServiceProvider(&SamlServiceProviderOptions{
+		EntityId:                    testSamlSPEntityId,
+		AssertionConsumerServiceUrl: testSamlAcsUrl,
+		IdPMetadata:                 []byte("<invalid"),
+	}); err == nil {
+		t.Fatal("IdP 元数据无效时应返回错误")
+	}
+
+	var server *stubServer
+	server = newStubServer(func(w http.ResponseWriter, r *http.Request) {
+		if server.hits("") > 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write(idp.metadata())
+	})
+	defer server.Close()
+	client.options.RetryPolicy = &util.RetryPolicy{MaxAttempts: 1}
+	sp, err := client.NewSamlServiceProvider(&SamlServiceProviderOptions{
+		EntityId:                    testSamlSPEntityId,
+		AssertionConsumerServiceUrl: testSamlAcsUrl,
+		IdPMetadataUrl:              server.URL,
+		IdPMetadataCacheTTL:         time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = sp.IdPMetadata(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(5 * time.Millisecond)
+	for i := 0; i < 3; i++ {
+		if metadata, err := sp.IdPMetadata(context.Background()); err != nil || metadata.EntityId != testSamlIdPEntityId {
+			t.Fatalf("获取失败时应继续使用缓存的元数据: %+v %v", metadata, err)
+		}
+	}
+	if n := server.hits(""); n != 2 {
+		t.Fatalf("获取失败后应等待一段时间再重试, 实际请求 %d 次", n)
+	}
+}
+
+func TestSamlServiceProvider_BuildAuthnRequest(t *testing.T) {
+	idp := newSamlTestIdP(t)
+	spKey := newTestRSAKey(t)
+	sp := newTestSamlServiceProvider(t, idp, spKey)
+	ctx := context.Background()
+
+	request, err := sp.BuildAuthnRequest(ctx, SamlHTTPRedirect, "/home")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(request.Url, "https://example.authing.cn/saml-idp/app/sso?SAMLRequest=") {
+		t.Fatalf("应使用元数据中的 HTTP-Redirect 地址: %s", request.Url)
+	}
+	rawQuery := request.Url[strings.Index(request.Url, "?")+1:]
+	signedQuery := rawQuery[:strings.Index(rawQuery, "&Signature=")]
+	query, _ := url.ParseQuery(rawQuery)
+	signature, _ := base64.StdEncoding.DecodeString(query.Get("Signature"))
+	if err = rsa.VerifyPKCS1v15(&spKey.PublicKey, crypto.SHA256, digestXML(crypto.SHA256, []byte(signedQuery)), signature); err != nil {
+		t.Fatalf("查询参数签名无效: %v", err)
+	}
+	deflated, _ := base64.StdEncoding.DecodeString(query.Get("SAMLRequest"))
+	inflated, err := ioutil.ReadAll(flate.NewReader(bytes.NewReader(deflated)))
+	if err != nil || query.Get("RelayState") != "/home" || !strings.Contains(string(inflated), `ID="`+request.Id+`"`) ||
+		!strings.Contains(string(inflated), `<saml:Issuer>`+testSamlSPEntityId+`</saml:Issuer>`) {
+		t.Fatalf("AuthnRequest 不符合预期: %s %v", inflated, err)
+	}
+
+	request, err = sp.BuildAuthnRequest(ctx, SamlHTTPPost, "/home")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if request.Url != sp.client.BuildAuthorizeUrlBySaml() || !strings.Contains(request.PostForm(), `name="SAMLRequest"`) {
+		t.Fatalf("元数据未声明 HTTP-POST 时应使用 BuildAuthorizeUrlBySaml: %s", request.Url)
+	}
+	document, _ := base64.StdEncoding.DecodeString(request.SAMLRequest)
+	root, err := parseXMLTree(document)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = verifyEnvelopedSignature(root, []*x509.Certificate{sp.certificate}); err != nil || root.attr("ID") != request.Id {
+		t.Fatalf("AuthnRequest XML 签名无效: %v", err)
+	}
+}
+
+func TestSamlServiceProvider_Metadata(t *testing.T) {
+	idp := newSamlTestIdP(t)
+	server := newStubServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/saml-idp/app/metadata" {
+			t.Errorf("IdP 元数据地址不符合预期: %s", r.URL.Path)
+		}
+		w.Write(idp.metadata())
+	})
+	defer server.Close()
+	client := newTestClient(t, server.URL, withoutSecret)
+	spKey := newTestRSAKey(t)
+	sp, err := client.NewSamlServiceProvider(&SamlServiceProviderOptions{
+		EntityId:                    testSamlSPEntityId,
+		AssertionConsumerServiceUrl: testSamlAcsUrl,
+		PrivateKey:                  string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(spKey)})),
+		Certificate:                 string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: newTestCertificate(t, spKey).Raw})),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	metadata, err := sp.IdPMetadata(context.Background())
+	if err != nil || metadata.EntityId != testSamlIdPEntityId || len(metadata.Certificates) != 1 || !metadata.Certificates[0].Equal(idp.certificate) {
+		t.Fatalf("IdP 元数据解析失败: %+v %v", metadata, err)
+	}
+	root, err := parseXMLTree(sp.Metadata())
+	if err != nil {
+		t.Fatal(err)
+	}
+	descriptor := root.childElement(samlMetadataNamespace, "SPSSODescriptor")
+	if root.attr("entityID") != testSamlSPEntityId || descriptor == nil || descriptor.attr("AuthnRequestsSigned") != "true" ||
+		descriptor.childElement(samlMetadataNamespace, "KeyDescriptor") == nil ||
+		descriptor.childElement(samlMetadataNamespace, "AssertionConsumerService").attr("Location") != testSamlAcsUrl {
+		t.Fatalf("SP 元数据不符合预期: %s", sp.Metadata())
+	}
+}
@@ -0,0 +1,114 @@
+package authentication
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+const TokenExchangeGrantType = "urn:ietf:params:oauth:grant-type:token-exchange"
+
+// TokenTypeEnum RFC 8693 定义的 token 类型标识
+type TokenTypeEnum string
+
+const (
+	TokenTypeAccessToken  TokenTypeEnum = "urn:ietf:params:oauth:token-type:access_token"
+	TokenTypeRefreshToken TokenTypeEnum = "urn:ietf:params:oauth:token-type:refresh_token"
+	TokenTypeIDToken      TokenTypeEnum = "urn:ietf:params:oauth:token-type:id_token"
+	TokenTypeJwt          TokenTypeEnum = "urn:ietf:params:oauth:token-type:jwt"
+	TokenTypeSaml2        TokenTypeEnum = "urn:ietf:params:oauth:token-type:saml2"
+)
+
+type TokenExchangeParams struct {
+	/**
+	必填，代表被代理用户身份的 token，例如网关收到的用户 access token
+	*/
+	SubjectToken string
+	/**
+	SubjectToken 的类型，默认为 access token
+	*/
+	SubjectTokenType TokenTypeEnum
+	/**
+	代表调用方身份的 token，用于委托（on-behalf-of）场景
+	*/
+	ActorToken string
+	/**
+	ActorToken 的类型，ActorToken 不为空时默认为 access token
+	*/
+	ActorTokenType TokenTypeEnum
+	/**
+	期望签发的 token 类型，为空时由服务端决定
+	*/
+	RequestedTokenType TokenTypeEnum
+	/**
+	新 token 的目标服务标识
+	*/
+	Audience string
+	/**
+	新 token 的目标资源地址
+	*/
+	Resource string
+	/**
+	新 token 的权限范围，通常应小于 SubjectToken 的权限
+	*/
+	Scope string
+}
+
+// TokenExchangeResult token 交换的结果，IssuedTokenType 为实际签发的 token 类型，签发的 token 位于 AccessToken 字段
+type TokenExchangeResult struct {
+	TokenSet
+	IssuedTokenType TokenTypeEnum
+}
+
+// ExchangeToken 使用 OAuth 2.0 Token Exchange（RFC 8693）将用户 token 交换为面向特定 audience、权限收窄的新 token，
+// 使用初始化时指定的 token 端点认证方式，服务端返回错误时返回 *OAuthError
+func (client *AuthenticationClient) ExchangeToken(ctx context.Context, params *TokenExchangeParams) (*TokenExchangeResult, error) {
+	if params == nil || params.SubjectToken == "" {
+		return nil, errors.New("SubjectToken 不能为空")
+	}
+	body := map[string]string{
+		"grant_type":         TokenExchangeGrantType,
+		"subject_token":      params.SubjectToken,
+		"subject_token_type": string(params.SubjectTokenType),
+	}
+	if params.SubjectTokenType == "" {
+		body["subject_token_type"] = string(TokenTypeAccessToken)
+	}
+	if params.ActorToken != "" {
+		body["actor_token"] = params.ActorToken
+		body["actor_token_type"] = string(params.ActorTokenType)
+		if params.ActorTokenType == "" {
+			body["actor_token_type"] = string(TokenTypeAccessToken)
+		}
+	}
+	optionalParams := map[string]string{
+		"requested_token_type": string(params.RequestedTokenType),
+		"audience":             params.Audience,
+		"resource":             params.Resource,
+		"scope":                params.Scope,
+	}
+	for key, value := range optionalParams {
+		if value != "" {
+			body[key] = value
+		}
+	}
+	respBody, err := client.requestToken(ctx, OIDC, client.options.TokenEndPointAuthMethod, body)
+	if err != nil {
+		return nil, err
+	}
+	tokenSet, err := parseTokenSet(respBody)
+	if err != nil {
+		return nil, err
+	}
+	var response struct {
+		IssuedTokenType TokenTypeEnum `json:"issued_token_type"`
+	}
+	if err = json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("无法解析 token 交换响应: %w", err)
+	}
+	if tokenSet.AccessToken == "" {
+		return nil, fmt.Errorf("token 交换响应缺少 access_token: %s", respBody)
+	}
+	return &TokenExchangeResult{TokenSet: *tokenSet, IssuedTokenType: response.IssuedTokenType}, nil
+}
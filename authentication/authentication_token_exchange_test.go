@@ -0,0 +1,65 @@
+package authentication
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestExchangeToken(t *testing.T) {
+	server := newStubServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.PostForm.Get("audience") == "forbidden" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":"invalid_target","error_description":"audience 不可用"}`))
+			return
+		}
+		w.Write([]byte(`{"access_token":"downstream","issued_token_type":"urn:ietf:params:oauth:token-type:access_token","token_type":"Bearer","scope":"orders:read","expires_in":300}`))
+	})
+	defer server.Close()
+	client := newTestClient(t, server.URL, func(options *AuthenticationClientOptions) {
+		options.TokenEndPointAuthMethod = ClientSecretBasic
+	})
+	ctx := context.Background()
+
+	result, err := client.ExchangeToken(ctx, &TokenExchangeParams{
+		SubjectToken: "user-at",
+		ActorToken:   "gateway-at",
+		Audience:     "orders",
+		Scope:        "orders:read",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.AccessToken != "downstream" || result.IssuedTokenType != TokenTypeAccessToken || result.ExpiresIn != 300 {
+		t.Fatalf("交换结果不符合预期: %+v", result)
+	}
+	request := server.lastRequest()
+	expected := map[string]string{
+		"grant_type":         TokenExchangeGrantType,
+		"subject_token":      "user-at",
+		"subject_token_type": string(TokenTypeAccessToken),
+		"actor_token":        "gateway-at",
+		"actor_token_type":   string(TokenTypeAccessToken),
+		"audience":           "orders",
+		"scope":              "orders:read",
+		"resource":           "",
+	}
+	for key, value := range expected {
+		if request.Form.Get(key) != value {
+			t.Errorf("%s: 期望 %q, 实际 %q", key, value, request.Form.Get(key))
+		}
+	}
+	if request.Header.Get("Authorization") == "" {
+		t.Fatal("应使用 client_secret_basic 认证")
+	}
+
+	_, err = client.ExchangeToken(ctx, &TokenExchangeParams{SubjectToken: "user-at", Audience: "forbidden"})
+	var oauthError *OAuthError
+	if !errors.As(err, &oauthError) || oauthError.ErrorCode != "invalid_target" {
+		t.Fatalf("期望 invalid_target, 实际 %v", err)
+	}
+	if _, err = client.ExchangeToken(ctx, &TokenExchangeParams{}); err == nil {
+		t.Fatal("缺少 SubjectToken 时应返回错误")
+	}
+}
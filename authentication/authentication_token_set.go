@@ -0,0 +1,137 @@
+package authentication
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/valyala/fasthttp"
+)
+
+// TokenSet token 端点签发的 token 集合，各授权模式统一返回该结构
+type TokenSet struct {
+	AccessToken  string
+	IDToken      string
+	RefreshToken string
+	TokenType    string
+	Scope        string
+	/**
+	access token 的有效期（秒），服务端未返回时为 0
+	*/
+	ExpiresIn int64
+	/**
+	access token 的过期时间，服务端未返回 expires_in 时为零值
+	*/
+	Expiry time.Time
+}
+
+// Expired 判断 access token 在 now 之后 leeway 内是否过期，Expiry 为零值时视为不过期
+func (tokenSet *TokenSet) Expired(now time.Time, leeway time.Duration) bool {
+	return !tokenSet.Expiry.IsZero() && !now.Add(leeway).Before(tokenSet.Expiry)
+}
+
+// OAuthError token 相关端点按 RFC 6749 返回的错误
+type OAuthError struct {
+	StatusCode       int
+	ErrorCode        string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+	ErrorUri         string `json:"error_uri"`
+}
+
+func (e *OAuthError) Error() string {
+	message := e.ErrorCode
+	if e.ErrorDescription != "" {
+		message += ": " + e.ErrorDescription
+	}
+	if e.StatusCode != 0 {
+		message = fmt.Sprintf("[%d] %s", e.StatusCode, message)
+	}
+	return message
+}
+
+type tokenResponseBody struct {
+	AccessToken  string      `json:"access_token"`
+	IDToken      string      `json:"id_token"`
+	RefreshToken string      `json:"refresh_token"`
+	TokenType    string      `json:"token_type"`
+	Scope        string      `json:"scope"`
+	ExpiresIn    json.Number `json:"expires_in"`
+}
+
+// checkOAuthError 响应包含 error 字段或状态码非 2xx 时返回 *OAuthError
+func checkOAuthError(statusCode int, body []byte) error {
+	var oauthError OAuthError
+	json.Unmarshal(body, &oauthError)
+	if oauthError.ErrorCode == "" && statusCode >= 200 && statusCode < 300 {
+		return nil
+	}
+	if oauthError.ErrorCode == "" {
+		oauthError.ErrorCode = "server_error"
+		oauthError.ErrorDescription = string(body)
+	}
+	oauthError.StatusCode = statusCode
+	return &oauthError
+}
+
+func parseTokenSet(body []byte) (*TokenSet, error) {
+	var response tokenResponseBody
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("无法解析 token 响应: %w", err)
+	}
+	tokenSet := &TokenSet{
+		AccessToken:  response.AccessToken,
+		IDToken:      response.IDToken,
+		RefreshToken: response.RefreshToken,
+		TokenType:    response.TokenType,
+		Scope:        response.Scope,
+	}
+	if response.ExpiresIn != "" {
+		expiresIn, err := response.ExpiresIn.Int64()
+		if err != nil {
+			return nil, fmt.Errorf("expires_in 格式错误: %s", response.ExpiresIn)
+		}
+		tokenSet.ExpiresIn = expiresIn
+		tokenSet.Expiry = time.Now().Add(time.Duration(expiresIn) * time.Second)
+	}
+	return tokenSet, nil
+}
+
+// requestToken 向 token 端点发送表单请求，authMethod 不为空时按该方式附加客户端凭证；
+// 返回原始响应体，服务端返回错误时同时返回 *OAuthError
+func (client *AuthenticationClient) requestToken(ctx context.Context, protocol ProtocolEnum, authMethod TokenAuthMethodEnum, body map[string]string) ([]byte, error) {
+	endpoints, err := client.endpoints(ctx, protocol)
+	if err != nil {
+		return nil, err
+	}
+	// 启用 DPoP 时可能需要重试，每次发送都重新生成 client assertion
+	resp, err := client.sendWithDPoP(ctx, "", func() (*ProtocolRequestOption, error) {
+		header := map[string]string{
+			"Content-Type": "application/x-www-form-urlencoded",
+		}
+		if authMethod != "" {
+			if err := client.applyClientAuth(authMethod, endpoints.Token, header, body); err != nil {
+				return nil, err
+			}
+		}
+		return &ProtocolRequestOption{
+			Url:     endpoints.Token,
+			Method:  fasthttp.MethodPost,
+			Headers: client.getReqHeaders(header),
+			ReqDto:  body,
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, checkOAuthError(resp.StatusCode, resp.Body)
+}
+
+// requestTokenSet 同 requestToken，并将响应解析为 TokenSet
+func (client *AuthenticationClient) requestTokenSet(ctx context.Context, protocol ProtocolEnum, authMethod TokenAuthMethodEnum, body map[string]string) (*TokenSet, error) {
+	respBody, err := client.requestToken(ctx, protocol, authMethod, body)
+	if err != nil {
+		return nil, err
+	}
+	return parseTokenSet(respBody)
+}
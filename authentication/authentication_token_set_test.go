@@ -0,0 +1,75 @@
+package authentication
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestGrantWithContext_TokenSet(t *testing.T) {
+	server := newStubServer(stubResponse(http.StatusOK, `{"access_token":"at","id_token":"it","token_type":"Bearer","scope":"openid","expires_in":3600}`))
+	defer server.Close()
+	client := newTestClient(t, server.URL, withoutSecret)
+	ctx := context.Background()
+
+	tokenSet, err := client.GetAccessTokenByCodeWithContext(ctx, &CodeToTokenParams{Code: "code"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tokenSet.AccessToken != "at" || tokenSet.IDToken != "it" || tokenSet.Scope != "openid" || tokenSet.ExpiresIn != 3600 {
+		t.Fatalf("TokenSet 不符合预期: %+v", tokenSet)
+	}
+	if d := time.Until(tokenSet.Expiry); d < 59*time.Minute || d > time.Hour {
+		t.Fatalf("Expiry 不符合预期: %v", tokenSet.Expiry)
+	}
+	if tokenSet.Expired(time.Now(), time.Minute) || !tokenSet.Expired(time.Now(), 2*time.Hour) {
+		t.Fatal("Expired 判断不符合预期")
+	}
+
+	tokenSet, err = client.GetNewAccessTokenByRefreshTokenWithContext(ctx, "rt")
+	if err != nil || tokenSet.RefreshToken != "rt" {
+		t.Fatalf("未返回新的 refresh token 时应沿用原值: %+v %v", tokenSet, err)
+	}
+
+	tokenSet, err = client.GetAccessTokenByClientCredentialsWithContext(ctx, GetAccessTokenByClientCredentialsRequest{
+		Scope:                 "read",
+		ClientCredentialInput: &ClientCredentialInput{AccessKey: "ak", SecretKey: "sk"},
+	})
+	if err != nil || tokenSet.AccessToken != "at" {
+		t.Fatalf("client credentials 返回不符合预期: %+v %v", tokenSet, err)
+	}
+}
+
+func TestGrantWithContext_OAuthError(t *testing.T) {
+	server := newStubServer(stubResponse(http.StatusBadRequest, `{"error":"invalid_grant","error_description":"code 已失效"}`))
+	defer server.Close()
+	client := newTestClient(t, server.URL, withoutSecret)
+
+	_, err := client.GetNewAccessTokenByRefreshTokenWithContext(context.Background(), "rt")
+	var oauthError *OAuthError
+	if !errors.As(err, &oauthError) {
+		t.Fatalf("期望 *OAuthError, 实际 %v", err)
+	}
+	if oauthError.StatusCode != http.StatusBadRequest || oauthError.ErrorCode != "invalid_grant" || oauthError.ErrorDescription != "code 已失效" {
+		t.Fatalf("OAuthError 不符合预期: %+v", oauthError)
+	}
+
+	response, err := client.GetAccessTokenByCode("code")
+	if !errors.As(err, &oauthError) || response.Error != "invalid_grant" {
+		t.Fatalf("旧版方法应返回错误并填充 Error 字段: %+v %v", response, err)
+	}
+}
+
+func TestGrantWithContext_NonJsonError(t *testing.T) {
+	server := newStubServer(stubResponse(http.StatusBadGateway, `bad gateway`))
+	defer server.Close()
+	client := newTestClient(t, server.URL, withoutSecret)
+
+	_, err := client.GetAccessTokenByCodeWithContext(context.Background(), &CodeToTokenParams{Code: "code"})
+	var oauthError *OAuthError
+	if !errors.As(err, &oauthError) || oauthError.StatusCode != http.StatusBadGateway || oauthError.ErrorCode != "server_error" {
+		t.Fatalf("期望 server_error, 实际 %v", err)
+	}
+}
@@ -0,0 +1,100 @@
+package authentication
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+var ErrNoRefreshToken = errors.New("access token 已过期且没有可用的 refresh token")
+
+// TokenSource 提供可用的 token，形式与 golang.org/x/oauth2 的 TokenSource 一致
+type TokenSource interface {
+	Token() (*TokenSet, error)
+}
+
+type TokenSourceOptions struct {
+	/**
+	在 access token 过期前多久刷新，默认为 1 分钟
+	*/
+	RefreshBefore time.Duration
+	/**
+	刷新得到新 token 后的回调，可用于持久化新的 token（包括轮换后的 refresh token）
+	*/
+	OnTokenRefreshed func(tokenSet *TokenSet)
+}
+
+// ReuseTokenSource 缓存 token，即将过期时刷新；并发调用时只会发起一次刷新，其余调用等待并复用结果
+type ReuseTokenSource struct {
+	mutex   sync.Mutex
+	current *TokenSet
+	refresh func(ctx context.Context, current *TokenSet) (*TokenSet, error)
+	options TokenSourceOptions
+}
+
+func newReuseTokenSource(current *TokenSet, refresh func(ctx context.Context, current *TokenSet) (*TokenSet, error), options *TokenSourceOptions) *ReuseTokenSource {
+	source := &ReuseTokenSource{current: current, refresh: refresh}
+	if options != nil {
+		source.options = *options
+	}
+	if source.options.RefreshBefore == 0 {
+		source.options.RefreshBefore = time.Minute
+	}
+	return source
+}
+
+// RefreshTokenSource 使用 refresh token 自动续期用户的 token，tokenSet 为登录时获得的 token；
+// 服务端轮换 refresh token 时自动使用新的 refresh token
+func (client *AuthenticationClient) RefreshTokenSource(tokenSet *TokenSet, options *TokenSourceOptions) *ReuseTokenSource {
+	return newReuseTokenSource(tokenSet, func(ctx context.Context, current *TokenSet) (*TokenSet, error) {
+		if current == nil || current.RefreshToken == "" {
+			return nil, ErrNoRefreshToken
+		}
+		refreshed, err := client.GetNewAccessTokenByRefreshTokenWithContext(ctx, current.RefreshToken)
+		if err != nil {
+			return nil, err
+		}
+		if refreshed.IDToken == "" {
+			refreshed.IDToken = current.IDToken
+		}
+		return refreshed, nil
+	}, options)
+}
+
+// ClientCredentialsTokenSource 使用 client credentials 模式获取并自动续期机器对机器调用的 token
+func (client *AuthenticationClient) ClientCredentialsTokenSource(req GetAccessTokenByClientCredentialsRequest, options *TokenSourceOptions) *ReuseTokenSource {
+	return newReuseTokenSource(nil, func(ctx context.Context, current *TokenSet) (*TokenSet, error) {
+		return client.GetAccessTokenByClientCredentialsWithContext(ctx, req)
+	}, options)
+}
+
+// Token 返回可用的 token
+func (source *ReuseTokenSource) Token() (*TokenSet, error) {
+	return source.TokenWithContext(context.Background())
+}
+
+// TokenWithContext 同 Token，ctx 用于控制刷新请求。
+// 刷新失败时若当前 token 尚未过期则继续返回当前 token
+func (source *ReuseTokenSource) TokenWithContext(ctx context.Context) (*TokenSet, error) {
+	source.mutex.Lock()
+	defer source.mutex.Unlock()
+	current := source.current
+	now := time.Now()
+	if current != nil && !current.Expired(now, source.options.RefreshBefore) {
+		return current, nil
+	}
+
+	refreshed, err := source.refresh(ctx, current)
+	if err != nil {
+		if current != nil && !current.Expired(now, 0) {
+			return current, nil
+		}
+		return nil, err
+	}
+	source.current = refreshed
+	if source.options.OnTokenRefreshed != nil {
+		source.options.OnTokenRefreshed(refreshed)
+	}
+	return refreshed, nil
+}
@@ -0,0 +1,101 @@
+package authentication
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRefreshTokenSource_RotationAndDedup(t *testing.T) {
+	var server *stubServer
+	server = newStubServer(func(w http.ResponseWriter, r *http.Request) {
+		n := server.hits("")
+		if r.PostForm.Get("refresh_token") != fmt.Sprintf("rt-%d", n-1) {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":"invalid_grant"}`))
+			return
+		}
+		time.Sleep(50 * time.Millisecond)
+		fmt.Fprintf(w, `{"access_token":"at-%d","refresh_token":"rt-%d","expires_in":3600}`, n, n)
+	})
+	defer server.Close()
+	client := newTestClient(t, server.URL, withoutSecret)
+
+	var persisted []*TokenSet
+	var mutex sync.Mutex
+	source := client.RefreshTokenSource(&TokenSet{
+		AccessToken:  "at-0",
+		RefreshToken: "rt-0",
+		IDToken:      "it",
+		Expiry:       time.Now().Add(30 * time.Second),
+	}, &TokenSourceOptions{
+		OnTokenRefreshed: func(tokenSet *TokenSet) {
+			mutex.Lock()
+			persisted = append(persisted, tokenSet)
+			mutex.Unlock()
+		},
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tokenSet, err := source.Token()
+			if err != nil || tokenSet.AccessToken != "at-1" {
+				t.Errorf("期望 at-1, 实际 %+v %v", tokenSet, err)
+			}
+		}()
+	}
+	wg.Wait()
+	if n := server.hits(""); n != 1 {
+		t.Fatalf("并发调用应只刷新一次, 实际 %d 次", n)
+	}
+	if len(persisted) != 1 || persisted[0].RefreshToken != "rt-1" || persisted[0].IDToken != "it" {
+		t.Fatalf("回调参数不符合预期: %+v", persisted)
+	}
+
+	tokenSet, err := source.Token()
+	if err != nil || tokenSet.AccessToken != "at-1" || server.hits("") != 1 {
+		t.Fatalf("未到刷新时间时应返回缓存的 token: %+v %v", tokenSet, err)
+	}
+}
+
+func TestRefreshTokenSource_Errors(t *testing.T) {
+	server := newStubServer(stubResponse(http.StatusServiceUnavailable, `{"error":"temporarily_unavailable"}`))
+	defer server.Close()
+	client := newTestClient(t, server.URL, withoutSecret)
+
+	stillValid := &TokenSet{AccessToken: "at", RefreshToken: "rt", Expiry: time.Now().Add(30 * time.Second)}
+	tokenSet, err := client.RefreshTokenSource(stillValid, nil).Token()
+	if err != nil || tokenSet != stillValid {
+		t.Fatalf("刷新失败时应返回未过期的 token: %+v %v", tokenSet, err)
+	}
+
+	expired := &TokenSet{AccessToken: "at", Expiry: time.Now().Add(-time.Second)}
+	if _, err = client.RefreshTokenSource(expired, nil).Token(); err != ErrNoRefreshToken {
+		t.Fatalf("期望 ErrNoRefreshToken, 实际 %v", err)
+	}
+}
+
+func TestClientCredentialsTokenSource(t *testing.T) {
+	var server *stubServer
+	server = newStubServer(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"access_token":"m2m-%d","expires_in":3600}`, server.hits(""))
+	})
+	defer server.Close()
+	client := newTestClient(t, server.URL, withoutSecret)
+
+	var source TokenSource = client.ClientCredentialsTokenSource(GetAccessTokenByClientCredentialsRequest{
+		Scope:                 "read",
+		ClientCredentialInput: &ClientCredentialInput{AccessKey: "ak", SecretKey: "sk"},
+	}, nil)
+	for i := 0; i < 3; i++ {
+		tokenSet, err := source.Token()
+		if err != nil || tokenSet.AccessToken != "m2m-1" {
+			t.Fatalf("期望 m2m-1, 实际 %+v %v", tokenSet, err)
+		}
+	}
+}
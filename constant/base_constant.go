@@ -15,4 +15,9 @@ const (
 	None = "none"
 
 	WebSocketHost = "wss://events.authing.com"
+
+	// NotLoggedInApiCode 服务端返回的未登录 apiCode，token 无效或已被吊销时返回
+	NotLoggedInApiCode = 2020
+	// TokenExpiredApiCode 服务端返回的登录信息已过期 apiCode
+	TokenExpiredApiCode = 2206
 )
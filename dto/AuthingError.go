@@ -0,0 +1,51 @@
+package dto
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrTimeout 请求超时，包括 ReadTimeout 到期与 context 截止时间到达
+var ErrTimeout = errors.New("请求超时")
+
+// AuthingError Authing 接口返回的错误，字段取自 CommonResponseDto。
+// 网络错误时 StatusCode 为 500（超时为 504），Err 为底层错误，可通过 errors.Is 判断
+type AuthingError struct {
+	StatusCode int
+	ApiCode    int
+	RequestId  string
+	Message    string
+	Err        error
+	// Body 服务端返回的原始响应体，网络错误时为空
+	Body []byte
+}
+
+func NewAuthingError(resp *CommonResponseDto) *AuthingError {
+	return &AuthingError{
+		StatusCode: resp.StatusCode,
+		ApiCode:    resp.ApiCode,
+		RequestId:  resp.RequestId,
+		Message:    resp.Message,
+	}
+}
+
+func (e *AuthingError) Error() string {
+	if e.ApiCode != 0 {
+		return fmt.Sprintf("authing: [%d/%d] %s (requestId: %s)", e.StatusCode, e.ApiCode, e.Message, e.RequestId)
+	}
+	return fmt.Sprintf("authing: [%d] %s", e.StatusCode, e.Message)
+}
+
+func (e *AuthingError) Unwrap() error {
+	return e.Err
+}
+
+// Response 将错误还原为通用响应结构
+func (e *AuthingError) Response() *CommonResponseDto {
+	return &CommonResponseDto{
+		StatusCode: e.StatusCode,
+		Message:    e.Message,
+		ApiCode:    e.ApiCode,
+		RequestId:  e.RequestId,
+	}
+}
@@ -26,5 +26,8 @@ type OidcDiscoveryMetadata struct{
     IntrospectionEndpointAuthMethodsSupported  []string `json:"introspection_endpoint_auth_methods_supported"`
     RevocationEndpoint  string `json:"revocation_endpoint"`
     RevocationEndpointAuthMethodsSupported  []string `json:"revocation_endpoint_auth_methods_supported"`
+    DeviceAuthorizationEndpoint  string `json:"device_authorization_endpoint"`
+    PushedAuthorizationRequestEndpoint  string `json:"pushed_authorization_request_endpoint"`
+    RequestObjectSigningAlgValuesSupported  []string `json:"request_object_signing_alg_values_supported"`
 }
 
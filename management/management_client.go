@@ -1,8 +1,8 @@
 package management
 
 import (
-	"encoding/json"
-	"fmt"
+	"context"
+	"log"
 
 	"github.com/Authing/authing-golang-sdk/v3/dto"
 	"github.com/Authing/authing-golang-sdk/v3/util"
@@ -189,18 +189,25 @@ import (
                                                                                                                                          * @returns UserPaginatedRespDto
 */
 func (client *ManagementClient) ListUsers(reqDto *dto.ListUsersRequestDto) *dto.UserPaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/list-users", fasthttp.MethodPost, reqDto)
-	var response dto.UserPaginatedRespDto
+	response, err := client.ListUsersWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.UserPaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ListUsersWithContext 同 ListUsers，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ListUsersWithContext(ctx context.Context, reqDto *dto.ListUsersRequestDto) (*dto.UserPaginatedRespDto, error) {
+	var response dto.UserPaginatedRespDto
+	err := client.request(ctx, "/api/v3/list-users", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -218,18 +225,25 @@ func (client *ManagementClient) ListUsers(reqDto *dto.ListUsersRequestDto) *dto.
  * @returns UserPaginatedRespDto
  */
 func (client *ManagementClient) ListUsersLegacy(reqDto *dto.ListUsersDto) *dto.UserPaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/list-users", fasthttp.MethodGet, reqDto)
-	var response dto.UserPaginatedRespDto
+	response, err := client.ListUsersLegacyWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.UserPaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ListUsersLegacyWithContext 同 ListUsersLegacy，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ListUsersLegacyWithContext(ctx context.Context, reqDto *dto.ListUsersDto) (*dto.UserPaginatedRespDto, error) {
+	var response dto.UserPaginatedRespDto
+	err := client.request(ctx, "/api/v3/list-users", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -251,18 +265,25 @@ func (client *ManagementClient) ListUsersLegacy(reqDto *dto.ListUsersDto) *dto.U
  * @returns UserSingleRespDto
  */
 func (client *ManagementClient) GetUser(reqDto *dto.GetUserDto) *dto.UserSingleRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-user", fasthttp.MethodGet, reqDto)
-	var response dto.UserSingleRespDto
+	response, err := client.GetUserWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.UserSingleRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetUserWithContext 同 GetUser，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetUserWithContext(ctx context.Context, reqDto *dto.GetUserDto) (*dto.UserSingleRespDto, error) {
+	var response dto.UserSingleRespDto
+	err := client.request(ctx, "/api/v3/get-user", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -284,18 +305,25 @@ func (client *ManagementClient) GetUser(reqDto *dto.GetUserDto) *dto.UserSingleR
  * @returns UserListRespDto
  */
 func (client *ManagementClient) GetUserBatch(reqDto *dto.GetUserBatchDto) *dto.UserListRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-user-batch", fasthttp.MethodGet, reqDto)
-	var response dto.UserListRespDto
+	response, err := client.GetUserBatchWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.UserListRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetUserBatchWithContext 同 GetUserBatch，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetUserBatchWithContext(ctx context.Context, reqDto *dto.GetUserBatchDto) (*dto.UserListRespDto, error) {
+	var response dto.UserListRespDto
+	err := client.request(ctx, "/api/v3/get-user-batch", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -305,18 +333,25 @@ func (client *ManagementClient) GetUserBatch(reqDto *dto.GetUserBatchDto) *dto.U
  * @returns UserSingleRespDto
  */
 func (client *ManagementClient) CreateUser(reqDto *dto.CreateUserReqDto) *dto.UserSingleRespDto {
-	b, err := client.SendHttpRequest("/api/v3/create-user", fasthttp.MethodPost, reqDto)
-	var response dto.UserSingleRespDto
+	response, err := client.CreateUserWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.UserSingleRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// CreateUserWithContext 同 CreateUser，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) CreateUserWithContext(ctx context.Context, reqDto *dto.CreateUserReqDto) (*dto.UserSingleRespDto, error) {
+	var response dto.UserSingleRespDto
+	err := client.request(ctx, "/api/v3/create-user", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -326,18 +361,25 @@ func (client *ManagementClient) CreateUser(reqDto *dto.CreateUserReqDto) *dto.Us
  * @returns UserListRespDto
  */
 func (client *ManagementClient) CreateUsersBatch(reqDto *dto.CreateUserBatchReqDto) *dto.UserListRespDto {
-	b, err := client.SendHttpRequest("/api/v3/create-users-batch", fasthttp.MethodPost, reqDto)
-	var response dto.UserListRespDto
+	response, err := client.CreateUsersBatchWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.UserListRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// CreateUsersBatchWithContext 同 CreateUsersBatch，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) CreateUsersBatchWithContext(ctx context.Context, reqDto *dto.CreateUserBatchReqDto) (*dto.UserListRespDto, error) {
+	var response dto.UserListRespDto
+	err := client.request(ctx, "/api/v3/create-users-batch", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -347,18 +389,25 @@ func (client *ManagementClient) CreateUsersBatch(reqDto *dto.CreateUserBatchReqD
  * @returns UserSingleRespDto
  */
 func (client *ManagementClient) UpdateUser(reqDto *dto.UpdateUserReqDto) *dto.UserSingleRespDto {
-	b, err := client.SendHttpRequest("/api/v3/update-user", fasthttp.MethodPost, reqDto)
-	var response dto.UserSingleRespDto
+	response, err := client.UpdateUserWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.UserSingleRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// UpdateUserWithContext 同 UpdateUser，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) UpdateUserWithContext(ctx context.Context, reqDto *dto.UpdateUserReqDto) (*dto.UserSingleRespDto, error) {
+	var response dto.UserSingleRespDto
+	err := client.request(ctx, "/api/v3/update-user", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -368,18 +417,25 @@ func (client *ManagementClient) UpdateUser(reqDto *dto.UpdateUserReqDto) *dto.Us
  * @returns UserListRespDto
  */
 func (client *ManagementClient) UpdateUserBatch(reqDto *dto.UpdateUserBatchReqDto) *dto.UserListRespDto {
-	b, err := client.SendHttpRequest("/api/v3/update-user-batch", fasthttp.MethodPost, reqDto)
-	var response dto.UserListRespDto
+	response, err := client.UpdateUserBatchWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.UserListRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// UpdateUserBatchWithContext 同 UpdateUserBatch，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) UpdateUserBatchWithContext(ctx context.Context, reqDto *dto.UpdateUserBatchReqDto) (*dto.UserListRespDto, error) {
+	var response dto.UserListRespDto
+	err := client.request(ctx, "/api/v3/update-user-batch", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -389,18 +445,25 @@ func (client *ManagementClient) UpdateUserBatch(reqDto *dto.UpdateUserBatchReqDt
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) DeleteUsersBatch(reqDto *dto.DeleteUsersBatchDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/delete-users-batch", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.DeleteUsersBatchWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// DeleteUsersBatchWithContext 同 DeleteUsersBatch，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) DeleteUsersBatchWithContext(ctx context.Context, reqDto *dto.DeleteUsersBatchDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/delete-users-batch", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -419,18 +482,25 @@ func (client *ManagementClient) DeleteUsersBatch(reqDto *dto.DeleteUsersBatchDto
  * @returns IdentityListRespDto
  */
 func (client *ManagementClient) GetUserIdentities(reqDto *dto.GetUserIdentitiesDto) *dto.IdentityListRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-user-identities", fasthttp.MethodGet, reqDto)
-	var response dto.IdentityListRespDto
+	response, err := client.GetUserIdentitiesWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IdentityListRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetUserIdentitiesWithContext 同 GetUserIdentities，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetUserIdentitiesWithContext(ctx context.Context, reqDto *dto.GetUserIdentitiesDto) (*dto.IdentityListRespDto, error) {
+	var response dto.IdentityListRespDto
+	err := client.request(ctx, "/api/v3/get-user-identities", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -450,18 +520,25 @@ func (client *ManagementClient) GetUserIdentities(reqDto *dto.GetUserIdentitiesD
  * @returns RolePaginatedRespDto
  */
 func (client *ManagementClient) GetUserRoles(reqDto *dto.GetUserRolesDto) *dto.RolePaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-user-roles", fasthttp.MethodGet, reqDto)
-	var response dto.RolePaginatedRespDto
+	response, err := client.GetUserRolesWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.RolePaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetUserRolesWithContext 同 GetUserRoles，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetUserRolesWithContext(ctx context.Context, reqDto *dto.GetUserRolesDto) (*dto.RolePaginatedRespDto, error) {
+	var response dto.RolePaginatedRespDto
+	err := client.request(ctx, "/api/v3/get-user-roles", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -480,18 +557,25 @@ func (client *ManagementClient) GetUserRoles(reqDto *dto.GetUserRolesDto) *dto.R
  * @returns PrincipalAuthenticationInfoPaginatedRespDto
  */
 func (client *ManagementClient) GetUserPrincipalAuthenticationInfo(reqDto *dto.GetUserPrincipalAuthenticationInfoDto) *dto.PrincipalAuthenticationInfoPaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-user-principal-authentication-info", fasthttp.MethodGet, reqDto)
-	var response dto.PrincipalAuthenticationInfoPaginatedRespDto
+	response, err := client.GetUserPrincipalAuthenticationInfoWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.PrincipalAuthenticationInfoPaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetUserPrincipalAuthenticationInfoWithContext 同 GetUserPrincipalAuthenticationInfo，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetUserPrincipalAuthenticationInfoWithContext(ctx context.Context, reqDto *dto.GetUserPrincipalAuthenticationInfoDto) (*dto.PrincipalAuthenticationInfoPaginatedRespDto, error) {
+	var response dto.PrincipalAuthenticationInfoPaginatedRespDto
+	err := client.request(ctx, "/api/v3/get-user-principal-authentication-info", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -501,18 +585,25 @@ func (client *ManagementClient) GetUserPrincipalAuthenticationInfo(reqDto *dto.G
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) ResetUserPrincipalAuthenticationInfo(reqDto *dto.ResetUserPrincipalAuthenticationInfoDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/reset-user-principal-authentication-info", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.ResetUserPrincipalAuthenticationInfoWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ResetUserPrincipalAuthenticationInfoWithContext 同 ResetUserPrincipalAuthenticationInfo，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ResetUserPrincipalAuthenticationInfoWithContext(ctx context.Context, reqDto *dto.ResetUserPrincipalAuthenticationInfoDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/reset-user-principal-authentication-info", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -537,18 +628,25 @@ func (client *ManagementClient) ResetUserPrincipalAuthenticationInfo(reqDto *dto
  * @returns UserDepartmentPaginatedRespDto
  */
 func (client *ManagementClient) GetUserDepartments(reqDto *dto.GetUserDepartmentsDto) *dto.UserDepartmentPaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-user-departments", fasthttp.MethodGet, reqDto)
-	var response dto.UserDepartmentPaginatedRespDto
+	response, err := client.GetUserDepartmentsWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.UserDepartmentPaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetUserDepartmentsWithContext 同 GetUserDepartments，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetUserDepartmentsWithContext(ctx context.Context, reqDto *dto.GetUserDepartmentsDto) (*dto.UserDepartmentPaginatedRespDto, error) {
+	var response dto.UserDepartmentPaginatedRespDto
+	err := client.request(ctx, "/api/v3/get-user-departments", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -558,18 +656,25 @@ func (client *ManagementClient) GetUserDepartments(reqDto *dto.GetUserDepartment
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) SetUserDepartments(reqDto *dto.SetUserDepartmentsDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/set-user-departments", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.SetUserDepartmentsWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// SetUserDepartmentsWithContext 同 SetUserDepartments，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) SetUserDepartmentsWithContext(ctx context.Context, reqDto *dto.SetUserDepartmentsDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/set-user-departments", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -588,18 +693,25 @@ func (client *ManagementClient) SetUserDepartments(reqDto *dto.SetUserDepartment
  * @returns GroupPaginatedRespDto
  */
 func (client *ManagementClient) GetUserGroups(reqDto *dto.GetUserGroupsDto) *dto.GroupPaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-user-groups", fasthttp.MethodGet, reqDto)
-	var response dto.GroupPaginatedRespDto
+	response, err := client.GetUserGroupsWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.GroupPaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetUserGroupsWithContext 同 GetUserGroups，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetUserGroupsWithContext(ctx context.Context, reqDto *dto.GetUserGroupsDto) (*dto.GroupPaginatedRespDto, error) {
+	var response dto.GroupPaginatedRespDto
+	err := client.request(ctx, "/api/v3/get-user-groups", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -618,18 +730,25 @@ func (client *ManagementClient) GetUserGroups(reqDto *dto.GetUserGroupsDto) *dto
  * @returns UserMfaSingleRespDto
  */
 func (client *ManagementClient) GetUserMfaInfo(reqDto *dto.GetUserMfaInfoDto) *dto.UserMfaSingleRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-user-mfa-info", fasthttp.MethodGet, reqDto)
-	var response dto.UserMfaSingleRespDto
+	response, err := client.GetUserMfaInfoWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.UserMfaSingleRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetUserMfaInfoWithContext 同 GetUserMfaInfo，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetUserMfaInfoWithContext(ctx context.Context, reqDto *dto.GetUserMfaInfoDto) (*dto.UserMfaSingleRespDto, error) {
+	var response dto.UserMfaSingleRespDto
+	err := client.request(ctx, "/api/v3/get-user-mfa-info", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -641,18 +760,25 @@ func (client *ManagementClient) GetUserMfaInfo(reqDto *dto.GetUserMfaInfoDto) *d
  * @returns ListArchivedUsersSingleRespDto
  */
 func (client *ManagementClient) ListArchivedUsers(reqDto *dto.ListArchivedUsersDto) *dto.ListArchivedUsersSingleRespDto {
-	b, err := client.SendHttpRequest("/api/v3/list-archived-users", fasthttp.MethodGet, reqDto)
-	var response dto.ListArchivedUsersSingleRespDto
+	response, err := client.ListArchivedUsersWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.ListArchivedUsersSingleRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ListArchivedUsersWithContext 同 ListArchivedUsers，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ListArchivedUsersWithContext(ctx context.Context, reqDto *dto.ListArchivedUsersDto) (*dto.ListArchivedUsersSingleRespDto, error) {
+	var response dto.ListArchivedUsersSingleRespDto
+	err := client.request(ctx, "/api/v3/list-archived-users", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -662,18 +788,25 @@ func (client *ManagementClient) ListArchivedUsers(reqDto *dto.ListArchivedUsersD
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) KickUsers(reqDto *dto.KickUsersDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/kick-users", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.KickUsersWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// KickUsersWithContext 同 KickUsers，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) KickUsersWithContext(ctx context.Context, reqDto *dto.KickUsersDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/kick-users", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -683,18 +816,25 @@ func (client *ManagementClient) KickUsers(reqDto *dto.KickUsersDto) *dto.IsSucce
  * @returns IsUserExistsRespDto
  */
 func (client *ManagementClient) IsUserExists(reqDto *dto.IsUserExistsReqDto) *dto.IsUserExistsRespDto {
-	b, err := client.SendHttpRequest("/api/v3/is-user-exists", fasthttp.MethodPost, reqDto)
-	var response dto.IsUserExistsRespDto
+	response, err := client.IsUserExistsWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsUserExistsRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// IsUserExistsWithContext 同 IsUserExists，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) IsUserExistsWithContext(ctx context.Context, reqDto *dto.IsUserExistsReqDto) (*dto.IsUserExistsRespDto, error) {
+	var response dto.IsUserExistsRespDto
+	err := client.request(ctx, "/api/v3/is-user-exists", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -713,18 +853,25 @@ func (client *ManagementClient) IsUserExists(reqDto *dto.IsUserExistsReqDto) *dt
  * @returns AppListRespDto
  */
 func (client *ManagementClient) GetUserAccessibleApps(reqDto *dto.GetUserAccessibleAppsDto) *dto.AppListRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-user-accessible-apps", fasthttp.MethodGet, reqDto)
-	var response dto.AppListRespDto
+	response, err := client.GetUserAccessibleAppsWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.AppListRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetUserAccessibleAppsWithContext 同 GetUserAccessibleApps，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetUserAccessibleAppsWithContext(ctx context.Context, reqDto *dto.GetUserAccessibleAppsDto) (*dto.AppListRespDto, error) {
+	var response dto.AppListRespDto
+	err := client.request(ctx, "/api/v3/get-user-accessible-apps", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -743,18 +890,25 @@ func (client *ManagementClient) GetUserAccessibleApps(reqDto *dto.GetUserAccessi
  * @returns AppListRespDto
  */
 func (client *ManagementClient) GetUserAuthorizedApps(reqDto *dto.GetUserAuthorizedAppsDto) *dto.AppListRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-user-authorized-apps", fasthttp.MethodGet, reqDto)
-	var response dto.AppListRespDto
+	response, err := client.GetUserAuthorizedAppsWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.AppListRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetUserAuthorizedAppsWithContext 同 GetUserAuthorizedApps，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetUserAuthorizedAppsWithContext(ctx context.Context, reqDto *dto.GetUserAuthorizedAppsDto) (*dto.AppListRespDto, error) {
+	var response dto.AppListRespDto
+	err := client.request(ctx, "/api/v3/get-user-authorized-apps", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -764,18 +918,25 @@ func (client *ManagementClient) GetUserAuthorizedApps(reqDto *dto.GetUserAuthori
  * @returns HasAnyRoleRespDto
  */
 func (client *ManagementClient) HasAnyRole(reqDto *dto.HasAnyRoleReqDto) *dto.HasAnyRoleRespDto {
-	b, err := client.SendHttpRequest("/api/v3/has-any-role", fasthttp.MethodPost, reqDto)
-	var response dto.HasAnyRoleRespDto
+	response, err := client.HasAnyRoleWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.HasAnyRoleRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// HasAnyRoleWithContext 同 HasAnyRole，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) HasAnyRoleWithContext(ctx context.Context, reqDto *dto.HasAnyRoleReqDto) (*dto.HasAnyRoleRespDto, error) {
+	var response dto.HasAnyRoleRespDto
+	err := client.request(ctx, "/api/v3/has-any-role", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -800,18 +961,25 @@ func (client *ManagementClient) HasAnyRole(reqDto *dto.HasAnyRoleReqDto) *dto.Ha
  * @returns UserLoginHistoryPaginatedRespDto
  */
 func (client *ManagementClient) GetUserLoginHistory(reqDto *dto.GetUserLoginHistoryDto) *dto.UserLoginHistoryPaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-user-login-history", fasthttp.MethodGet, reqDto)
-	var response dto.UserLoginHistoryPaginatedRespDto
+	response, err := client.GetUserLoginHistoryWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.UserLoginHistoryPaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetUserLoginHistoryWithContext 同 GetUserLoginHistory，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetUserLoginHistoryWithContext(ctx context.Context, reqDto *dto.GetUserLoginHistoryDto) (*dto.UserLoginHistoryPaginatedRespDto, error) {
+	var response dto.UserLoginHistoryPaginatedRespDto
+	err := client.request(ctx, "/api/v3/get-user-login-history", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -830,18 +998,25 @@ func (client *ManagementClient) GetUserLoginHistory(reqDto *dto.GetUserLoginHist
  * @returns UserLoggedInAppsListRespDto
  */
 func (client *ManagementClient) GetUserLoggedinApps(reqDto *dto.GetUserLoggedinAppsDto) *dto.UserLoggedInAppsListRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-user-loggedin-apps", fasthttp.MethodGet, reqDto)
-	var response dto.UserLoggedInAppsListRespDto
+	response, err := client.GetUserLoggedinAppsWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.UserLoggedInAppsListRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetUserLoggedinAppsWithContext 同 GetUserLoggedinApps，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetUserLoggedinAppsWithContext(ctx context.Context, reqDto *dto.GetUserLoggedinAppsDto) (*dto.UserLoggedInAppsListRespDto, error) {
+	var response dto.UserLoggedInAppsListRespDto
+	err := client.request(ctx, "/api/v3/get-user-loggedin-apps", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -860,18 +1035,25 @@ func (client *ManagementClient) GetUserLoggedinApps(reqDto *dto.GetUserLoggedinA
  * @returns UserLoggedInIdentitiesRespDto
  */
 func (client *ManagementClient) GetUserLoggedinIdentities(reqDto *dto.GetUserLoggedInIdentitiesDto) *dto.UserLoggedInIdentitiesRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-user-logged-in-identities", fasthttp.MethodGet, reqDto)
-	var response dto.UserLoggedInIdentitiesRespDto
+	response, err := client.GetUserLoggedinIdentitiesWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.UserLoggedInIdentitiesRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetUserLoggedinIdentitiesWithContext 同 GetUserLoggedinIdentities，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetUserLoggedinIdentitiesWithContext(ctx context.Context, reqDto *dto.GetUserLoggedInIdentitiesDto) (*dto.UserLoggedInIdentitiesRespDto, error) {
+	var response dto.UserLoggedInIdentitiesRespDto
+	err := client.request(ctx, "/api/v3/get-user-logged-in-identities", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -881,18 +1063,25 @@ func (client *ManagementClient) GetUserLoggedinIdentities(reqDto *dto.GetUserLog
  * @returns ResignUserRespDto
  */
 func (client *ManagementClient) ResignUser(reqDto *dto.ResignUserReqDto) *dto.ResignUserRespDto {
-	b, err := client.SendHttpRequest("/api/v3/resign-user", fasthttp.MethodPost, reqDto)
-	var response dto.ResignUserRespDto
+	response, err := client.ResignUserWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.ResignUserRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ResignUserWithContext 同 ResignUser，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ResignUserWithContext(ctx context.Context, reqDto *dto.ResignUserReqDto) (*dto.ResignUserRespDto, error) {
+	var response dto.ResignUserRespDto
+	err := client.request(ctx, "/api/v3/resign-user", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -902,18 +1091,25 @@ func (client *ManagementClient) ResignUser(reqDto *dto.ResignUserReqDto) *dto.Re
  * @returns ResignUserRespDto
  */
 func (client *ManagementClient) ResignUserBatch(reqDto *dto.ResignUserBatchReqDto) *dto.ResignUserRespDto {
-	b, err := client.SendHttpRequest("/api/v3/resign-user-batch", fasthttp.MethodPost, reqDto)
-	var response dto.ResignUserRespDto
+	response, err := client.ResignUserBatchWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.ResignUserRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ResignUserBatchWithContext 同 ResignUserBatch，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ResignUserBatchWithContext(ctx context.Context, reqDto *dto.ResignUserBatchReqDto) (*dto.ResignUserRespDto, error) {
+	var response dto.ResignUserRespDto
+	err := client.request(ctx, "/api/v3/resign-user-batch", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -934,18 +1130,25 @@ func (client *ManagementClient) ResignUserBatch(reqDto *dto.ResignUserBatchReqDt
  * @returns AuthorizedResourcePaginatedRespDto
  */
 func (client *ManagementClient) GetUserAuthorizedResources(reqDto *dto.GetUserAuthorizedResourcesDto) *dto.AuthorizedResourcePaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-user-authorized-resources", fasthttp.MethodGet, reqDto)
-	var response dto.AuthorizedResourcePaginatedRespDto
+	response, err := client.GetUserAuthorizedResourcesWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.AuthorizedResourcePaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetUserAuthorizedResourcesWithContext 同 GetUserAuthorizedResources，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetUserAuthorizedResourcesWithContext(ctx context.Context, reqDto *dto.GetUserAuthorizedResourcesDto) (*dto.AuthorizedResourcePaginatedRespDto, error) {
+	var response dto.AuthorizedResourcePaginatedRespDto
+	err := client.request(ctx, "/api/v3/get-user-authorized-resources", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -955,18 +1158,25 @@ func (client *ManagementClient) GetUserAuthorizedResources(reqDto *dto.GetUserAu
  * @returns CheckSessionStatusRespDto
  */
 func (client *ManagementClient) CheckSessionStatus(reqDto *dto.CheckSessionStatusDto) *dto.CheckSessionStatusRespDto {
-	b, err := client.SendHttpRequest("/api/v3/check-session-status", fasthttp.MethodPost, reqDto)
-	var response dto.CheckSessionStatusRespDto
+	response, err := client.CheckSessionStatusWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CheckSessionStatusRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// CheckSessionStatusWithContext 同 CheckSessionStatus，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) CheckSessionStatusWithContext(ctx context.Context, reqDto *dto.CheckSessionStatusDto) (*dto.CheckSessionStatusRespDto, error) {
+	var response dto.CheckSessionStatusRespDto
+	err := client.request(ctx, "/api/v3/check-session-status", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -976,18 +1186,25 @@ func (client *ManagementClient) CheckSessionStatus(reqDto *dto.CheckSessionStatu
  * @returns CommonResponseDto
  */
 func (client *ManagementClient) ImportOtp(reqDto *dto.ImportOtpReqDto) *dto.CommonResponseDto {
-	b, err := client.SendHttpRequest("/api/v3/import-otp", fasthttp.MethodPost, reqDto)
-	var response dto.CommonResponseDto
+	response, err := client.ImportOtpWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CommonResponseDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ImportOtpWithContext 同 ImportOtp，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ImportOtpWithContext(ctx context.Context, reqDto *dto.ImportOtpReqDto) (*dto.CommonResponseDto, error) {
+	var response dto.CommonResponseDto
+	err := client.request(ctx, "/api/v3/import-otp", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1006,18 +1223,25 @@ func (client *ManagementClient) ImportOtp(reqDto *dto.ImportOtpReqDto) *dto.Comm
  * @returns GetOtpSecretRespDto
  */
 func (client *ManagementClient) GetOtpSecretByUser(reqDto *dto.GetOtpSecretByUserDto) *dto.GetOtpSecretRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-otp-secret-by-user", fasthttp.MethodGet, reqDto)
-	var response dto.GetOtpSecretRespDto
+	response, err := client.GetOtpSecretByUserWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.GetOtpSecretRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetOtpSecretByUserWithContext 同 GetOtpSecretByUser，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetOtpSecretByUserWithContext(ctx context.Context, reqDto *dto.GetOtpSecretByUserDto) (*dto.GetOtpSecretRespDto, error) {
+	var response dto.GetOtpSecretRespDto
+	err := client.request(ctx, "/api/v3/get-otp-secret-by-user", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1029,18 +1253,25 @@ func (client *ManagementClient) GetOtpSecretByUser(reqDto *dto.GetOtpSecretByUse
  * @returns OrganizationSingleRespDto
  */
 func (client *ManagementClient) GetOrganization(reqDto *dto.GetOrganizationDto) *dto.OrganizationSingleRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-organization", fasthttp.MethodGet, reqDto)
-	var response dto.OrganizationSingleRespDto
+	response, err := client.GetOrganizationWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.OrganizationSingleRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetOrganizationWithContext 同 GetOrganization，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetOrganizationWithContext(ctx context.Context, reqDto *dto.GetOrganizationDto) (*dto.OrganizationSingleRespDto, error) {
+	var response dto.OrganizationSingleRespDto
+	err := client.request(ctx, "/api/v3/get-organization", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1052,18 +1283,25 @@ func (client *ManagementClient) GetOrganization(reqDto *dto.GetOrganizationDto)
  * @returns OrganizationListRespDto
  */
 func (client *ManagementClient) GetOrganizationsBatch(reqDto *dto.GetOrganizationBatchDto) *dto.OrganizationListRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-organization-batch", fasthttp.MethodGet, reqDto)
-	var response dto.OrganizationListRespDto
+	response, err := client.GetOrganizationsBatchWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.OrganizationListRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetOrganizationsBatchWithContext 同 GetOrganizationsBatch，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetOrganizationsBatchWithContext(ctx context.Context, reqDto *dto.GetOrganizationBatchDto) (*dto.OrganizationListRespDto, error) {
+	var response dto.OrganizationListRespDto
+	err := client.request(ctx, "/api/v3/get-organization-batch", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1077,18 +1315,25 @@ func (client *ManagementClient) GetOrganizationsBatch(reqDto *dto.GetOrganizatio
  * @returns OrganizationPaginatedRespDto
  */
 func (client *ManagementClient) ListOrganizations(reqDto *dto.ListOrganizationsDto) *dto.OrganizationPaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/list-organizations", fasthttp.MethodGet, reqDto)
-	var response dto.OrganizationPaginatedRespDto
+	response, err := client.ListOrganizationsWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.OrganizationPaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ListOrganizationsWithContext 同 ListOrganizations，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ListOrganizationsWithContext(ctx context.Context, reqDto *dto.ListOrganizationsDto) (*dto.OrganizationPaginatedRespDto, error) {
+	var response dto.OrganizationPaginatedRespDto
+	err := client.request(ctx, "/api/v3/list-organizations", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1098,18 +1343,25 @@ func (client *ManagementClient) ListOrganizations(reqDto *dto.ListOrganizationsD
  * @returns OrganizationSingleRespDto
  */
 func (client *ManagementClient) CreateOrganization(reqDto *dto.CreateOrganizationReqDto) *dto.OrganizationSingleRespDto {
-	b, err := client.SendHttpRequest("/api/v3/create-organization", fasthttp.MethodPost, reqDto)
-	var response dto.OrganizationSingleRespDto
+	response, err := client.CreateOrganizationWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.OrganizationSingleRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// CreateOrganizationWithContext 同 CreateOrganization，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) CreateOrganizationWithContext(ctx context.Context, reqDto *dto.CreateOrganizationReqDto) (*dto.OrganizationSingleRespDto, error) {
+	var response dto.OrganizationSingleRespDto
+	err := client.request(ctx, "/api/v3/create-organization", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1119,18 +1371,25 @@ func (client *ManagementClient) CreateOrganization(reqDto *dto.CreateOrganizatio
  * @returns OrganizationSingleRespDto
  */
 func (client *ManagementClient) UpdateOrganization(reqDto *dto.UpdateOrganizationReqDto) *dto.OrganizationSingleRespDto {
-	b, err := client.SendHttpRequest("/api/v3/update-organization", fasthttp.MethodPost, reqDto)
-	var response dto.OrganizationSingleRespDto
+	response, err := client.UpdateOrganizationWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.OrganizationSingleRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// UpdateOrganizationWithContext 同 UpdateOrganization，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) UpdateOrganizationWithContext(ctx context.Context, reqDto *dto.UpdateOrganizationReqDto) (*dto.OrganizationSingleRespDto, error) {
+	var response dto.OrganizationSingleRespDto
+	err := client.request(ctx, "/api/v3/update-organization", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1140,18 +1399,25 @@ func (client *ManagementClient) UpdateOrganization(reqDto *dto.UpdateOrganizatio
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) DeleteOrganization(reqDto *dto.DeleteOrganizationReqDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/delete-organization", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.DeleteOrganizationWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// DeleteOrganizationWithContext 同 DeleteOrganization，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) DeleteOrganizationWithContext(ctx context.Context, reqDto *dto.DeleteOrganizationReqDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/delete-organization", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1165,18 +1431,25 @@ func (client *ManagementClient) DeleteOrganization(reqDto *dto.DeleteOrganizatio
  * @returns OrganizationPaginatedRespDto
  */
 func (client *ManagementClient) SearchOrganizations(reqDto *dto.SearchOrganizationsDto) *dto.OrganizationPaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/search-organizations", fasthttp.MethodGet, reqDto)
-	var response dto.OrganizationPaginatedRespDto
+	response, err := client.SearchOrganizationsWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.OrganizationPaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// SearchOrganizationsWithContext 同 SearchOrganizations，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) SearchOrganizationsWithContext(ctx context.Context, reqDto *dto.SearchOrganizationsDto) (*dto.OrganizationPaginatedRespDto, error) {
+	var response dto.OrganizationPaginatedRespDto
+	err := client.request(ctx, "/api/v3/search-organizations", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1191,18 +1464,25 @@ func (client *ManagementClient) SearchOrganizations(reqDto *dto.SearchOrganizati
  * @returns DepartmentSingleRespDto
  */
 func (client *ManagementClient) GetDepartment(reqDto *dto.GetDepartmentDto) *dto.DepartmentSingleRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-department", fasthttp.MethodGet, reqDto)
-	var response dto.DepartmentSingleRespDto
+	response, err := client.GetDepartmentWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.DepartmentSingleRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetDepartmentWithContext 同 GetDepartment，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetDepartmentWithContext(ctx context.Context, reqDto *dto.GetDepartmentDto) (*dto.DepartmentSingleRespDto, error) {
+	var response dto.DepartmentSingleRespDto
+	err := client.request(ctx, "/api/v3/get-department", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1212,18 +1492,25 @@ func (client *ManagementClient) GetDepartment(reqDto *dto.GetDepartmentDto) *dto
  * @returns DepartmentSingleRespDto
  */
 func (client *ManagementClient) CreateDepartment(reqDto *dto.CreateDepartmentReqDto) *dto.DepartmentSingleRespDto {
-	b, err := client.SendHttpRequest("/api/v3/create-department", fasthttp.MethodPost, reqDto)
-	var response dto.DepartmentSingleRespDto
+	response, err := client.CreateDepartmentWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.DepartmentSingleRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// CreateDepartmentWithContext 同 CreateDepartment，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) CreateDepartmentWithContext(ctx context.Context, reqDto *dto.CreateDepartmentReqDto) (*dto.DepartmentSingleRespDto, error) {
+	var response dto.DepartmentSingleRespDto
+	err := client.request(ctx, "/api/v3/create-department", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1233,18 +1520,25 @@ func (client *ManagementClient) CreateDepartment(reqDto *dto.CreateDepartmentReq
  * @returns DepartmentSingleRespDto
  */
 func (client *ManagementClient) UpdateDepartment(reqDto *dto.UpdateDepartmentReqDto) *dto.DepartmentSingleRespDto {
-	b, err := client.SendHttpRequest("/api/v3/update-department", fasthttp.MethodPost, reqDto)
-	var response dto.DepartmentSingleRespDto
+	response, err := client.UpdateDepartmentWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.DepartmentSingleRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// UpdateDepartmentWithContext 同 UpdateDepartment，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) UpdateDepartmentWithContext(ctx context.Context, reqDto *dto.UpdateDepartmentReqDto) (*dto.DepartmentSingleRespDto, error) {
+	var response dto.DepartmentSingleRespDto
+	err := client.request(ctx, "/api/v3/update-department", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1254,18 +1548,25 @@ func (client *ManagementClient) UpdateDepartment(reqDto *dto.UpdateDepartmentReq
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) DeleteDepartment(reqDto *dto.DeleteDepartmentReqDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/delete-department", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.DeleteDepartmentWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// DeleteDepartmentWithContext 同 DeleteDepartment，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) DeleteDepartmentWithContext(ctx context.Context, reqDto *dto.DeleteDepartmentReqDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/delete-department", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1276,18 +1577,25 @@ func (client *ManagementClient) DeleteDepartment(reqDto *dto.DeleteDepartmentReq
  * @returns DepartmentListRespDto
  */
 func (client *ManagementClient) SearchDepartments(reqDto *dto.SearchDepartmentsReqDto) *dto.DepartmentListRespDto {
-	b, err := client.SendHttpRequest("/api/v3/search-departments", fasthttp.MethodPost, reqDto)
-	var response dto.DepartmentListRespDto
+	response, err := client.SearchDepartmentsWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.DepartmentListRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// SearchDepartmentsWithContext 同 SearchDepartments，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) SearchDepartmentsWithContext(ctx context.Context, reqDto *dto.SearchDepartmentsReqDto) (*dto.DepartmentListRespDto, error) {
+	var response dto.DepartmentListRespDto
+	err := client.request(ctx, "/api/v3/search-departments", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1297,18 +1605,25 @@ func (client *ManagementClient) SearchDepartments(reqDto *dto.SearchDepartmentsR
  * @returns DepartmentListRespDto
  */
 func (client *ManagementClient) SearchDepartmentsList(reqDto *dto.SearchDepartmentsListReqDto) *dto.DepartmentListRespDto {
-	b, err := client.SendHttpRequest("/api/v3/search-departments-list", fasthttp.MethodPost, reqDto)
-	var response dto.DepartmentListRespDto
+	response, err := client.SearchDepartmentsListWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.DepartmentListRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// SearchDepartmentsListWithContext 同 SearchDepartmentsList，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) SearchDepartmentsListWithContext(ctx context.Context, reqDto *dto.SearchDepartmentsListReqDto) (*dto.DepartmentListRespDto, error) {
+	var response dto.DepartmentListRespDto
+	err := client.request(ctx, "/api/v3/search-departments-list", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1324,18 +1639,25 @@ func (client *ManagementClient) SearchDepartmentsList(reqDto *dto.SearchDepartme
  * @returns DepartmentPaginatedRespDto
  */
 func (client *ManagementClient) ListChildrenDepartments(reqDto *dto.ListChildrenDepartmentsDto) *dto.DepartmentPaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/list-children-departments", fasthttp.MethodGet, reqDto)
-	var response dto.DepartmentPaginatedRespDto
+	response, err := client.ListChildrenDepartmentsWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.DepartmentPaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ListChildrenDepartmentsWithContext 同 ListChildrenDepartments，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ListChildrenDepartmentsWithContext(ctx context.Context, reqDto *dto.ListChildrenDepartmentsDto) (*dto.DepartmentPaginatedRespDto, error) {
+	var response dto.DepartmentPaginatedRespDto
+	err := client.request(ctx, "/api/v3/list-children-departments", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1356,18 +1678,25 @@ func (client *ManagementClient) ListChildrenDepartments(reqDto *dto.ListChildren
  * @returns UserPaginatedRespDto
  */
 func (client *ManagementClient) ListDepartmentMembers(reqDto *dto.ListDepartmentMembersDto) *dto.UserPaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/list-department-members", fasthttp.MethodGet, reqDto)
-	var response dto.UserPaginatedRespDto
+	response, err := client.ListDepartmentMembersWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.UserPaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ListDepartmentMembersWithContext 同 ListDepartmentMembers，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ListDepartmentMembersWithContext(ctx context.Context, reqDto *dto.ListDepartmentMembersDto) (*dto.UserPaginatedRespDto, error) {
+	var response dto.UserPaginatedRespDto
+	err := client.request(ctx, "/api/v3/list-department-members", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1380,18 +1709,25 @@ func (client *ManagementClient) ListDepartmentMembers(reqDto *dto.ListDepartment
  * @returns UserIdListRespDto
  */
 func (client *ManagementClient) ListDepartmentMemberIds(reqDto *dto.ListDepartmentMemberIdsDto) *dto.UserIdListRespDto {
-	b, err := client.SendHttpRequest("/api/v3/list-department-member-ids", fasthttp.MethodGet, reqDto)
-	var response dto.UserIdListRespDto
+	response, err := client.ListDepartmentMemberIdsWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.UserIdListRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ListDepartmentMemberIdsWithContext 同 ListDepartmentMemberIds，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ListDepartmentMemberIdsWithContext(ctx context.Context, reqDto *dto.ListDepartmentMemberIdsDto) (*dto.UserIdListRespDto, error) {
+	var response dto.UserIdListRespDto
+	err := client.request(ctx, "/api/v3/list-department-member-ids", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1411,18 +1747,25 @@ func (client *ManagementClient) ListDepartmentMemberIds(reqDto *dto.ListDepartme
  * @returns UserPaginatedRespDto
  */
 func (client *ManagementClient) SearchDepartmentMembers(reqDto *dto.SearchDepartmentMembersDto) *dto.UserPaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/search-department-members", fasthttp.MethodGet, reqDto)
-	var response dto.UserPaginatedRespDto
+	response, err := client.SearchDepartmentMembersWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.UserPaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// SearchDepartmentMembersWithContext 同 SearchDepartmentMembers，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) SearchDepartmentMembersWithContext(ctx context.Context, reqDto *dto.SearchDepartmentMembersDto) (*dto.UserPaginatedRespDto, error) {
+	var response dto.UserPaginatedRespDto
+	err := client.request(ctx, "/api/v3/search-department-members", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1432,18 +1775,25 @@ func (client *ManagementClient) SearchDepartmentMembers(reqDto *dto.SearchDepart
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) AddDepartmentMembers(reqDto *dto.AddDepartmentMembersReqDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/add-department-members", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.AddDepartmentMembersWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// AddDepartmentMembersWithContext 同 AddDepartmentMembers，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) AddDepartmentMembersWithContext(ctx context.Context, reqDto *dto.AddDepartmentMembersReqDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/add-department-members", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1453,18 +1803,25 @@ func (client *ManagementClient) AddDepartmentMembers(reqDto *dto.AddDepartmentMe
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) RemoveDepartmentMembers(reqDto *dto.RemoveDepartmentMembersReqDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/remove-department-members", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.RemoveDepartmentMembersWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// RemoveDepartmentMembersWithContext 同 RemoveDepartmentMembers，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) RemoveDepartmentMembersWithContext(ctx context.Context, reqDto *dto.RemoveDepartmentMembersReqDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/remove-department-members", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1478,18 +1835,25 @@ func (client *ManagementClient) RemoveDepartmentMembers(reqDto *dto.RemoveDepart
  * @returns DepartmentSingleRespDto
  */
 func (client *ManagementClient) GetParentDepartment(reqDto *dto.GetParentDepartmentDto) *dto.DepartmentSingleRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-parent-department", fasthttp.MethodGet, reqDto)
-	var response dto.DepartmentSingleRespDto
+	response, err := client.GetParentDepartmentWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.DepartmentSingleRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetParentDepartmentWithContext 同 GetParentDepartment，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetParentDepartmentWithContext(ctx context.Context, reqDto *dto.GetParentDepartmentDto) (*dto.DepartmentSingleRespDto, error) {
+	var response dto.DepartmentSingleRespDto
+	err := client.request(ctx, "/api/v3/get-parent-department", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1504,18 +1868,25 @@ func (client *ManagementClient) GetParentDepartment(reqDto *dto.GetParentDepartm
  * @returns IsUserInDepartmentRespDto
  */
 func (client *ManagementClient) IsUserInDepartment(reqDto *dto.IsUserInDepartmentDto) *dto.IsUserInDepartmentRespDto {
-	b, err := client.SendHttpRequest("/api/v3/is-user-in-department", fasthttp.MethodGet, reqDto)
-	var response dto.IsUserInDepartmentRespDto
+	response, err := client.IsUserInDepartmentWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsUserInDepartmentRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// IsUserInDepartmentWithContext 同 IsUserInDepartment，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) IsUserInDepartmentWithContext(ctx context.Context, reqDto *dto.IsUserInDepartmentDto) (*dto.IsUserInDepartmentRespDto, error) {
+	var response dto.IsUserInDepartmentRespDto
+	err := client.request(ctx, "/api/v3/is-user-in-department", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1526,18 +1897,25 @@ func (client *ManagementClient) IsUserInDepartment(reqDto *dto.IsUserInDepartmen
  * @returns DepartmentSingleRespDto
  */
 func (client *ManagementClient) GetDepartmentById(reqDto *dto.GetDepartmentByIdDto) *dto.DepartmentSingleRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-department-by-id", fasthttp.MethodGet, reqDto)
-	var response dto.DepartmentSingleRespDto
+	response, err := client.GetDepartmentByIdWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.DepartmentSingleRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetDepartmentByIdWithContext 同 GetDepartmentById，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetDepartmentByIdWithContext(ctx context.Context, reqDto *dto.GetDepartmentByIdDto) (*dto.DepartmentSingleRespDto, error) {
+	var response dto.DepartmentSingleRespDto
+	err := client.request(ctx, "/api/v3/get-department-by-id", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1547,18 +1925,25 @@ func (client *ManagementClient) GetDepartmentById(reqDto *dto.GetDepartmentByIdD
  * @returns CreateDepartmentTreeRespDto
  */
 func (client *ManagementClient) CreateDepartmentTree(reqDto *dto.CreateDepartmentTreeReqDto) *dto.CreateDepartmentTreeRespDto {
-	b, err := client.SendHttpRequest("/api/v3/create-department-tree", fasthttp.MethodPost, reqDto)
-	var response dto.CreateDepartmentTreeRespDto
+	response, err := client.CreateDepartmentTreeWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CreateDepartmentTreeRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// CreateDepartmentTreeWithContext 同 CreateDepartmentTree，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) CreateDepartmentTreeWithContext(ctx context.Context, reqDto *dto.CreateDepartmentTreeReqDto) (*dto.CreateDepartmentTreeRespDto, error) {
+	var response dto.CreateDepartmentTreeRespDto
+	err := client.request(ctx, "/api/v3/create-department-tree", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1568,18 +1953,25 @@ func (client *ManagementClient) CreateDepartmentTree(reqDto *dto.CreateDepartmen
  * @returns GroupSingleRespDto
  */
 func (client *ManagementClient) GetGroup(reqDto *dto.GetGroupDto) *dto.GroupSingleRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-group", fasthttp.MethodGet, reqDto)
-	var response dto.GroupSingleRespDto
+	response, err := client.GetGroupWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.GroupSingleRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetGroupWithContext 同 GetGroup，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetGroupWithContext(ctx context.Context, reqDto *dto.GetGroupDto) (*dto.GroupSingleRespDto, error) {
+	var response dto.GroupSingleRespDto
+	err := client.request(ctx, "/api/v3/get-group", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1591,18 +1983,25 @@ func (client *ManagementClient) GetGroup(reqDto *dto.GetGroupDto) *dto.GroupSing
  * @returns GroupPaginatedRespDto
  */
 func (client *ManagementClient) ListGroups(reqDto *dto.ListGroupsDto) *dto.GroupPaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/list-groups", fasthttp.MethodGet, reqDto)
-	var response dto.GroupPaginatedRespDto
+	response, err := client.ListGroupsWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.GroupPaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ListGroupsWithContext 同 ListGroups，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ListGroupsWithContext(ctx context.Context, reqDto *dto.ListGroupsDto) (*dto.GroupPaginatedRespDto, error) {
+	var response dto.GroupPaginatedRespDto
+	err := client.request(ctx, "/api/v3/list-groups", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1612,18 +2011,25 @@ func (client *ManagementClient) ListGroups(reqDto *dto.ListGroupsDto) *dto.Group
  * @returns GroupSingleRespDto
  */
 func (client *ManagementClient) CreateGroup(reqDto *dto.CreateGroupReqDto) *dto.GroupSingleRespDto {
-	b, err := client.SendHttpRequest("/api/v3/create-group", fasthttp.MethodPost, reqDto)
-	var response dto.GroupSingleRespDto
+	response, err := client.CreateGroupWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.GroupSingleRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// CreateGroupWithContext 同 CreateGroup，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) CreateGroupWithContext(ctx context.Context, reqDto *dto.CreateGroupReqDto) (*dto.GroupSingleRespDto, error) {
+	var response dto.GroupSingleRespDto
+	err := client.request(ctx, "/api/v3/create-group", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1633,18 +2039,25 @@ func (client *ManagementClient) CreateGroup(reqDto *dto.CreateGroupReqDto) *dto.
  * @returns GroupListRespDto
  */
 func (client *ManagementClient) CreateGroupsBatch(reqDto *dto.CreateGroupBatchReqDto) *dto.GroupListRespDto {
-	b, err := client.SendHttpRequest("/api/v3/create-groups-batch", fasthttp.MethodPost, reqDto)
-	var response dto.GroupListRespDto
+	response, err := client.CreateGroupsBatchWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.GroupListRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// CreateGroupsBatchWithContext 同 CreateGroupsBatch，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) CreateGroupsBatchWithContext(ctx context.Context, reqDto *dto.CreateGroupBatchReqDto) (*dto.GroupListRespDto, error) {
+	var response dto.GroupListRespDto
+	err := client.request(ctx, "/api/v3/create-groups-batch", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1654,18 +2067,25 @@ func (client *ManagementClient) CreateGroupsBatch(reqDto *dto.CreateGroupBatchRe
  * @returns GroupSingleRespDto
  */
 func (client *ManagementClient) UpdateGroup(reqDto *dto.UpdateGroupReqDto) *dto.GroupSingleRespDto {
-	b, err := client.SendHttpRequest("/api/v3/update-group", fasthttp.MethodPost, reqDto)
-	var response dto.GroupSingleRespDto
+	response, err := client.UpdateGroupWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.GroupSingleRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// UpdateGroupWithContext 同 UpdateGroup，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) UpdateGroupWithContext(ctx context.Context, reqDto *dto.UpdateGroupReqDto) (*dto.GroupSingleRespDto, error) {
+	var response dto.GroupSingleRespDto
+	err := client.request(ctx, "/api/v3/update-group", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1675,18 +2095,25 @@ func (client *ManagementClient) UpdateGroup(reqDto *dto.UpdateGroupReqDto) *dto.
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) DeleteGroupsBatch(reqDto *dto.DeleteGroupsReqDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/delete-groups-batch", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.DeleteGroupsBatchWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// DeleteGroupsBatchWithContext 同 DeleteGroupsBatch，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) DeleteGroupsBatchWithContext(ctx context.Context, reqDto *dto.DeleteGroupsReqDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/delete-groups-batch", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1696,18 +2123,25 @@ func (client *ManagementClient) DeleteGroupsBatch(reqDto *dto.DeleteGroupsReqDto
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) AddGroupMembers(reqDto *dto.AddGroupMembersReqDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/add-group-members", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.AddGroupMembersWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// AddGroupMembersWithContext 同 AddGroupMembers，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) AddGroupMembersWithContext(ctx context.Context, reqDto *dto.AddGroupMembersReqDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/add-group-members", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1717,18 +2151,25 @@ func (client *ManagementClient) AddGroupMembers(reqDto *dto.AddGroupMembersReqDt
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) RemoveGroupMembers(reqDto *dto.RemoveGroupMembersReqDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/remove-group-members", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.RemoveGroupMembersWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// RemoveGroupMembersWithContext 同 RemoveGroupMembers，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) RemoveGroupMembersWithContext(ctx context.Context, reqDto *dto.RemoveGroupMembersReqDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/remove-group-members", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1743,18 +2184,25 @@ func (client *ManagementClient) RemoveGroupMembers(reqDto *dto.RemoveGroupMember
  * @returns UserPaginatedRespDto
  */
 func (client *ManagementClient) ListGroupMembers(reqDto *dto.ListGroupMembersDto) *dto.UserPaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/list-group-members", fasthttp.MethodGet, reqDto)
-	var response dto.UserPaginatedRespDto
+	response, err := client.ListGroupMembersWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.UserPaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ListGroupMembersWithContext 同 ListGroupMembers，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ListGroupMembersWithContext(ctx context.Context, reqDto *dto.ListGroupMembersDto) (*dto.UserPaginatedRespDto, error) {
+	var response dto.UserPaginatedRespDto
+	err := client.request(ctx, "/api/v3/list-group-members", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1766,18 +2214,25 @@ func (client *ManagementClient) ListGroupMembers(reqDto *dto.ListGroupMembersDto
  * @returns AuthorizedResourceListRespDto
  */
 func (client *ManagementClient) GetGroupAuthorizedResources(reqDto *dto.GetGroupAuthorizedResourcesDto) *dto.AuthorizedResourceListRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-group-authorized-resources", fasthttp.MethodGet, reqDto)
-	var response dto.AuthorizedResourceListRespDto
+	response, err := client.GetGroupAuthorizedResourcesWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.AuthorizedResourceListRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetGroupAuthorizedResourcesWithContext 同 GetGroupAuthorizedResources，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetGroupAuthorizedResourcesWithContext(ctx context.Context, reqDto *dto.GetGroupAuthorizedResourcesDto) (*dto.AuthorizedResourceListRespDto, error) {
+	var response dto.AuthorizedResourceListRespDto
+	err := client.request(ctx, "/api/v3/get-group-authorized-resources", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1788,18 +2243,25 @@ func (client *ManagementClient) GetGroupAuthorizedResources(reqDto *dto.GetGroup
  * @returns RoleSingleRespDto
  */
 func (client *ManagementClient) GetRole(reqDto *dto.GetRoleDto) *dto.RoleSingleRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-role", fasthttp.MethodGet, reqDto)
-	var response dto.RoleSingleRespDto
+	response, err := client.GetRoleWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.RoleSingleRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetRoleWithContext 同 GetRole，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetRoleWithContext(ctx context.Context, reqDto *dto.GetRoleDto) (*dto.RoleSingleRespDto, error) {
+	var response dto.RoleSingleRespDto
+	err := client.request(ctx, "/api/v3/get-role", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1809,18 +2271,25 @@ func (client *ManagementClient) GetRole(reqDto *dto.GetRoleDto) *dto.RoleSingleR
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) AssignRole(reqDto *dto.AssignRoleDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/assign-role", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.AssignRoleWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// AssignRoleWithContext 同 AssignRole，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) AssignRoleWithContext(ctx context.Context, reqDto *dto.AssignRoleDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/assign-role", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1830,18 +2299,25 @@ func (client *ManagementClient) AssignRole(reqDto *dto.AssignRoleDto) *dto.IsSuc
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) RevokeRole(reqDto *dto.RevokeRoleDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/revoke-role", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.RevokeRoleWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// RevokeRoleWithContext 同 RevokeRole，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) RevokeRoleWithContext(ctx context.Context, reqDto *dto.RevokeRoleDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/revoke-role", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1853,18 +2329,25 @@ func (client *ManagementClient) RevokeRole(reqDto *dto.RevokeRoleDto) *dto.IsSuc
  * @returns RoleAuthorizedResourcePaginatedRespDto
  */
 func (client *ManagementClient) GetRoleAuthorizedResources(reqDto *dto.GetRoleAuthorizedResourcesDto) *dto.RoleAuthorizedResourcePaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-role-authorized-resources", fasthttp.MethodGet, reqDto)
-	var response dto.RoleAuthorizedResourcePaginatedRespDto
+	response, err := client.GetRoleAuthorizedResourcesWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.RoleAuthorizedResourcePaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetRoleAuthorizedResourcesWithContext 同 GetRoleAuthorizedResources，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetRoleAuthorizedResourcesWithContext(ctx context.Context, reqDto *dto.GetRoleAuthorizedResourcesDto) (*dto.RoleAuthorizedResourcePaginatedRespDto, error) {
+	var response dto.RoleAuthorizedResourcePaginatedRespDto
+	err := client.request(ctx, "/api/v3/get-role-authorized-resources", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1880,18 +2363,25 @@ func (client *ManagementClient) GetRoleAuthorizedResources(reqDto *dto.GetRoleAu
  * @returns UserPaginatedRespDto
  */
 func (client *ManagementClient) ListRoleMembers(reqDto *dto.ListRoleMembersDto) *dto.UserPaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/list-role-members", fasthttp.MethodGet, reqDto)
-	var response dto.UserPaginatedRespDto
+	response, err := client.ListRoleMembersWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.UserPaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ListRoleMembersWithContext 同 ListRoleMembers，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ListRoleMembersWithContext(ctx context.Context, reqDto *dto.ListRoleMembersDto) (*dto.UserPaginatedRespDto, error) {
+	var response dto.UserPaginatedRespDto
+	err := client.request(ctx, "/api/v3/list-role-members", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1904,18 +2394,25 @@ func (client *ManagementClient) ListRoleMembers(reqDto *dto.ListRoleMembersDto)
  * @returns RoleDepartmentListPaginatedRespDto
  */
 func (client *ManagementClient) ListRoleDepartments(reqDto *dto.ListRoleDepartmentsDto) *dto.RoleDepartmentListPaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/list-role-departments", fasthttp.MethodGet, reqDto)
-	var response dto.RoleDepartmentListPaginatedRespDto
+	response, err := client.ListRoleDepartmentsWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.RoleDepartmentListPaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ListRoleDepartmentsWithContext 同 ListRoleDepartments，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ListRoleDepartmentsWithContext(ctx context.Context, reqDto *dto.ListRoleDepartmentsDto) (*dto.RoleDepartmentListPaginatedRespDto, error) {
+	var response dto.RoleDepartmentListPaginatedRespDto
+	err := client.request(ctx, "/api/v3/list-role-departments", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1925,18 +2422,25 @@ func (client *ManagementClient) ListRoleDepartments(reqDto *dto.ListRoleDepartme
  * @returns RoleSingleRespDto
  */
 func (client *ManagementClient) CreateRole(reqDto *dto.CreateRoleDto) *dto.RoleSingleRespDto {
-	b, err := client.SendHttpRequest("/api/v3/create-role", fasthttp.MethodPost, reqDto)
-	var response dto.RoleSingleRespDto
+	response, err := client.CreateRoleWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.RoleSingleRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// CreateRoleWithContext 同 CreateRole，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) CreateRoleWithContext(ctx context.Context, reqDto *dto.CreateRoleDto) (*dto.RoleSingleRespDto, error) {
+	var response dto.RoleSingleRespDto
+	err := client.request(ctx, "/api/v3/create-role", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1949,18 +2453,25 @@ func (client *ManagementClient) CreateRole(reqDto *dto.CreateRoleDto) *dto.RoleS
  * @returns RolePaginatedRespDto
  */
 func (client *ManagementClient) ListRoles(reqDto *dto.ListRolesDto) *dto.RolePaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/list-roles", fasthttp.MethodGet, reqDto)
-	var response dto.RolePaginatedRespDto
+	response, err := client.ListRolesWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.RolePaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ListRolesWithContext 同 ListRoles，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ListRolesWithContext(ctx context.Context, reqDto *dto.ListRolesDto) (*dto.RolePaginatedRespDto, error) {
+	var response dto.RolePaginatedRespDto
+	err := client.request(ctx, "/api/v3/list-roles", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1970,18 +2481,25 @@ func (client *ManagementClient) ListRoles(reqDto *dto.ListRolesDto) *dto.RolePag
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) DeleteRolesBatch(reqDto *dto.DeleteRoleDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/delete-roles-batch", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.DeleteRolesBatchWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// DeleteRolesBatchWithContext 同 DeleteRolesBatch，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) DeleteRolesBatchWithContext(ctx context.Context, reqDto *dto.DeleteRoleDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/delete-roles-batch", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -1991,18 +2509,25 @@ func (client *ManagementClient) DeleteRolesBatch(reqDto *dto.DeleteRoleDto) *dto
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) CreateRolesBatch(reqDto *dto.CreateRolesBatch) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/create-roles-batch", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.CreateRolesBatchWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// CreateRolesBatchWithContext 同 CreateRolesBatch，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) CreateRolesBatchWithContext(ctx context.Context, reqDto *dto.CreateRolesBatch) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/create-roles-batch", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2012,18 +2537,25 @@ func (client *ManagementClient) CreateRolesBatch(reqDto *dto.CreateRolesBatch) *
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) UpdateRole(reqDto *dto.UpdateRoleDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/update-role", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.UpdateRoleWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// UpdateRoleWithContext 同 UpdateRole，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) UpdateRoleWithContext(ctx context.Context, reqDto *dto.UpdateRoleDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/update-role", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2033,18 +2565,25 @@ func (client *ManagementClient) UpdateRole(reqDto *dto.UpdateRoleDto) *dto.IsSuc
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) DeleteRoles(reqDto *dto.DeleteRoleBatchDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/multiple-namespace-delete-roles-batch", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.DeleteRolesWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// DeleteRolesWithContext 同 DeleteRoles，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) DeleteRolesWithContext(ctx context.Context, reqDto *dto.DeleteRoleBatchDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/multiple-namespace-delete-roles-batch", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2054,18 +2593,25 @@ func (client *ManagementClient) DeleteRoles(reqDto *dto.DeleteRoleBatchDto) *dto
  * @returns RoleCheckParamsRespDto
  */
 func (client *ManagementClient) CheckParamsNamespace(reqDto *dto.CheckRoleParamsDto) *dto.RoleCheckParamsRespDto {
-	b, err := client.SendHttpRequest("/api/v3/check-role-params", fasthttp.MethodPost, reqDto)
-	var response dto.RoleCheckParamsRespDto
+	response, err := client.CheckParamsNamespaceWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.RoleCheckParamsRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// CheckParamsNamespaceWithContext 同 CheckParamsNamespace，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) CheckParamsNamespaceWithContext(ctx context.Context, reqDto *dto.CheckRoleParamsDto) (*dto.RoleCheckParamsRespDto, error) {
+	var response dto.RoleCheckParamsRespDto
+	err := client.request(ctx, "/api/v3/check-role-params", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2075,18 +2621,25 @@ func (client *ManagementClient) CheckParamsNamespace(reqDto *dto.CheckRoleParams
  * @returns RoleListPageRespDto
  */
 func (client *ManagementClient) ListRoleAssignments(reqDto *dto.ListRoleAssignmentsDto) *dto.RoleListPageRespDto {
-	b, err := client.SendHttpRequest("/api/v3/list-role-assignments", fasthttp.MethodGet, reqDto)
-	var response dto.RoleListPageRespDto
+	response, err := client.ListRoleAssignmentsWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.RoleListPageRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ListRoleAssignmentsWithContext 同 ListRoleAssignments，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ListRoleAssignmentsWithContext(ctx context.Context, reqDto *dto.ListRoleAssignmentsDto) (*dto.RoleListPageRespDto, error) {
+	var response dto.RoleListPageRespDto
+	err := client.request(ctx, "/api/v3/list-role-assignments", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2097,18 +2650,25 @@ func (client *ManagementClient) ListRoleAssignments(reqDto *dto.ListRoleAssignme
  * @returns ExtIdpListPaginatedRespDto
  */
 func (client *ManagementClient) ListExtIdp(reqDto *dto.ListExtIdpDto) *dto.ExtIdpListPaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/list-ext-idp", fasthttp.MethodGet, reqDto)
-	var response dto.ExtIdpListPaginatedRespDto
+	response, err := client.ListExtIdpWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.ExtIdpListPaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ListExtIdpWithContext 同 ListExtIdp，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ListExtIdpWithContext(ctx context.Context, reqDto *dto.ListExtIdpDto) (*dto.ExtIdpListPaginatedRespDto, error) {
+	var response dto.ExtIdpListPaginatedRespDto
+	err := client.request(ctx, "/api/v3/list-ext-idp", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2121,18 +2681,25 @@ func (client *ManagementClient) ListExtIdp(reqDto *dto.ListExtIdpDto) *dto.ExtId
  * @returns ExtIdpDetailSingleRespDto
  */
 func (client *ManagementClient) GetExtIdp(reqDto *dto.GetExtIdpDto) *dto.ExtIdpDetailSingleRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-ext-idp", fasthttp.MethodGet, reqDto)
-	var response dto.ExtIdpDetailSingleRespDto
+	response, err := client.GetExtIdpWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.ExtIdpDetailSingleRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetExtIdpWithContext 同 GetExtIdp，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetExtIdpWithContext(ctx context.Context, reqDto *dto.GetExtIdpDto) (*dto.ExtIdpDetailSingleRespDto, error) {
+	var response dto.ExtIdpDetailSingleRespDto
+	err := client.request(ctx, "/api/v3/get-ext-idp", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2142,18 +2709,25 @@ func (client *ManagementClient) GetExtIdp(reqDto *dto.GetExtIdpDto) *dto.ExtIdpD
  * @returns ExtIdpSingleRespDto
  */
 func (client *ManagementClient) CreateExtIdp(reqDto *dto.CreateExtIdpDto) *dto.ExtIdpSingleRespDto {
-	b, err := client.SendHttpRequest("/api/v3/create-ext-idp", fasthttp.MethodPost, reqDto)
-	var response dto.ExtIdpSingleRespDto
+	response, err := client.CreateExtIdpWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.ExtIdpSingleRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// CreateExtIdpWithContext 同 CreateExtIdp，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) CreateExtIdpWithContext(ctx context.Context, reqDto *dto.CreateExtIdpDto) (*dto.ExtIdpSingleRespDto, error) {
+	var response dto.ExtIdpSingleRespDto
+	err := client.request(ctx, "/api/v3/create-ext-idp", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2163,18 +2737,25 @@ func (client *ManagementClient) CreateExtIdp(reqDto *dto.CreateExtIdpDto) *dto.E
  * @returns ExtIdpSingleRespDto
  */
 func (client *ManagementClient) UpdateExtIdp(reqDto *dto.UpdateExtIdpDto) *dto.ExtIdpSingleRespDto {
-	b, err := client.SendHttpRequest("/api/v3/update-ext-idp", fasthttp.MethodPost, reqDto)
-	var response dto.ExtIdpSingleRespDto
+	response, err := client.UpdateExtIdpWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.ExtIdpSingleRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// UpdateExtIdpWithContext 同 UpdateExtIdp，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) UpdateExtIdpWithContext(ctx context.Context, reqDto *dto.UpdateExtIdpDto) (*dto.ExtIdpSingleRespDto, error) {
+	var response dto.ExtIdpSingleRespDto
+	err := client.request(ctx, "/api/v3/update-ext-idp", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2184,18 +2765,25 @@ func (client *ManagementClient) UpdateExtIdp(reqDto *dto.UpdateExtIdpDto) *dto.E
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) DeleteExtIdp(reqDto *dto.DeleteExtIdpDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/delete-ext-idp", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.DeleteExtIdpWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// DeleteExtIdpWithContext 同 DeleteExtIdp，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) DeleteExtIdpWithContext(ctx context.Context, reqDto *dto.DeleteExtIdpDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/delete-ext-idp", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2205,18 +2793,25 @@ func (client *ManagementClient) DeleteExtIdp(reqDto *dto.DeleteExtIdpDto) *dto.I
  * @returns ExtIdpConnDetailSingleRespDto
  */
 func (client *ManagementClient) CreateExtIdpConn(reqDto *dto.CreateExtIdpConnDto) *dto.ExtIdpConnDetailSingleRespDto {
-	b, err := client.SendHttpRequest("/api/v3/create-ext-idp-conn", fasthttp.MethodPost, reqDto)
-	var response dto.ExtIdpConnDetailSingleRespDto
+	response, err := client.CreateExtIdpConnWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.ExtIdpConnDetailSingleRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// CreateExtIdpConnWithContext 同 CreateExtIdpConn，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) CreateExtIdpConnWithContext(ctx context.Context, reqDto *dto.CreateExtIdpConnDto) (*dto.ExtIdpConnDetailSingleRespDto, error) {
+	var response dto.ExtIdpConnDetailSingleRespDto
+	err := client.request(ctx, "/api/v3/create-ext-idp-conn", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2226,18 +2821,25 @@ func (client *ManagementClient) CreateExtIdpConn(reqDto *dto.CreateExtIdpConnDto
  * @returns ExtIdpConnDetailSingleRespDto
  */
 func (client *ManagementClient) UpdateExtIdpConn(reqDto *dto.UpdateExtIdpConnDto) *dto.ExtIdpConnDetailSingleRespDto {
-	b, err := client.SendHttpRequest("/api/v3/update-ext-idp-conn", fasthttp.MethodPost, reqDto)
-	var response dto.ExtIdpConnDetailSingleRespDto
+	response, err := client.UpdateExtIdpConnWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.ExtIdpConnDetailSingleRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// UpdateExtIdpConnWithContext 同 UpdateExtIdpConn，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) UpdateExtIdpConnWithContext(ctx context.Context, reqDto *dto.UpdateExtIdpConnDto) (*dto.ExtIdpConnDetailSingleRespDto, error) {
+	var response dto.ExtIdpConnDetailSingleRespDto
+	err := client.request(ctx, "/api/v3/update-ext-idp-conn", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2247,18 +2849,25 @@ func (client *ManagementClient) UpdateExtIdpConn(reqDto *dto.UpdateExtIdpConnDto
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) DeleteExtIdpConn(reqDto *dto.DeleteExtIdpConnDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/delete-ext-idp-conn", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.DeleteExtIdpConnWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// DeleteExtIdpConnWithContext 同 DeleteExtIdpConn，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) DeleteExtIdpConnWithContext(ctx context.Context, reqDto *dto.DeleteExtIdpConnDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/delete-ext-idp-conn", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2268,18 +2877,25 @@ func (client *ManagementClient) DeleteExtIdpConn(reqDto *dto.DeleteExtIdpConnDto
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) ChangeExtIdpConnState(reqDto *dto.ChangeExtIdpConnStateDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/change-ext-idp-conn-state", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.ChangeExtIdpConnStateWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ChangeExtIdpConnStateWithContext 同 ChangeExtIdpConnState，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ChangeExtIdpConnStateWithContext(ctx context.Context, reqDto *dto.ChangeExtIdpConnStateDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/change-ext-idp-conn-state", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2289,18 +2905,25 @@ func (client *ManagementClient) ChangeExtIdpConnState(reqDto *dto.ChangeExtIdpCo
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) ChangeExtIdpConnAssociationState(reqDto *dto.ChangeExtIdpAssociationStateDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/change-ext-idp-conn-association-state", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.ChangeExtIdpConnAssociationStateWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ChangeExtIdpConnAssociationStateWithContext 同 ChangeExtIdpConnAssociationState，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ChangeExtIdpConnAssociationStateWithContext(ctx context.Context, reqDto *dto.ChangeExtIdpAssociationStateDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/change-ext-idp-conn-association-state", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2314,18 +2937,25 @@ func (client *ManagementClient) ChangeExtIdpConnAssociationState(reqDto *dto.Cha
  * @returns ExtIdpListPaginatedRespDto
  */
 func (client *ManagementClient) ListTenantExtIdp(reqDto *dto.ListTenantExtIdpDto) *dto.ExtIdpListPaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/list-tenant-ext-idp", fasthttp.MethodGet, reqDto)
-	var response dto.ExtIdpListPaginatedRespDto
+	response, err := client.ListTenantExtIdpWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.ExtIdpListPaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ListTenantExtIdpWithContext 同 ListTenantExtIdp，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ListTenantExtIdpWithContext(ctx context.Context, reqDto *dto.ListTenantExtIdpDto) (*dto.ExtIdpListPaginatedRespDto, error) {
+	var response dto.ExtIdpListPaginatedRespDto
+	err := client.request(ctx, "/api/v3/list-tenant-ext-idp", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2338,18 +2968,25 @@ func (client *ManagementClient) ListTenantExtIdp(reqDto *dto.ListTenantExtIdpDto
  * @returns ExtIdpListPaginatedRespDto
  */
 func (client *ManagementClient) ExtIdpConnStateByApps(reqDto *dto.ExtIdpConnAppsDto) *dto.ExtIdpListPaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/ext-idp-conn-apps", fasthttp.MethodGet, reqDto)
-	var response dto.ExtIdpListPaginatedRespDto
+	response, err := client.ExtIdpConnStateByAppsWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.ExtIdpListPaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ExtIdpConnStateByAppsWithContext 同 ExtIdpConnStateByApps，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ExtIdpConnStateByAppsWithContext(ctx context.Context, reqDto *dto.ExtIdpConnAppsDto) (*dto.ExtIdpListPaginatedRespDto, error) {
+	var response dto.ExtIdpListPaginatedRespDto
+	err := client.request(ctx, "/api/v3/ext-idp-conn-apps", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2358,18 +2995,25 @@ func (client *ManagementClient) ExtIdpConnStateByApps(reqDto *dto.ExtIdpConnApps
  * @returns CustomFieldListRespDto
  */
 func (client *ManagementClient) GetUserBaseFields() *dto.CustomFieldListRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-user-base-fields", fasthttp.MethodGet, nil)
-	var response dto.CustomFieldListRespDto
+	response, err := client.GetUserBaseFieldsWithContext(context.Background())
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CustomFieldListRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetUserBaseFieldsWithContext 同 GetUserBaseFields，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetUserBaseFieldsWithContext(ctx context.Context) (*dto.CustomFieldListRespDto, error) {
+	var response dto.CustomFieldListRespDto
+	err := client.request(ctx, "/api/v3/get-user-base-fields", fasthttp.MethodGet, nil, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2379,18 +3023,25 @@ func (client *ManagementClient) GetUserBaseFields() *dto.CustomFieldListRespDto
  * @returns CustomFieldListRespDto
  */
 func (client *ManagementClient) SetUserBaseFields(reqDto *dto.SetUserBaseFieldsReqDto) *dto.CustomFieldListRespDto {
-	b, err := client.SendHttpRequest("/api/v3/set-user-base-fields", fasthttp.MethodPost, reqDto)
-	var response dto.CustomFieldListRespDto
+	response, err := client.SetUserBaseFieldsWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CustomFieldListRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// SetUserBaseFieldsWithContext 同 SetUserBaseFields，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) SetUserBaseFieldsWithContext(ctx context.Context, reqDto *dto.SetUserBaseFieldsReqDto) (*dto.CustomFieldListRespDto, error) {
+	var response dto.CustomFieldListRespDto
+	err := client.request(ctx, "/api/v3/set-user-base-fields", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2405,18 +3056,25 @@ func (client *ManagementClient) SetUserBaseFields(reqDto *dto.SetUserBaseFieldsR
  * @returns CustomFieldListRespDto
  */
 func (client *ManagementClient) GetCustomFields(reqDto *dto.GetCustomFieldsDto) *dto.CustomFieldListRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-custom-fields", fasthttp.MethodGet, reqDto)
-	var response dto.CustomFieldListRespDto
+	response, err := client.GetCustomFieldsWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CustomFieldListRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetCustomFieldsWithContext 同 GetCustomFields，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetCustomFieldsWithContext(ctx context.Context, reqDto *dto.GetCustomFieldsDto) (*dto.CustomFieldListRespDto, error) {
+	var response dto.CustomFieldListRespDto
+	err := client.request(ctx, "/api/v3/get-custom-fields", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2426,18 +3084,25 @@ func (client *ManagementClient) GetCustomFields(reqDto *dto.GetCustomFieldsDto)
  * @returns CustomFieldListRespDto
  */
 func (client *ManagementClient) SetCustomFields(reqDto *dto.SetCustomFieldsReqDto) *dto.CustomFieldListRespDto {
-	b, err := client.SendHttpRequest("/api/v3/set-custom-fields", fasthttp.MethodPost, reqDto)
-	var response dto.CustomFieldListRespDto
+	response, err := client.SetCustomFieldsWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CustomFieldListRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// SetCustomFieldsWithContext 同 SetCustomFields，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) SetCustomFieldsWithContext(ctx context.Context, reqDto *dto.SetCustomFieldsReqDto) (*dto.CustomFieldListRespDto, error) {
+	var response dto.CustomFieldListRespDto
+	err := client.request(ctx, "/api/v3/set-custom-fields", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2447,18 +3112,25 @@ func (client *ManagementClient) SetCustomFields(reqDto *dto.SetCustomFieldsReqDt
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) SetCustomData(reqDto *dto.SetCustomDataReqDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/set-custom-data", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.SetCustomDataWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// SetCustomDataWithContext 同 SetCustomData，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) SetCustomDataWithContext(ctx context.Context, reqDto *dto.SetCustomDataReqDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/set-custom-data", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2480,18 +3152,25 @@ func (client *ManagementClient) SetCustomData(reqDto *dto.SetCustomDataReqDto) *
  * @returns GetCustomDataRespDto
  */
 func (client *ManagementClient) GetCustomData(reqDto *dto.GetCustomDataDto) *dto.GetCustomDataRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-custom-data", fasthttp.MethodGet, reqDto)
-	var response dto.GetCustomDataRespDto
+	response, err := client.GetCustomDataWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.GetCustomDataRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetCustomDataWithContext 同 GetCustomData，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetCustomDataWithContext(ctx context.Context, reqDto *dto.GetCustomDataDto) (*dto.GetCustomDataRespDto, error) {
+	var response dto.GetCustomDataRespDto
+	err := client.request(ctx, "/api/v3/get-custom-data", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2501,18 +3180,25 @@ func (client *ManagementClient) GetCustomData(reqDto *dto.GetCustomDataDto) *dto
  * @returns ResourceRespDto
  */
 func (client *ManagementClient) CreateResource(reqDto *dto.CreateResourceDto) *dto.ResourceRespDto {
-	b, err := client.SendHttpRequest("/api/v3/create-resource", fasthttp.MethodPost, reqDto)
-	var response dto.ResourceRespDto
+	response, err := client.CreateResourceWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.ResourceRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// CreateResourceWithContext 同 CreateResource，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) CreateResourceWithContext(ctx context.Context, reqDto *dto.CreateResourceDto) (*dto.ResourceRespDto, error) {
+	var response dto.ResourceRespDto
+	err := client.request(ctx, "/api/v3/create-resource", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2522,18 +3208,25 @@ func (client *ManagementClient) CreateResource(reqDto *dto.CreateResourceDto) *d
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) CreateResourcesBatch(reqDto *dto.CreateResourcesBatchDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/create-resources-batch", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.CreateResourcesBatchWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// CreateResourcesBatchWithContext 同 CreateResourcesBatch，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) CreateResourcesBatchWithContext(ctx context.Context, reqDto *dto.CreateResourcesBatchDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/create-resources-batch", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2544,18 +3237,25 @@ func (client *ManagementClient) CreateResourcesBatch(reqDto *dto.CreateResources
  * @returns ResourceRespDto
  */
 func (client *ManagementClient) GetResource(reqDto *dto.GetResourceDto) *dto.ResourceRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-resource", fasthttp.MethodGet, reqDto)
-	var response dto.ResourceRespDto
+	response, err := client.GetResourceWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.ResourceRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetResourceWithContext 同 GetResource，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetResourceWithContext(ctx context.Context, reqDto *dto.GetResourceDto) (*dto.ResourceRespDto, error) {
+	var response dto.ResourceRespDto
+	err := client.request(ctx, "/api/v3/get-resource", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2566,18 +3266,25 @@ func (client *ManagementClient) GetResource(reqDto *dto.GetResourceDto) *dto.Res
  * @returns ResourceListRespDto
  */
 func (client *ManagementClient) GetResourcesBatch(reqDto *dto.GetResourcesBatchDto) *dto.ResourceListRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-resources-batch", fasthttp.MethodGet, reqDto)
-	var response dto.ResourceListRespDto
+	response, err := client.GetResourcesBatchWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.ResourceListRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetResourcesBatchWithContext 同 GetResourcesBatch，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetResourcesBatchWithContext(ctx context.Context, reqDto *dto.GetResourcesBatchDto) (*dto.ResourceListRespDto, error) {
+	var response dto.ResourceListRespDto
+	err := client.request(ctx, "/api/v3/get-resources-batch", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2590,18 +3297,25 @@ func (client *ManagementClient) GetResourcesBatch(reqDto *dto.GetResourcesBatchD
  * @returns CommonResourcePaginatedRespDto
  */
 func (client *ManagementClient) ListCommonResource(reqDto *dto.ListCommonResourceDto) *dto.CommonResourcePaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/list-common-resource", fasthttp.MethodGet, reqDto)
-	var response dto.CommonResourcePaginatedRespDto
+	response, err := client.ListCommonResourceWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CommonResourcePaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ListCommonResourceWithContext 同 ListCommonResource，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ListCommonResourceWithContext(ctx context.Context, reqDto *dto.ListCommonResourceDto) (*dto.CommonResourcePaginatedRespDto, error) {
+	var response dto.CommonResourcePaginatedRespDto
+	err := client.request(ctx, "/api/v3/list-common-resource", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2614,18 +3328,25 @@ func (client *ManagementClient) ListCommonResource(reqDto *dto.ListCommonResourc
  * @returns ResourcePaginatedRespDto
  */
 func (client *ManagementClient) ListResources(reqDto *dto.ListResourcesDto) *dto.ResourcePaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/list-resources", fasthttp.MethodGet, reqDto)
-	var response dto.ResourcePaginatedRespDto
+	response, err := client.ListResourcesWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.ResourcePaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ListResourcesWithContext 同 ListResources，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ListResourcesWithContext(ctx context.Context, reqDto *dto.ListResourcesDto) (*dto.ResourcePaginatedRespDto, error) {
+	var response dto.ResourcePaginatedRespDto
+	err := client.request(ctx, "/api/v3/list-resources", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2635,18 +3356,25 @@ func (client *ManagementClient) ListResources(reqDto *dto.ListResourcesDto) *dto
  * @returns ResourceRespDto
  */
 func (client *ManagementClient) UpdateResource(reqDto *dto.UpdateResourceDto) *dto.ResourceRespDto {
-	b, err := client.SendHttpRequest("/api/v3/update-resource", fasthttp.MethodPost, reqDto)
-	var response dto.ResourceRespDto
+	response, err := client.UpdateResourceWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.ResourceRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// UpdateResourceWithContext 同 UpdateResource，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) UpdateResourceWithContext(ctx context.Context, reqDto *dto.UpdateResourceDto) (*dto.ResourceRespDto, error) {
+	var response dto.ResourceRespDto
+	err := client.request(ctx, "/api/v3/update-resource", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2656,18 +3384,25 @@ func (client *ManagementClient) UpdateResource(reqDto *dto.UpdateResourceDto) *d
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) DeleteResource(reqDto *dto.DeleteResourceDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/delete-resource", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.DeleteResourceWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// DeleteResourceWithContext 同 DeleteResource，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) DeleteResourceWithContext(ctx context.Context, reqDto *dto.DeleteResourceDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/delete-resource", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2677,18 +3412,25 @@ func (client *ManagementClient) DeleteResource(reqDto *dto.DeleteResourceDto) *d
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) DeleteResourcesBatch(reqDto *dto.DeleteResourcesBatchDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/delete-resources-batch", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.DeleteResourcesBatchWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// DeleteResourcesBatchWithContext 同 DeleteResourcesBatch，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) DeleteResourcesBatchWithContext(ctx context.Context, reqDto *dto.DeleteResourcesBatchDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/delete-resources-batch", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2699,18 +3441,25 @@ func (client *ManagementClient) DeleteResourcesBatch(reqDto *dto.DeleteResources
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) DeleteResourcesByIdBatch(reqDto *dto.DeleteCommonResourcesBatchDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/delete-common-resources-batch", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.DeleteResourcesByIdBatchWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// DeleteResourcesByIdBatchWithContext 同 DeleteResourcesByIdBatch，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) DeleteResourcesByIdBatchWithContext(ctx context.Context, reqDto *dto.DeleteCommonResourcesBatchDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/delete-common-resources-batch", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2720,18 +3469,25 @@ func (client *ManagementClient) DeleteResourcesByIdBatch(reqDto *dto.DeleteCommo
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) AssociateTenantResource(reqDto *dto.AssociateTenantResourceDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/associate-tenant-resource", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.AssociateTenantResourceWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// AssociateTenantResourceWithContext 同 AssociateTenantResource，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) AssociateTenantResourceWithContext(ctx context.Context, reqDto *dto.AssociateTenantResourceDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/associate-tenant-resource", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2741,18 +3497,25 @@ func (client *ManagementClient) AssociateTenantResource(reqDto *dto.AssociateTen
  * @returns NamespaceRespDto
  */
 func (client *ManagementClient) CreateNamespace(reqDto *dto.CreateNamespaceDto) *dto.NamespaceRespDto {
-	b, err := client.SendHttpRequest("/api/v3/create-namespace", fasthttp.MethodPost, reqDto)
-	var response dto.NamespaceRespDto
+	response, err := client.CreateNamespaceWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.NamespaceRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// CreateNamespaceWithContext 同 CreateNamespace，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) CreateNamespaceWithContext(ctx context.Context, reqDto *dto.CreateNamespaceDto) (*dto.NamespaceRespDto, error) {
+	var response dto.NamespaceRespDto
+	err := client.request(ctx, "/api/v3/create-namespace", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2762,18 +3525,25 @@ func (client *ManagementClient) CreateNamespace(reqDto *dto.CreateNamespaceDto)
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) CreateNamespacesBatch(reqDto *dto.CreateNamespacesBatchDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/create-namespaces-batch", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.CreateNamespacesBatchWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// CreateNamespacesBatchWithContext 同 CreateNamespacesBatch，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) CreateNamespacesBatchWithContext(ctx context.Context, reqDto *dto.CreateNamespacesBatchDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/create-namespaces-batch", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2783,18 +3553,25 @@ func (client *ManagementClient) CreateNamespacesBatch(reqDto *dto.CreateNamespac
  * @returns NamespaceRespDto
  */
 func (client *ManagementClient) GetNamespace(reqDto *dto.GetNamespaceDto) *dto.NamespaceRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-namespace", fasthttp.MethodGet, reqDto)
-	var response dto.NamespaceRespDto
+	response, err := client.GetNamespaceWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.NamespaceRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetNamespaceWithContext 同 GetNamespace，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetNamespaceWithContext(ctx context.Context, reqDto *dto.GetNamespaceDto) (*dto.NamespaceRespDto, error) {
+	var response dto.NamespaceRespDto
+	err := client.request(ctx, "/api/v3/get-namespace", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2804,18 +3581,25 @@ func (client *ManagementClient) GetNamespace(reqDto *dto.GetNamespaceDto) *dto.N
  * @returns NamespaceListRespDto
  */
 func (client *ManagementClient) GetNamespacesBatch(reqDto *dto.GetNamespacesBatchDto) *dto.NamespaceListRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-namespaces-batch", fasthttp.MethodGet, reqDto)
-	var response dto.NamespaceListRespDto
+	response, err := client.GetNamespacesBatchWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.NamespaceListRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetNamespacesBatchWithContext 同 GetNamespacesBatch，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetNamespacesBatchWithContext(ctx context.Context, reqDto *dto.GetNamespacesBatchDto) (*dto.NamespaceListRespDto, error) {
+	var response dto.NamespaceListRespDto
+	err := client.request(ctx, "/api/v3/get-namespaces-batch", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2825,18 +3609,25 @@ func (client *ManagementClient) GetNamespacesBatch(reqDto *dto.GetNamespacesBatc
  * @returns UpdateNamespaceRespDto
  */
 func (client *ManagementClient) UpdateNamespace(reqDto *dto.UpdateNamespaceDto) *dto.UpdateNamespaceRespDto {
-	b, err := client.SendHttpRequest("/api/v3/update-namespace", fasthttp.MethodPost, reqDto)
-	var response dto.UpdateNamespaceRespDto
+	response, err := client.UpdateNamespaceWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.UpdateNamespaceRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// UpdateNamespaceWithContext 同 UpdateNamespace，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) UpdateNamespaceWithContext(ctx context.Context, reqDto *dto.UpdateNamespaceDto) (*dto.UpdateNamespaceRespDto, error) {
+	var response dto.UpdateNamespaceRespDto
+	err := client.request(ctx, "/api/v3/update-namespace", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2846,18 +3637,25 @@ func (client *ManagementClient) UpdateNamespace(reqDto *dto.UpdateNamespaceDto)
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) DeleteNamespace(reqDto *dto.DeleteNamespaceDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/delete-namespace", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.DeleteNamespaceWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// DeleteNamespaceWithContext 同 DeleteNamespace，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) DeleteNamespaceWithContext(ctx context.Context, reqDto *dto.DeleteNamespaceDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/delete-namespace", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2867,18 +3665,25 @@ func (client *ManagementClient) DeleteNamespace(reqDto *dto.DeleteNamespaceDto)
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) DeleteNamespacesBatch(reqDto *dto.DeleteNamespacesBatchDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/delete-namespaces-batch", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.DeleteNamespacesBatchWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// DeleteNamespacesBatchWithContext 同 DeleteNamespacesBatch，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) DeleteNamespacesBatchWithContext(ctx context.Context, reqDto *dto.DeleteNamespacesBatchDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/delete-namespaces-batch", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2890,18 +3695,25 @@ func (client *ManagementClient) DeleteNamespacesBatch(reqDto *dto.DeleteNamespac
  * @returns NamespaceListPaginatedRespDto
  */
 func (client *ManagementClient) ListNamespaces(reqDto *dto.ListNamespacesDto) *dto.NamespaceListPaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/list-namespaces", fasthttp.MethodGet, reqDto)
-	var response dto.NamespaceListPaginatedRespDto
+	response, err := client.ListNamespacesWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.NamespaceListPaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ListNamespacesWithContext 同 ListNamespaces，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ListNamespacesWithContext(ctx context.Context, reqDto *dto.ListNamespacesDto) (*dto.NamespaceListPaginatedRespDto, error) {
+	var response dto.NamespaceListPaginatedRespDto
+	err := client.request(ctx, "/api/v3/list-namespaces", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2914,18 +3726,25 @@ func (client *ManagementClient) ListNamespaces(reqDto *dto.ListNamespacesDto) *d
  * @returns NamespaceRolesListPaginatedRespDto
  */
 func (client *ManagementClient) ListNamespaceRoles(reqDto *dto.ListNamespaceRolesDto) *dto.NamespaceRolesListPaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/list-namespace-roles", fasthttp.MethodGet, reqDto)
-	var response dto.NamespaceRolesListPaginatedRespDto
+	response, err := client.ListNamespaceRolesWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.NamespaceRolesListPaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ListNamespaceRolesWithContext 同 ListNamespaceRoles，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ListNamespaceRolesWithContext(ctx context.Context, reqDto *dto.ListNamespaceRolesDto) (*dto.NamespaceRolesListPaginatedRespDto, error) {
+	var response dto.NamespaceRolesListPaginatedRespDto
+	err := client.request(ctx, "/api/v3/list-namespace-roles", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2935,18 +3754,25 @@ func (client *ManagementClient) ListNamespaceRoles(reqDto *dto.ListNamespaceRole
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) AuthorizeResources(reqDto *dto.AuthorizeResourcesDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/authorize-resources", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.AuthorizeResourcesWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// AuthorizeResourcesWithContext 同 AuthorizeResources，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) AuthorizeResourcesWithContext(ctx context.Context, reqDto *dto.AuthorizeResourcesDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/authorize-resources", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2971,18 +3797,25 @@ func (client *ManagementClient) AuthorizeResources(reqDto *dto.AuthorizeResource
  * @returns AuthorizedResourcePaginatedRespDto
  */
 func (client *ManagementClient) GetAuthorizedResources(reqDto *dto.GetAuthorizedResourcesDto) *dto.AuthorizedResourcePaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-authorized-resources", fasthttp.MethodGet, reqDto)
-	var response dto.AuthorizedResourcePaginatedRespDto
+	response, err := client.GetAuthorizedResourcesWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.AuthorizedResourcePaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetAuthorizedResourcesWithContext 同 GetAuthorizedResources，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetAuthorizedResourcesWithContext(ctx context.Context, reqDto *dto.GetAuthorizedResourcesDto) (*dto.AuthorizedResourcePaginatedRespDto, error) {
+	var response dto.AuthorizedResourcePaginatedRespDto
+	err := client.request(ctx, "/api/v3/get-authorized-resources", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -2992,18 +3825,25 @@ func (client *ManagementClient) GetAuthorizedResources(reqDto *dto.GetAuthorized
  * @returns IsActionAllowedRespDtp
  */
 func (client *ManagementClient) IsActionAllowed(reqDto *dto.IsActionAllowedDto) *dto.IsActionAllowedRespDtp {
-	b, err := client.SendHttpRequest("/api/v3/is-action-allowed", fasthttp.MethodPost, reqDto)
-	var response dto.IsActionAllowedRespDtp
+	response, err := client.IsActionAllowedWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsActionAllowedRespDtp{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// IsActionAllowedWithContext 同 IsActionAllowed，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) IsActionAllowedWithContext(ctx context.Context, reqDto *dto.IsActionAllowedDto) (*dto.IsActionAllowedRespDtp, error) {
+	var response dto.IsActionAllowedRespDtp
+	err := client.request(ctx, "/api/v3/is-action-allowed", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -3013,18 +3853,25 @@ func (client *ManagementClient) IsActionAllowed(reqDto *dto.IsActionAllowedDto)
  * @returns GetResourceAuthorizedTargetRespDto
  */
 func (client *ManagementClient) GetResourceAuthorizedTargets(reqDto *dto.GetResourceAuthorizedTargetsDto) *dto.GetResourceAuthorizedTargetRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-resource-authorized-targets", fasthttp.MethodPost, reqDto)
-	var response dto.GetResourceAuthorizedTargetRespDto
+	response, err := client.GetResourceAuthorizedTargetsWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.GetResourceAuthorizedTargetRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetResourceAuthorizedTargetsWithContext 同 GetResourceAuthorizedTargets，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetResourceAuthorizedTargetsWithContext(ctx context.Context, reqDto *dto.GetResourceAuthorizedTargetsDto) (*dto.GetResourceAuthorizedTargetRespDto, error) {
+	var response dto.GetResourceAuthorizedTargetRespDto
+	err := client.request(ctx, "/api/v3/get-resource-authorized-targets", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -3034,18 +3881,25 @@ func (client *ManagementClient) GetResourceAuthorizedTargets(reqDto *dto.GetReso
  * @returns SyncTaskSingleRespDto
  */
 func (client *ManagementClient) GetSyncTask(reqDto *dto.GetSyncTaskDto) *dto.SyncTaskSingleRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-sync-task", fasthttp.MethodGet, reqDto)
-	var response dto.SyncTaskSingleRespDto
+	response, err := client.GetSyncTaskWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.SyncTaskSingleRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetSyncTaskWithContext 同 GetSyncTask，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetSyncTaskWithContext(ctx context.Context, reqDto *dto.GetSyncTaskDto) (*dto.SyncTaskSingleRespDto, error) {
+	var response dto.SyncTaskSingleRespDto
+	err := client.request(ctx, "/api/v3/get-sync-task", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -3056,18 +3910,25 @@ func (client *ManagementClient) GetSyncTask(reqDto *dto.GetSyncTaskDto) *dto.Syn
  * @returns SyncTaskPaginatedRespDto
  */
 func (client *ManagementClient) ListSyncTasks(reqDto *dto.ListSyncTasksDto) *dto.SyncTaskPaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/list-sync-tasks", fasthttp.MethodGet, reqDto)
-	var response dto.SyncTaskPaginatedRespDto
+	response, err := client.ListSyncTasksWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.SyncTaskPaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ListSyncTasksWithContext 同 ListSyncTasks，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ListSyncTasksWithContext(ctx context.Context, reqDto *dto.ListSyncTasksDto) (*dto.SyncTaskPaginatedRespDto, error) {
+	var response dto.SyncTaskPaginatedRespDto
+	err := client.request(ctx, "/api/v3/list-sync-tasks", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -3077,18 +3938,25 @@ func (client *ManagementClient) ListSyncTasks(reqDto *dto.ListSyncTasksDto) *dto
  * @returns SyncTaskPaginatedRespDto
  */
 func (client *ManagementClient) CreateSyncTask(reqDto *dto.CreateSyncTaskDto) *dto.SyncTaskPaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/create-sync-task", fasthttp.MethodPost, reqDto)
-	var response dto.SyncTaskPaginatedRespDto
+	response, err := client.CreateSyncTaskWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.SyncTaskPaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// CreateSyncTaskWithContext 同 CreateSyncTask，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) CreateSyncTaskWithContext(ctx context.Context, reqDto *dto.CreateSyncTaskDto) (*dto.SyncTaskPaginatedRespDto, error) {
+	var response dto.SyncTaskPaginatedRespDto
+	err := client.request(ctx, "/api/v3/create-sync-task", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -3098,18 +3966,25 @@ func (client *ManagementClient) CreateSyncTask(reqDto *dto.CreateSyncTaskDto) *d
  * @returns SyncTaskPaginatedRespDto
  */
 func (client *ManagementClient) UpdateSyncTask(reqDto *dto.UpdateSyncTaskDto) *dto.SyncTaskPaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/update-sync-task", fasthttp.MethodPost, reqDto)
-	var response dto.SyncTaskPaginatedRespDto
+	response, err := client.UpdateSyncTaskWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.SyncTaskPaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// UpdateSyncTaskWithContext 同 UpdateSyncTask，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) UpdateSyncTaskWithContext(ctx context.Context, reqDto *dto.UpdateSyncTaskDto) (*dto.SyncTaskPaginatedRespDto, error) {
+	var response dto.SyncTaskPaginatedRespDto
+	err := client.request(ctx, "/api/v3/update-sync-task", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -3119,18 +3994,25 @@ func (client *ManagementClient) UpdateSyncTask(reqDto *dto.UpdateSyncTaskDto) *d
  * @returns TriggerSyncTaskRespDto
  */
 func (client *ManagementClient) TriggerSyncTask(reqDto *dto.TriggerSyncTaskDto) *dto.TriggerSyncTaskRespDto {
-	b, err := client.SendHttpRequest("/api/v3/trigger-sync-task", fasthttp.MethodPost, reqDto)
-	var response dto.TriggerSyncTaskRespDto
+	response, err := client.TriggerSyncTaskWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.TriggerSyncTaskRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// TriggerSyncTaskWithContext 同 TriggerSyncTask，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) TriggerSyncTaskWithContext(ctx context.Context, reqDto *dto.TriggerSyncTaskDto) (*dto.TriggerSyncTaskRespDto, error) {
+	var response dto.TriggerSyncTaskRespDto
+	err := client.request(ctx, "/api/v3/trigger-sync-task", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -3140,18 +4022,25 @@ func (client *ManagementClient) TriggerSyncTask(reqDto *dto.TriggerSyncTaskDto)
  * @returns SyncJobSingleRespDto
  */
 func (client *ManagementClient) GetSyncJob(reqDto *dto.GetSyncJobDto) *dto.SyncJobSingleRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-sync-job", fasthttp.MethodGet, reqDto)
-	var response dto.SyncJobSingleRespDto
+	response, err := client.GetSyncJobWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.SyncJobSingleRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetSyncJobWithContext 同 GetSyncJob，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetSyncJobWithContext(ctx context.Context, reqDto *dto.GetSyncJobDto) (*dto.SyncJobSingleRespDto, error) {
+	var response dto.SyncJobSingleRespDto
+	err := client.request(ctx, "/api/v3/get-sync-job", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -3168,18 +4057,25 @@ func (client *ManagementClient) GetSyncJob(reqDto *dto.GetSyncJobDto) *dto.SyncJ
  * @returns SyncJobPaginatedRespDto
  */
 func (client *ManagementClient) ListSyncJobs(reqDto *dto.ListSyncJobsDto) *dto.SyncJobPaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/list-sync-jobs", fasthttp.MethodGet, reqDto)
-	var response dto.SyncJobPaginatedRespDto
+	response, err := client.ListSyncJobsWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.SyncJobPaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ListSyncJobsWithContext 同 ListSyncJobs，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ListSyncJobsWithContext(ctx context.Context, reqDto *dto.ListSyncJobsDto) (*dto.SyncJobPaginatedRespDto, error) {
+	var response dto.SyncJobPaginatedRespDto
+	err := client.request(ctx, "/api/v3/list-sync-jobs", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -3212,18 +4108,25 @@ func (client *ManagementClient) ListSyncJobs(reqDto *dto.ListSyncJobsDto) *dto.S
  * @returns TriggerSyncTaskRespDto
  */
 func (client *ManagementClient) ListSyncJobLogs(reqDto *dto.ListSyncJobLogsDto) *dto.TriggerSyncTaskRespDto {
-	b, err := client.SendHttpRequest("/api/v3/list-sync-job-logs", fasthttp.MethodGet, reqDto)
-	var response dto.TriggerSyncTaskRespDto
+	response, err := client.ListSyncJobLogsWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.TriggerSyncTaskRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ListSyncJobLogsWithContext 同 ListSyncJobLogs，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ListSyncJobLogsWithContext(ctx context.Context, reqDto *dto.ListSyncJobLogsDto) (*dto.TriggerSyncTaskRespDto, error) {
+	var response dto.TriggerSyncTaskRespDto
+	err := client.request(ctx, "/api/v3/list-sync-job-logs", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -3240,18 +4143,25 @@ func (client *ManagementClient) ListSyncJobLogs(reqDto *dto.ListSyncJobLogsDto)
  * @returns SyncRiskOperationPaginatedRespDto
  */
 func (client *ManagementClient) ListSyncRiskOperations(reqDto *dto.ListSyncRiskOperationsDto) *dto.SyncRiskOperationPaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/list-sync-risk-operations", fasthttp.MethodGet, reqDto)
-	var response dto.SyncRiskOperationPaginatedRespDto
+	response, err := client.ListSyncRiskOperationsWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.SyncRiskOperationPaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ListSyncRiskOperationsWithContext 同 ListSyncRiskOperations，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ListSyncRiskOperationsWithContext(ctx context.Context, reqDto *dto.ListSyncRiskOperationsDto) (*dto.SyncRiskOperationPaginatedRespDto, error) {
+	var response dto.SyncRiskOperationPaginatedRespDto
+	err := client.request(ctx, "/api/v3/list-sync-risk-operations", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -3261,18 +4171,25 @@ func (client *ManagementClient) ListSyncRiskOperations(reqDto *dto.ListSyncRiskO
  * @returns TriggerSyncRiskOperationsRespDto
  */
 func (client *ManagementClient) TriggerSyncRiskOperations(reqDto *dto.TriggerSyncRiskOperationDto) *dto.TriggerSyncRiskOperationsRespDto {
-	b, err := client.SendHttpRequest("/api/v3/trigger-sync-risk-operations", fasthttp.MethodPost, reqDto)
-	var response dto.TriggerSyncRiskOperationsRespDto
+	response, err := client.TriggerSyncRiskOperationsWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.TriggerSyncRiskOperationsRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// TriggerSyncRiskOperationsWithContext 同 TriggerSyncRiskOperations，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) TriggerSyncRiskOperationsWithContext(ctx context.Context, reqDto *dto.TriggerSyncRiskOperationDto) (*dto.TriggerSyncRiskOperationsRespDto, error) {
+	var response dto.TriggerSyncRiskOperationsRespDto
+	err := client.request(ctx, "/api/v3/trigger-sync-risk-operations", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -3282,18 +4199,25 @@ func (client *ManagementClient) TriggerSyncRiskOperations(reqDto *dto.TriggerSyn
  * @returns CancelSyncRiskOperationsRespDto
  */
 func (client *ManagementClient) CancelSyncRiskOperation(reqDto *dto.CancelSyncRiskOperationDto) *dto.CancelSyncRiskOperationsRespDto {
-	b, err := client.SendHttpRequest("/api/v3/cancel-sync-risk-operation", fasthttp.MethodPost, reqDto)
-	var response dto.CancelSyncRiskOperationsRespDto
+	response, err := client.CancelSyncRiskOperationWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CancelSyncRiskOperationsRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// CancelSyncRiskOperationWithContext 同 CancelSyncRiskOperation，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) CancelSyncRiskOperationWithContext(ctx context.Context, reqDto *dto.CancelSyncRiskOperationDto) (*dto.CancelSyncRiskOperationsRespDto, error) {
+	var response dto.CancelSyncRiskOperationsRespDto
+	err := client.request(ctx, "/api/v3/cancel-sync-risk-operation", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -3303,18 +4227,25 @@ func (client *ManagementClient) CancelSyncRiskOperation(reqDto *dto.CancelSyncRi
  * @returns UserActionLogRespDto
  */
 func (client *ManagementClient) GetUserActionLogs(reqDto *dto.GetUserActionLogsDto) *dto.UserActionLogRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-user-action-logs", fasthttp.MethodPost, reqDto)
-	var response dto.UserActionLogRespDto
+	response, err := client.GetUserActionLogsWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.UserActionLogRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetUserActionLogsWithContext 同 GetUserActionLogs，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetUserActionLogsWithContext(ctx context.Context, reqDto *dto.GetUserActionLogsDto) (*dto.UserActionLogRespDto, error) {
+	var response dto.UserActionLogRespDto
+	err := client.request(ctx, "/api/v3/get-user-action-logs", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -3324,18 +4255,25 @@ func (client *ManagementClient) GetUserActionLogs(reqDto *dto.GetUserActionLogsD
  * @returns AdminAuditLogRespDto
  */
 func (client *ManagementClient) GetAdminAuditLogs(reqDto *dto.GetAdminAuditLogsDto) *dto.AdminAuditLogRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-admin-audit-logs", fasthttp.MethodPost, reqDto)
-	var response dto.AdminAuditLogRespDto
+	response, err := client.GetAdminAuditLogsWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.AdminAuditLogRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetAdminAuditLogsWithContext 同 GetAdminAuditLogs，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetAdminAuditLogsWithContext(ctx context.Context, reqDto *dto.GetAdminAuditLogsDto) (*dto.AdminAuditLogRespDto, error) {
+	var response dto.AdminAuditLogRespDto
+	err := client.request(ctx, "/api/v3/get-admin-audit-logs", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -3344,18 +4282,25 @@ func (client *ManagementClient) GetAdminAuditLogs(reqDto *dto.GetAdminAuditLogsD
  * @returns GetEmailTemplatesRespDto
  */
 func (client *ManagementClient) GetEmailTemplates() *dto.GetEmailTemplatesRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-email-templates", fasthttp.MethodGet, nil)
-	var response dto.GetEmailTemplatesRespDto
+	response, err := client.GetEmailTemplatesWithContext(context.Background())
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.GetEmailTemplatesRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetEmailTemplatesWithContext 同 GetEmailTemplates，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetEmailTemplatesWithContext(ctx context.Context) (*dto.GetEmailTemplatesRespDto, error) {
+	var response dto.GetEmailTemplatesRespDto
+	err := client.request(ctx, "/api/v3/get-email-templates", fasthttp.MethodGet, nil, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -3365,18 +4310,25 @@ func (client *ManagementClient) GetEmailTemplates() *dto.GetEmailTemplatesRespDt
  * @returns EmailTemplateSingleItemRespDto
  */
 func (client *ManagementClient) UpdateEmailTemplate(reqDto *dto.UpdateEmailTemplateDto) *dto.EmailTemplateSingleItemRespDto {
-	b, err := client.SendHttpRequest("/api/v3/update-email-template", fasthttp.MethodPost, reqDto)
-	var response dto.EmailTemplateSingleItemRespDto
+	response, err := client.UpdateEmailTemplateWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.EmailTemplateSingleItemRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// UpdateEmailTemplateWithContext 同 UpdateEmailTemplate，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) UpdateEmailTemplateWithContext(ctx context.Context, reqDto *dto.UpdateEmailTemplateDto) (*dto.EmailTemplateSingleItemRespDto, error) {
+	var response dto.EmailTemplateSingleItemRespDto
+	err := client.request(ctx, "/api/v3/update-email-template", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -3386,18 +4338,25 @@ func (client *ManagementClient) UpdateEmailTemplate(reqDto *dto.UpdateEmailTempl
  * @returns PreviewEmailTemplateRespDto
  */
 func (client *ManagementClient) PreviewEmailTemplate(reqDto *dto.PreviewEmailTemplateDto) *dto.PreviewEmailTemplateRespDto {
-	b, err := client.SendHttpRequest("/api/v3/preview-email-template", fasthttp.MethodPost, reqDto)
-	var response dto.PreviewEmailTemplateRespDto
+	response, err := client.PreviewEmailTemplateWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.PreviewEmailTemplateRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// PreviewEmailTemplateWithContext 同 PreviewEmailTemplate，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) PreviewEmailTemplateWithContext(ctx context.Context, reqDto *dto.PreviewEmailTemplateDto) (*dto.PreviewEmailTemplateRespDto, error) {
+	var response dto.PreviewEmailTemplateRespDto
+	err := client.request(ctx, "/api/v3/preview-email-template", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -3406,18 +4365,25 @@ func (client *ManagementClient) PreviewEmailTemplate(reqDto *dto.PreviewEmailTem
  * @returns EmailProviderRespDto
  */
 func (client *ManagementClient) GetEmailProvider() *dto.EmailProviderRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-email-provider", fasthttp.MethodGet, nil)
-	var response dto.EmailProviderRespDto
+	response, err := client.GetEmailProviderWithContext(context.Background())
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.EmailProviderRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetEmailProviderWithContext 同 GetEmailProvider，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetEmailProviderWithContext(ctx context.Context) (*dto.EmailProviderRespDto, error) {
+	var response dto.EmailProviderRespDto
+	err := client.request(ctx, "/api/v3/get-email-provider", fasthttp.MethodGet, nil, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -3427,18 +4393,25 @@ func (client *ManagementClient) GetEmailProvider() *dto.EmailProviderRespDto {
  * @returns EmailProviderRespDto
  */
 func (client *ManagementClient) ConfigEmailProvider(reqDto *dto.ConfigEmailProviderDto) *dto.EmailProviderRespDto {
-	b, err := client.SendHttpRequest("/api/v3/config-email-provider", fasthttp.MethodPost, reqDto)
-	var response dto.EmailProviderRespDto
+	response, err := client.ConfigEmailProviderWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.EmailProviderRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ConfigEmailProviderWithContext 同 ConfigEmailProvider，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ConfigEmailProviderWithContext(ctx context.Context, reqDto *dto.ConfigEmailProviderDto) (*dto.EmailProviderRespDto, error) {
+	var response dto.EmailProviderRespDto
+	err := client.request(ctx, "/api/v3/config-email-provider", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -3448,18 +4421,25 @@ func (client *ManagementClient) ConfigEmailProvider(reqDto *dto.ConfigEmailProvi
  * @returns ApplicationSingleRespDto
  */
 func (client *ManagementClient) GetApplication(reqDto *dto.GetApplicationDto) *dto.ApplicationSingleRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-application", fasthttp.MethodGet, reqDto)
-	var response dto.ApplicationSingleRespDto
+	response, err := client.GetApplicationWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.ApplicationSingleRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetApplicationWithContext 同 GetApplication，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetApplicationWithContext(ctx context.Context, reqDto *dto.GetApplicationDto) (*dto.ApplicationSingleRespDto, error) {
+	var response dto.ApplicationSingleRespDto
+	err := client.request(ctx, "/api/v3/get-application", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -3474,18 +4454,25 @@ func (client *ManagementClient) GetApplication(reqDto *dto.GetApplicationDto) *d
  * @returns ApplicationPaginatedRespDto
  */
 func (client *ManagementClient) ListApplications(reqDto *dto.ListApplicationsDto) *dto.ApplicationPaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/list-applications", fasthttp.MethodGet, reqDto)
-	var response dto.ApplicationPaginatedRespDto
+	response, err := client.ListApplicationsWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.ApplicationPaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ListApplicationsWithContext 同 ListApplications，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ListApplicationsWithContext(ctx context.Context, reqDto *dto.ListApplicationsDto) (*dto.ApplicationPaginatedRespDto, error) {
+	var response dto.ApplicationPaginatedRespDto
+	err := client.request(ctx, "/api/v3/list-applications", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -3495,18 +4482,25 @@ func (client *ManagementClient) ListApplications(reqDto *dto.ListApplicationsDto
  * @returns ApplicationSimpleInfoSingleRespDto
  */
 func (client *ManagementClient) GetApplicationSimpleInfo(reqDto *dto.GetApplicationSimpleInfoDto) *dto.ApplicationSimpleInfoSingleRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-application-simple-info", fasthttp.MethodGet, reqDto)
-	var response dto.ApplicationSimpleInfoSingleRespDto
+	response, err := client.GetApplicationSimpleInfoWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.ApplicationSimpleInfoSingleRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetApplicationSimpleInfoWithContext 同 GetApplicationSimpleInfo，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetApplicationSimpleInfoWithContext(ctx context.Context, reqDto *dto.GetApplicationSimpleInfoDto) (*dto.ApplicationSimpleInfoSingleRespDto, error) {
+	var response dto.ApplicationSimpleInfoSingleRespDto
+	err := client.request(ctx, "/api/v3/get-application-simple-info", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -3521,18 +4515,25 @@ func (client *ManagementClient) GetApplicationSimpleInfo(reqDto *dto.GetApplicat
  * @returns ApplicationSimpleInfoPaginatedRespDto
  */
 func (client *ManagementClient) ListApplicationSimpleInfo(reqDto *dto.ListApplicationSimpleInfoDto) *dto.ApplicationSimpleInfoPaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/list-application-simple-info", fasthttp.MethodGet, reqDto)
-	var response dto.ApplicationSimpleInfoPaginatedRespDto
+	response, err := client.ListApplicationSimpleInfoWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.ApplicationSimpleInfoPaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ListApplicationSimpleInfoWithContext 同 ListApplicationSimpleInfo，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ListApplicationSimpleInfoWithContext(ctx context.Context, reqDto *dto.ListApplicationSimpleInfoDto) (*dto.ApplicationSimpleInfoPaginatedRespDto, error) {
+	var response dto.ApplicationSimpleInfoPaginatedRespDto
+	err := client.request(ctx, "/api/v3/list-application-simple-info", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -3542,18 +4543,25 @@ func (client *ManagementClient) ListApplicationSimpleInfo(reqDto *dto.ListApplic
  * @returns CreateApplicationRespDto
  */
 func (client *ManagementClient) CreateApplication(reqDto *dto.CreateApplicationDto) *dto.CreateApplicationRespDto {
-	b, err := client.SendHttpRequest("/api/v3/create-application", fasthttp.MethodPost, reqDto)
-	var response dto.CreateApplicationRespDto
+	response, err := client.CreateApplicationWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CreateApplicationRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// CreateApplicationWithContext 同 CreateApplication，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) CreateApplicationWithContext(ctx context.Context, reqDto *dto.CreateApplicationDto) (*dto.CreateApplicationRespDto, error) {
+	var response dto.CreateApplicationRespDto
+	err := client.request(ctx, "/api/v3/create-application", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -3563,18 +4571,25 @@ func (client *ManagementClient) CreateApplication(reqDto *dto.CreateApplicationD
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) DeleteApplication(reqDto *dto.DeleteApplicationDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/delete-application", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.DeleteApplicationWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// DeleteApplicationWithContext 同 DeleteApplication，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) DeleteApplicationWithContext(ctx context.Context, reqDto *dto.DeleteApplicationDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/delete-application", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -3584,18 +4599,25 @@ func (client *ManagementClient) DeleteApplication(reqDto *dto.DeleteApplicationD
  * @returns GetApplicationSecretRespDto
  */
 func (client *ManagementClient) GetApplicationSecret(reqDto *dto.GetApplicationSecretDto) *dto.GetApplicationSecretRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-application-secret", fasthttp.MethodGet, reqDto)
-	var response dto.GetApplicationSecretRespDto
+	response, err := client.GetApplicationSecretWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.GetApplicationSecretRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetApplicationSecretWithContext 同 GetApplicationSecret，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetApplicationSecretWithContext(ctx context.Context, reqDto *dto.GetApplicationSecretDto) (*dto.GetApplicationSecretRespDto, error) {
+	var response dto.GetApplicationSecretRespDto
+	err := client.request(ctx, "/api/v3/get-application-secret", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -3605,18 +4627,25 @@ func (client *ManagementClient) GetApplicationSecret(reqDto *dto.GetApplicationS
  * @returns RefreshApplicationSecretRespDto
  */
 func (client *ManagementClient) RefreshApplicationSecret(reqDto *dto.RefreshApplicationSecretDto) *dto.RefreshApplicationSecretRespDto {
-	b, err := client.SendHttpRequest("/api/v3/refresh-application-secret", fasthttp.MethodPost, reqDto)
-	var response dto.RefreshApplicationSecretRespDto
+	response, err := client.RefreshApplicationSecretWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.RefreshApplicationSecretRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// RefreshApplicationSecretWithContext 同 RefreshApplicationSecret，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) RefreshApplicationSecretWithContext(ctx context.Context, reqDto *dto.RefreshApplicationSecretDto) (*dto.RefreshApplicationSecretRespDto, error) {
+	var response dto.RefreshApplicationSecretRespDto
+	err := client.request(ctx, "/api/v3/refresh-application-secret", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -3626,18 +4655,25 @@ func (client *ManagementClient) RefreshApplicationSecret(reqDto *dto.RefreshAppl
  * @returns UserPaginatedRespDto
  */
 func (client *ManagementClient) ListApplicationActiveUsers(reqDto *dto.ListApplicationActiveUsersDto) *dto.UserPaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/list-application-active-users", fasthttp.MethodPost, reqDto)
-	var response dto.UserPaginatedRespDto
+	response, err := client.ListApplicationActiveUsersWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.UserPaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ListApplicationActiveUsersWithContext 同 ListApplicationActiveUsers，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ListApplicationActiveUsersWithContext(ctx context.Context, reqDto *dto.ListApplicationActiveUsersDto) (*dto.UserPaginatedRespDto, error) {
+	var response dto.UserPaginatedRespDto
+	err := client.request(ctx, "/api/v3/list-application-active-users", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -3647,18 +4683,25 @@ func (client *ManagementClient) ListApplicationActiveUsers(reqDto *dto.ListAppli
  * @returns GetApplicationPermissionStrategyRespDto
  */
 func (client *ManagementClient) GetApplicationPermissionStrategy(reqDto *dto.GetApplicationPermissionStrategyDto) *dto.GetApplicationPermissionStrategyRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-application-permission-strategy", fasthttp.MethodGet, reqDto)
-	var response dto.GetApplicationPermissionStrategyRespDto
+	response, err := client.GetApplicationPermissionStrategyWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.GetApplicationPermissionStrategyRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetApplicationPermissionStrategyWithContext 同 GetApplicationPermissionStrategy，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetApplicationPermissionStrategyWithContext(ctx context.Context, reqDto *dto.GetApplicationPermissionStrategyDto) (*dto.GetApplicationPermissionStrategyRespDto, error) {
+	var response dto.GetApplicationPermissionStrategyRespDto
+	err := client.request(ctx, "/api/v3/get-application-permission-strategy", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -3668,18 +4711,25 @@ func (client *ManagementClient) GetApplicationPermissionStrategy(reqDto *dto.Get
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) UpdateApplicationPermissionStrategy(reqDto *dto.UpdateApplicationPermissionStrategyDataDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/update-application-permission-strategy", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.UpdateApplicationPermissionStrategyWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// UpdateApplicationPermissionStrategyWithContext 同 UpdateApplicationPermissionStrategy，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) UpdateApplicationPermissionStrategyWithContext(ctx context.Context, reqDto *dto.UpdateApplicationPermissionStrategyDataDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/update-application-permission-strategy", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -3689,18 +4739,25 @@ func (client *ManagementClient) UpdateApplicationPermissionStrategy(reqDto *dto.
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) AuthorizeApplicationAccess(reqDto *dto.AuthorizeApplicationAccessDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/authorize-application-access", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.AuthorizeApplicationAccessWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// AuthorizeApplicationAccessWithContext 同 AuthorizeApplicationAccess，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) AuthorizeApplicationAccessWithContext(ctx context.Context, reqDto *dto.AuthorizeApplicationAccessDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/authorize-application-access", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -3710,18 +4767,25 @@ func (client *ManagementClient) AuthorizeApplicationAccess(reqDto *dto.Authorize
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) RevokeApplicationAccess(reqDto *dto.RevokeApplicationAccessDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/revoke-application-access", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.RevokeApplicationAccessWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// RevokeApplicationAccessWithContext 同 RevokeApplicationAccess，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) RevokeApplicationAccessWithContext(ctx context.Context, reqDto *dto.RevokeApplicationAccessDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/revoke-application-access", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -3731,18 +4795,25 @@ func (client *ManagementClient) RevokeApplicationAccess(reqDto *dto.RevokeApplic
  * @returns CheckDomainAvailableSecretRespDto
  */
 func (client *ManagementClient) CheckDomainAvailable(reqDto *dto.CheckDomainAvailable) *dto.CheckDomainAvailableSecretRespDto {
-	b, err := client.SendHttpRequest("/api/v3/check-domain-available", fasthttp.MethodPost, reqDto)
-	var response dto.CheckDomainAvailableSecretRespDto
+	response, err := client.CheckDomainAvailableWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CheckDomainAvailableSecretRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// CheckDomainAvailableWithContext 同 CheckDomainAvailable，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) CheckDomainAvailableWithContext(ctx context.Context, reqDto *dto.CheckDomainAvailable) (*dto.CheckDomainAvailableSecretRespDto, error) {
+	var response dto.CheckDomainAvailableSecretRespDto
+	err := client.request(ctx, "/api/v3/check-domain-available", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -3755,18 +4826,25 @@ func (client *ManagementClient) CheckDomainAvailable(reqDto *dto.CheckDomainAvai
  * @returns TenantApplicationListPaginatedRespDto
  */
 func (client *ManagementClient) ListTenantApplications(reqDto *dto.ListTenantApplicationsDto) *dto.TenantApplicationListPaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/list-tenant-applications", fasthttp.MethodGet, reqDto)
-	var response dto.TenantApplicationListPaginatedRespDto
+	response, err := client.ListTenantApplicationsWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.TenantApplicationListPaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ListTenantApplicationsWithContext 同 ListTenantApplications，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ListTenantApplicationsWithContext(ctx context.Context, reqDto *dto.ListTenantApplicationsDto) (*dto.TenantApplicationListPaginatedRespDto, error) {
+	var response dto.TenantApplicationListPaginatedRespDto
+	err := client.request(ctx, "/api/v3/list-tenant-applications", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -3776,18 +4854,25 @@ func (client *ManagementClient) ListTenantApplications(reqDto *dto.ListTenantApp
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) UpdateLoginPageConfig(reqDto *dto.UpdateLoginConfigDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/update-login-page-config", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.UpdateLoginPageConfigWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// UpdateLoginPageConfigWithContext 同 UpdateLoginPageConfig，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) UpdateLoginPageConfigWithContext(ctx context.Context, reqDto *dto.UpdateLoginConfigDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/update-login-page-config", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -3796,18 +4881,25 @@ func (client *ManagementClient) UpdateLoginPageConfig(reqDto *dto.UpdateLoginCon
  * @returns UserPoolTenantConfigDtoRespDto
  */
 func (client *ManagementClient) UserpollTenantConfig() *dto.UserPoolTenantConfigDtoRespDto {
-	b, err := client.SendHttpRequest("/api/v3/userpool-tenant-config", fasthttp.MethodGet, nil)
-	var response dto.UserPoolTenantConfigDtoRespDto
+	response, err := client.UserpollTenantConfigWithContext(context.Background())
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.UserPoolTenantConfigDtoRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// UserpollTenantConfigWithContext 同 UserpollTenantConfig，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) UserpollTenantConfigWithContext(ctx context.Context) (*dto.UserPoolTenantConfigDtoRespDto, error) {
+	var response dto.UserPoolTenantConfigDtoRespDto
+	err := client.request(ctx, "/api/v3/userpool-tenant-config", fasthttp.MethodGet, nil, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -3817,18 +4909,25 @@ func (client *ManagementClient) UserpollTenantConfig() *dto.UserPoolTenantConfig
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) UpdateUserPoolTenantConfig(reqDto *dto.UpdateUserPoolTenantLoginConfigDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/update-userpool-tenant-config", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.UpdateUserPoolTenantConfigWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// UpdateUserPoolTenantConfigWithContext 同 UpdateUserPoolTenantConfig，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) UpdateUserPoolTenantConfigWithContext(ctx context.Context, reqDto *dto.UpdateUserPoolTenantLoginConfigDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/update-userpool-tenant-config", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -3838,18 +4937,25 @@ func (client *ManagementClient) UpdateUserPoolTenantConfig(reqDto *dto.UpdateUse
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) ChangeUserpoolTenanExtIdpConnState(reqDto *dto.ChangeUserPoolTenantExtIdpConnDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/change-userpool-tenant-ext-idp-conn-state", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.ChangeUserpoolTenanExtIdpConnStateWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ChangeUserpoolTenanExtIdpConnStateWithContext 同 ChangeUserpoolTenanExtIdpConnState，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ChangeUserpoolTenanExtIdpConnStateWithContext(ctx context.Context, reqDto *dto.ChangeUserPoolTenantExtIdpConnDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/change-userpool-tenant-ext-idp-conn-state", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -3859,18 +4965,25 @@ func (client *ManagementClient) ChangeUserpoolTenanExtIdpConnState(reqDto *dto.C
  * @returns AsaAccountSingleRespDto
  */
 func (client *ManagementClient) CreateAsaAccount(reqDto *dto.CreateAsaAccountDto) *dto.AsaAccountSingleRespDto {
-	b, err := client.SendHttpRequest("/api/v3/create-asa-account", fasthttp.MethodPost, reqDto)
-	var response dto.AsaAccountSingleRespDto
+	response, err := client.CreateAsaAccountWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.AsaAccountSingleRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// CreateAsaAccountWithContext 同 CreateAsaAccount，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) CreateAsaAccountWithContext(ctx context.Context, reqDto *dto.CreateAsaAccountDto) (*dto.AsaAccountSingleRespDto, error) {
+	var response dto.AsaAccountSingleRespDto
+	err := client.request(ctx, "/api/v3/create-asa-account", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -3880,18 +4993,25 @@ func (client *ManagementClient) CreateAsaAccount(reqDto *dto.CreateAsaAccountDto
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) CreateAsaAccountBatch(reqDto *dto.CreateAsaAccountsBatchDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/create-asa-accounts-batch", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.CreateAsaAccountBatchWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// CreateAsaAccountBatchWithContext 同 CreateAsaAccountBatch，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) CreateAsaAccountBatchWithContext(ctx context.Context, reqDto *dto.CreateAsaAccountsBatchDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/create-asa-accounts-batch", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -3901,18 +5021,25 @@ func (client *ManagementClient) CreateAsaAccountBatch(reqDto *dto.CreateAsaAccou
  * @returns AsaAccountSingleRespDto
  */
 func (client *ManagementClient) UpdateAsaAccount(reqDto *dto.UpdateAsaAccountDto) *dto.AsaAccountSingleRespDto {
-	b, err := client.SendHttpRequest("/api/v3/update-asa-account", fasthttp.MethodPost, reqDto)
-	var response dto.AsaAccountSingleRespDto
+	response, err := client.UpdateAsaAccountWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.AsaAccountSingleRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// UpdateAsaAccountWithContext 同 UpdateAsaAccount，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) UpdateAsaAccountWithContext(ctx context.Context, reqDto *dto.UpdateAsaAccountDto) (*dto.AsaAccountSingleRespDto, error) {
+	var response dto.AsaAccountSingleRespDto
+	err := client.request(ctx, "/api/v3/update-asa-account", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -3924,18 +5051,25 @@ func (client *ManagementClient) UpdateAsaAccount(reqDto *dto.UpdateAsaAccountDto
  * @returns AsaAccountPaginatedRespDto
  */
 func (client *ManagementClient) ListAsaAccount(reqDto *dto.ListAsaAccountsDto) *dto.AsaAccountPaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/list-asa-accounts", fasthttp.MethodGet, reqDto)
-	var response dto.AsaAccountPaginatedRespDto
+	response, err := client.ListAsaAccountWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.AsaAccountPaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ListAsaAccountWithContext 同 ListAsaAccount，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ListAsaAccountWithContext(ctx context.Context, reqDto *dto.ListAsaAccountsDto) (*dto.AsaAccountPaginatedRespDto, error) {
+	var response dto.AsaAccountPaginatedRespDto
+	err := client.request(ctx, "/api/v3/list-asa-accounts", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -3946,18 +5080,25 @@ func (client *ManagementClient) ListAsaAccount(reqDto *dto.ListAsaAccountsDto) *
  * @returns AsaAccountSingleRespDto
  */
 func (client *ManagementClient) GetAsaAccount(reqDto *dto.GetAsaAccountDto) *dto.AsaAccountSingleRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-asa-account", fasthttp.MethodGet, reqDto)
-	var response dto.AsaAccountSingleRespDto
+	response, err := client.GetAsaAccountWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.AsaAccountSingleRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetAsaAccountWithContext 同 GetAsaAccount，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetAsaAccountWithContext(ctx context.Context, reqDto *dto.GetAsaAccountDto) (*dto.AsaAccountSingleRespDto, error) {
+	var response dto.AsaAccountSingleRespDto
+	err := client.request(ctx, "/api/v3/get-asa-account", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -3967,18 +5108,25 @@ func (client *ManagementClient) GetAsaAccount(reqDto *dto.GetAsaAccountDto) *dto
  * @returns AsaAccountListRespDto
  */
 func (client *ManagementClient) GetAsaAccountBatch(reqDto *dto.GetAsaAccountBatchDto) *dto.AsaAccountListRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-asa-accounts-batch", fasthttp.MethodPost, reqDto)
-	var response dto.AsaAccountListRespDto
+	response, err := client.GetAsaAccountBatchWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.AsaAccountListRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetAsaAccountBatchWithContext 同 GetAsaAccountBatch，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetAsaAccountBatchWithContext(ctx context.Context, reqDto *dto.GetAsaAccountBatchDto) (*dto.AsaAccountListRespDto, error) {
+	var response dto.AsaAccountListRespDto
+	err := client.request(ctx, "/api/v3/get-asa-accounts-batch", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -3988,18 +5136,25 @@ func (client *ManagementClient) GetAsaAccountBatch(reqDto *dto.GetAsaAccountBatc
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) DeleteAsaAccount(reqDto *dto.DeleteAsaAccountDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/delete-asa-account", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.DeleteAsaAccountWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// DeleteAsaAccountWithContext 同 DeleteAsaAccount，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) DeleteAsaAccountWithContext(ctx context.Context, reqDto *dto.DeleteAsaAccountDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/delete-asa-account", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -4009,18 +5164,25 @@ func (client *ManagementClient) DeleteAsaAccount(reqDto *dto.DeleteAsaAccountDto
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) DeleteAsaAccountBatch(reqDto *dto.DeleteAsaAccountBatchDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/delete-asa-accounts-batch", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.DeleteAsaAccountBatchWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// DeleteAsaAccountBatchWithContext 同 DeleteAsaAccountBatch，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) DeleteAsaAccountBatchWithContext(ctx context.Context, reqDto *dto.DeleteAsaAccountBatchDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/delete-asa-accounts-batch", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -4030,18 +5192,25 @@ func (client *ManagementClient) DeleteAsaAccountBatch(reqDto *dto.DeleteAsaAccou
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) AssignAsaAccount(reqDto *dto.AssignAsaAccountsDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/assign-asa-account", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.AssignAsaAccountWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// AssignAsaAccountWithContext 同 AssignAsaAccount，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) AssignAsaAccountWithContext(ctx context.Context, reqDto *dto.AssignAsaAccountsDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/assign-asa-account", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -4051,18 +5220,25 @@ func (client *ManagementClient) AssignAsaAccount(reqDto *dto.AssignAsaAccountsDt
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) UnassignAsaAccount(reqDto *dto.AssignAsaAccountsDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/unassign-asa-account", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.UnassignAsaAccountWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// UnassignAsaAccountWithContext 同 UnassignAsaAccount，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) UnassignAsaAccountWithContext(ctx context.Context, reqDto *dto.AssignAsaAccountsDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/unassign-asa-account", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -4075,18 +5251,25 @@ func (client *ManagementClient) UnassignAsaAccount(reqDto *dto.AssignAsaAccounts
  * @returns GetAsaAccountAssignedTargetRespDto
  */
 func (client *ManagementClient) GetAsaAccountAssignedTargets(reqDto *dto.GetAsaAccountAssignedTargetsDto) *dto.GetAsaAccountAssignedTargetRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-asa-account-assigned-targets", fasthttp.MethodGet, reqDto)
-	var response dto.GetAsaAccountAssignedTargetRespDto
+	response, err := client.GetAsaAccountAssignedTargetsWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.GetAsaAccountAssignedTargetRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetAsaAccountAssignedTargetsWithContext 同 GetAsaAccountAssignedTargets，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetAsaAccountAssignedTargetsWithContext(ctx context.Context, reqDto *dto.GetAsaAccountAssignedTargetsDto) (*dto.GetAsaAccountAssignedTargetRespDto, error) {
+	var response dto.GetAsaAccountAssignedTargetRespDto
+	err := client.request(ctx, "/api/v3/get-asa-account-assigned-targets", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -4108,18 +5291,25 @@ func (client *ManagementClient) GetAsaAccountAssignedTargets(reqDto *dto.GetAsaA
  * @returns AsaAccountSingleNullableRespDto
  */
 func (client *ManagementClient) GetAssignedAccount(reqDto *dto.GetAssignedAccountDto) *dto.AsaAccountSingleNullableRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-assigned-account", fasthttp.MethodGet, reqDto)
-	var response dto.AsaAccountSingleNullableRespDto
+	response, err := client.GetAssignedAccountWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.AsaAccountSingleNullableRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetAssignedAccountWithContext 同 GetAssignedAccount，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetAssignedAccountWithContext(ctx context.Context, reqDto *dto.GetAssignedAccountDto) (*dto.AsaAccountSingleNullableRespDto, error) {
+	var response dto.AsaAccountSingleNullableRespDto
+	err := client.request(ctx, "/api/v3/get-assigned-account", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -4128,18 +5318,25 @@ func (client *ManagementClient) GetAssignedAccount(reqDto *dto.GetAssignedAccoun
  * @returns SecuritySettingsRespDto
  */
 func (client *ManagementClient) GetSecuritySettings() *dto.SecuritySettingsRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-security-settings", fasthttp.MethodGet, nil)
-	var response dto.SecuritySettingsRespDto
+	response, err := client.GetSecuritySettingsWithContext(context.Background())
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.SecuritySettingsRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetSecuritySettingsWithContext 同 GetSecuritySettings，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetSecuritySettingsWithContext(ctx context.Context) (*dto.SecuritySettingsRespDto, error) {
+	var response dto.SecuritySettingsRespDto
+	err := client.request(ctx, "/api/v3/get-security-settings", fasthttp.MethodGet, nil, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -4149,18 +5346,25 @@ func (client *ManagementClient) GetSecuritySettings() *dto.SecuritySettingsRespD
  * @returns SecuritySettingsRespDto
  */
 func (client *ManagementClient) UpdateSecuritySettings(reqDto *dto.UpdateSecuritySettingsDto) *dto.SecuritySettingsRespDto {
-	b, err := client.SendHttpRequest("/api/v3/update-security-settings", fasthttp.MethodPost, reqDto)
-	var response dto.SecuritySettingsRespDto
+	response, err := client.UpdateSecuritySettingsWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.SecuritySettingsRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// UpdateSecuritySettingsWithContext 同 UpdateSecuritySettings，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) UpdateSecuritySettingsWithContext(ctx context.Context, reqDto *dto.UpdateSecuritySettingsDto) (*dto.SecuritySettingsRespDto, error) {
+	var response dto.SecuritySettingsRespDto
+	err := client.request(ctx, "/api/v3/update-security-settings", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -4169,18 +5373,25 @@ func (client *ManagementClient) UpdateSecuritySettings(reqDto *dto.UpdateSecurit
  * @returns MFASettingsRespDto
  */
 func (client *ManagementClient) GetGlobalMfaSettings() *dto.MFASettingsRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-global-mfa-settings", fasthttp.MethodGet, nil)
-	var response dto.MFASettingsRespDto
+	response, err := client.GetGlobalMfaSettingsWithContext(context.Background())
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.MFASettingsRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetGlobalMfaSettingsWithContext 同 GetGlobalMfaSettings，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetGlobalMfaSettingsWithContext(ctx context.Context) (*dto.MFASettingsRespDto, error) {
+	var response dto.MFASettingsRespDto
+	err := client.request(ctx, "/api/v3/get-global-mfa-settings", fasthttp.MethodGet, nil, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -4190,18 +5401,25 @@ func (client *ManagementClient) GetGlobalMfaSettings() *dto.MFASettingsRespDto {
  * @returns MFASettingsRespDto
  */
 func (client *ManagementClient) UpdateGlobalMfaSettings(reqDto *dto.MFASettingsDto) *dto.MFASettingsRespDto {
-	b, err := client.SendHttpRequest("/api/v3/update-global-mfa-settings", fasthttp.MethodPost, reqDto)
-	var response dto.MFASettingsRespDto
+	response, err := client.UpdateGlobalMfaSettingsWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.MFASettingsRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// UpdateGlobalMfaSettingsWithContext 同 UpdateGlobalMfaSettings，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) UpdateGlobalMfaSettingsWithContext(ctx context.Context, reqDto *dto.MFASettingsDto) (*dto.MFASettingsRespDto, error) {
+	var response dto.MFASettingsRespDto
+	err := client.request(ctx, "/api/v3/update-global-mfa-settings", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -4211,18 +5429,25 @@ func (client *ManagementClient) UpdateGlobalMfaSettings(reqDto *dto.MFASettingsD
  * @returns CreatePermissionNamespaceResponseDto
  */
 func (client *ManagementClient) CreatePermissionNamespace(reqDto *dto.CreatePermissionNamespaceDto) *dto.CreatePermissionNamespaceResponseDto {
-	b, err := client.SendHttpRequest("/api/v3/create-permission-namespace", fasthttp.MethodPost, reqDto)
-	var response dto.CreatePermissionNamespaceResponseDto
+	response, err := client.CreatePermissionNamespaceWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CreatePermissionNamespaceResponseDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// CreatePermissionNamespaceWithContext 同 CreatePermissionNamespace，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) CreatePermissionNamespaceWithContext(ctx context.Context, reqDto *dto.CreatePermissionNamespaceDto) (*dto.CreatePermissionNamespaceResponseDto, error) {
+	var response dto.CreatePermissionNamespaceResponseDto
+	err := client.request(ctx, "/api/v3/create-permission-namespace", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -4232,18 +5457,25 @@ func (client *ManagementClient) CreatePermissionNamespace(reqDto *dto.CreatePerm
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) CreatePermissionNamespacesBatch(reqDto *dto.CreatePermissionNamespacesBatchDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/create-permission-namespaces-batch", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.CreatePermissionNamespacesBatchWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// CreatePermissionNamespacesBatchWithContext 同 CreatePermissionNamespacesBatch，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) CreatePermissionNamespacesBatchWithContext(ctx context.Context, reqDto *dto.CreatePermissionNamespacesBatchDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/create-permission-namespaces-batch", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -4253,18 +5485,25 @@ func (client *ManagementClient) CreatePermissionNamespacesBatch(reqDto *dto.Crea
  * @returns GetPermissionNamespaceResponseDto
  */
 func (client *ManagementClient) GetPermissionNamespace(reqDto *dto.GetPermissionNamespaceDto) *dto.GetPermissionNamespaceResponseDto {
-	b, err := client.SendHttpRequest("/api/v3/get-permission-namespace", fasthttp.MethodGet, reqDto)
+	response, err := client.GetPermissionNamespaceWithContext(context.Background(), reqDto)
+	if err != nil {
+		response = &dto.GetPermissionNamespaceResponseDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
+	}
+	return response
+}
+
+// GetPermissionNamespaceWithContext 同 GetPermissionNamespace，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetPermissionNamespaceWithContext(ctx context.Context, reqDto *dto.GetPermissionNamespaceDto) (*dto.GetPermissionNamespaceResponseDto, error) {
 	var response dto.GetPermissionNamespaceResponseDto
+	err := client.request(ctx, "/api/v3/get-permission-namespace", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	err = json.Unmarshal(b, &response)
-	if err != nil {
-		fmt.Println(err)
-		return nil
-	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -4274,18 +5513,25 @@ func (client *ManagementClient) GetPermissionNamespace(reqDto *dto.GetPermission
  * @returns GetPermissionNamespaceListResponseDto
  */
 func (client *ManagementClient) GetPermissionNamespacesBatch(reqDto *dto.GetPermissionNamespacesBatchDto) *dto.GetPermissionNamespaceListResponseDto {
-	b, err := client.SendHttpRequest("/api/v3/get-permission-namespaces-batch", fasthttp.MethodGet, reqDto)
-	var response dto.GetPermissionNamespaceListResponseDto
+	response, err := client.GetPermissionNamespacesBatchWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.GetPermissionNamespaceListResponseDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetPermissionNamespacesBatchWithContext 同 GetPermissionNamespacesBatch，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetPermissionNamespacesBatchWithContext(ctx context.Context, reqDto *dto.GetPermissionNamespacesBatchDto) (*dto.GetPermissionNamespaceListResponseDto, error) {
+	var response dto.GetPermissionNamespaceListResponseDto
+	err := client.request(ctx, "/api/v3/get-permission-namespaces-batch", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -4297,18 +5543,25 @@ func (client *ManagementClient) GetPermissionNamespacesBatch(reqDto *dto.GetPerm
  * @returns PermissionNamespaceListPaginatedRespDto
  */
 func (client *ManagementClient) ListPermissionNamespaces(reqDto *dto.ListPermissionNamespacesDto) *dto.PermissionNamespaceListPaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/list-permission-namespaces", fasthttp.MethodGet, reqDto)
-	var response dto.PermissionNamespaceListPaginatedRespDto
+	response, err := client.ListPermissionNamespacesWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.PermissionNamespaceListPaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ListPermissionNamespacesWithContext 同 ListPermissionNamespaces，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ListPermissionNamespacesWithContext(ctx context.Context, reqDto *dto.ListPermissionNamespacesDto) (*dto.PermissionNamespaceListPaginatedRespDto, error) {
+	var response dto.PermissionNamespaceListPaginatedRespDto
+	err := client.request(ctx, "/api/v3/list-permission-namespaces", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -4318,18 +5571,25 @@ func (client *ManagementClient) ListPermissionNamespaces(reqDto *dto.ListPermiss
  * @returns UpdatePermissionNamespaceResponseDto
  */
 func (client *ManagementClient) UpdatePermissionNamespace(reqDto *dto.UpdatePermissionNamespaceDto) *dto.UpdatePermissionNamespaceResponseDto {
-	b, err := client.SendHttpRequest("/api/v3/update-permission-namespace", fasthttp.MethodPost, reqDto)
-	var response dto.UpdatePermissionNamespaceResponseDto
+	response, err := client.UpdatePermissionNamespaceWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.UpdatePermissionNamespaceResponseDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// UpdatePermissionNamespaceWithContext 同 UpdatePermissionNamespace，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) UpdatePermissionNamespaceWithContext(ctx context.Context, reqDto *dto.UpdatePermissionNamespaceDto) (*dto.UpdatePermissionNamespaceResponseDto, error) {
+	var response dto.UpdatePermissionNamespaceResponseDto
+	err := client.request(ctx, "/api/v3/update-permission-namespace", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -4339,18 +5599,25 @@ func (client *ManagementClient) UpdatePermissionNamespace(reqDto *dto.UpdatePerm
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) DeletePermissionNamespace(reqDto *dto.DeletePermissionNamespaceDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/delete-permission-namespace", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.DeletePermissionNamespaceWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// DeletePermissionNamespaceWithContext 同 DeletePermissionNamespace，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) DeletePermissionNamespaceWithContext(ctx context.Context, reqDto *dto.DeletePermissionNamespaceDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/delete-permission-namespace", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -4360,18 +5627,25 @@ func (client *ManagementClient) DeletePermissionNamespace(reqDto *dto.DeletePerm
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) DeletePermissionNamespacesBatch(reqDto *dto.DeletePermissionNamespacesBatchDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/delete-permission-namespaces-batch", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.DeletePermissionNamespacesBatchWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// DeletePermissionNamespacesBatchWithContext 同 DeletePermissionNamespacesBatch，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) DeletePermissionNamespacesBatchWithContext(ctx context.Context, reqDto *dto.DeletePermissionNamespacesBatchDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/delete-permission-namespaces-batch", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -4381,18 +5655,25 @@ func (client *ManagementClient) DeletePermissionNamespacesBatch(reqDto *dto.Dele
  * @returns PermissionNamespaceCheckExistsRespDto
  */
 func (client *ManagementClient) CheckPermissionNamespaceExists(reqDto *dto.CheckPermissionNamespaceExistsDto) *dto.PermissionNamespaceCheckExistsRespDto {
-	b, err := client.SendHttpRequest("/api/v3/check-permission-namespace-exists", fasthttp.MethodPost, reqDto)
-	var response dto.PermissionNamespaceCheckExistsRespDto
+	response, err := client.CheckPermissionNamespaceExistsWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.PermissionNamespaceCheckExistsRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// CheckPermissionNamespaceExistsWithContext 同 CheckPermissionNamespaceExists，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) CheckPermissionNamespaceExistsWithContext(ctx context.Context, reqDto *dto.CheckPermissionNamespaceExistsDto) (*dto.PermissionNamespaceCheckExistsRespDto, error) {
+	var response dto.PermissionNamespaceCheckExistsRespDto
+	err := client.request(ctx, "/api/v3/check-permission-namespace-exists", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -4405,18 +5686,25 @@ func (client *ManagementClient) CheckPermissionNamespaceExists(reqDto *dto.Check
  * @returns PermissionNamespaceRolesListPaginatedRespDto
  */
 func (client *ManagementClient) ListPermissionNamespaceRoles(reqDto *dto.ListPermissionNamespaceRolesDto) *dto.PermissionNamespaceRolesListPaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/list-permission-namespace-roles", fasthttp.MethodGet, reqDto)
-	var response dto.PermissionNamespaceRolesListPaginatedRespDto
+	response, err := client.ListPermissionNamespaceRolesWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.PermissionNamespaceRolesListPaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ListPermissionNamespaceRolesWithContext 同 ListPermissionNamespaceRoles，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ListPermissionNamespaceRolesWithContext(ctx context.Context, reqDto *dto.ListPermissionNamespaceRolesDto) (*dto.PermissionNamespaceRolesListPaginatedRespDto, error) {
+	var response dto.PermissionNamespaceRolesListPaginatedRespDto
+	err := client.request(ctx, "/api/v3/list-permission-namespace-roles", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -4484,18 +5772,25 @@ func (client *ManagementClient) ListPermissionNamespaceRoles(reqDto *dto.ListPer
                            * @returns CreateDataResourceResponseDto
 */
 func (client *ManagementClient) CreateDataResource(reqDto *dto.CreateDataResourceDto) *dto.CreateDataResourceResponseDto {
-	b, err := client.SendHttpRequest("/api/v3/create-data-resource", fasthttp.MethodPost, reqDto)
-	var response dto.CreateDataResourceResponseDto
+	response, err := client.CreateDataResourceWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CreateDataResourceResponseDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// CreateDataResourceWithContext 同 CreateDataResource，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) CreateDataResourceWithContext(ctx context.Context, reqDto *dto.CreateDataResourceDto) (*dto.CreateDataResourceResponseDto, error) {
+	var response dto.CreateDataResourceResponseDto
+	err := client.request(ctx, "/api/v3/create-data-resource", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -4505,18 +5800,25 @@ func (client *ManagementClient) CreateDataResource(reqDto *dto.CreateDataResourc
  * @returns CreateStringDataResourceResponseDto
  */
 func (client *ManagementClient) CreateDataResourceByString(reqDto *dto.CreateStringDataResourceDto) *dto.CreateStringDataResourceResponseDto {
-	b, err := client.SendHttpRequest("/api/v3/create-string-data-resource", fasthttp.MethodPost, reqDto)
-	var response dto.CreateStringDataResourceResponseDto
+	response, err := client.CreateDataResourceByStringWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CreateStringDataResourceResponseDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// CreateDataResourceByStringWithContext 同 CreateDataResourceByString，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) CreateDataResourceByStringWithContext(ctx context.Context, reqDto *dto.CreateStringDataResourceDto) (*dto.CreateStringDataResourceResponseDto, error) {
+	var response dto.CreateStringDataResourceResponseDto
+	err := client.request(ctx, "/api/v3/create-string-data-resource", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -4526,18 +5828,25 @@ func (client *ManagementClient) CreateDataResourceByString(reqDto *dto.CreateStr
  * @returns CreateArrayDataResourceResponseDto
  */
 func (client *ManagementClient) CreateDataResourceByArray(reqDto *dto.CreateArrayDataResourceDto) *dto.CreateArrayDataResourceResponseDto {
-	b, err := client.SendHttpRequest("/api/v3/create-array-data-resource", fasthttp.MethodPost, reqDto)
-	var response dto.CreateArrayDataResourceResponseDto
+	response, err := client.CreateDataResourceByArrayWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CreateArrayDataResourceResponseDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// CreateDataResourceByArrayWithContext 同 CreateDataResourceByArray，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) CreateDataResourceByArrayWithContext(ctx context.Context, reqDto *dto.CreateArrayDataResourceDto) (*dto.CreateArrayDataResourceResponseDto, error) {
+	var response dto.CreateArrayDataResourceResponseDto
+	err := client.request(ctx, "/api/v3/create-array-data-resource", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -4547,18 +5856,25 @@ func (client *ManagementClient) CreateDataResourceByArray(reqDto *dto.CreateArra
  * @returns CreateTreeDataResourceResponseDto
  */
 func (client *ManagementClient) CreateDataResourceByTree(reqDto *dto.CreateTreeDataResourceDto) *dto.CreateTreeDataResourceResponseDto {
-	b, err := client.SendHttpRequest("/api/v3/create-tree-data-resource", fasthttp.MethodPost, reqDto)
-	var response dto.CreateTreeDataResourceResponseDto
+	response, err := client.CreateDataResourceByTreeWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CreateTreeDataResourceResponseDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// CreateDataResourceByTreeWithContext 同 CreateDataResourceByTree，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) CreateDataResourceByTreeWithContext(ctx context.Context, reqDto *dto.CreateTreeDataResourceDto) (*dto.CreateTreeDataResourceResponseDto, error) {
+	var response dto.CreateTreeDataResourceResponseDto
+	err := client.request(ctx, "/api/v3/create-tree-data-resource", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -4571,18 +5887,25 @@ func (client *ManagementClient) CreateDataResourceByTree(reqDto *dto.CreateTreeD
  * @returns ListDataResourcesPaginatedRespDto
  */
 func (client *ManagementClient) ListDataResources(reqDto *dto.ListDataResourcesDto) *dto.ListDataResourcesPaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/list-data-resources", fasthttp.MethodGet, reqDto)
-	var response dto.ListDataResourcesPaginatedRespDto
+	response, err := client.ListDataResourcesWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.ListDataResourcesPaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ListDataResourcesWithContext 同 ListDataResources，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ListDataResourcesWithContext(ctx context.Context, reqDto *dto.ListDataResourcesDto) (*dto.ListDataResourcesPaginatedRespDto, error) {
+	var response dto.ListDataResourcesPaginatedRespDto
+	err := client.request(ctx, "/api/v3/list-data-resources", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -4593,18 +5916,25 @@ func (client *ManagementClient) ListDataResources(reqDto *dto.ListDataResourcesD
  * @returns GetDataResourceResponseDto
  */
 func (client *ManagementClient) GetDataResource(reqDto *dto.GetDataResourceDto) *dto.GetDataResourceResponseDto {
-	b, err := client.SendHttpRequest("/api/v3/get-data-resource", fasthttp.MethodGet, reqDto)
-	var response dto.GetDataResourceResponseDto
+	response, err := client.GetDataResourceWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.GetDataResourceResponseDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetDataResourceWithContext 同 GetDataResource，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetDataResourceWithContext(ctx context.Context, reqDto *dto.GetDataResourceDto) (*dto.GetDataResourceResponseDto, error) {
+	var response dto.GetDataResourceResponseDto
+	err := client.request(ctx, "/api/v3/get-data-resource", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -4614,18 +5944,25 @@ func (client *ManagementClient) GetDataResource(reqDto *dto.GetDataResourceDto)
  * @returns UpdateDataResourceResponseDto
  */
 func (client *ManagementClient) UpdateDataResource(reqDto *dto.UpdateDataResourceDto) *dto.UpdateDataResourceResponseDto {
-	b, err := client.SendHttpRequest("/api/v3/update-data-resource", fasthttp.MethodPost, reqDto)
-	var response dto.UpdateDataResourceResponseDto
+	response, err := client.UpdateDataResourceWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.UpdateDataResourceResponseDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// UpdateDataResourceWithContext 同 UpdateDataResource，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) UpdateDataResourceWithContext(ctx context.Context, reqDto *dto.UpdateDataResourceDto) (*dto.UpdateDataResourceResponseDto, error) {
+	var response dto.UpdateDataResourceResponseDto
+	err := client.request(ctx, "/api/v3/update-data-resource", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -4635,18 +5972,25 @@ func (client *ManagementClient) UpdateDataResource(reqDto *dto.UpdateDataResourc
  * @returns CommonResponseDto
  */
 func (client *ManagementClient) DeleteDataResource(reqDto *dto.DeleteDataResourceDto) *dto.CommonResponseDto {
-	b, err := client.SendHttpRequest("/api/v3/delete-data-resource", fasthttp.MethodPost, reqDto)
-	var response dto.CommonResponseDto
+	response, err := client.DeleteDataResourceWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CommonResponseDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// DeleteDataResourceWithContext 同 DeleteDataResource，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) DeleteDataResourceWithContext(ctx context.Context, reqDto *dto.DeleteDataResourceDto) (*dto.CommonResponseDto, error) {
+	var response dto.CommonResponseDto
+	err := client.request(ctx, "/api/v3/delete-data-resource", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -4733,18 +6077,25 @@ func (client *ManagementClient) DeleteDataResource(reqDto *dto.DeleteDataResourc
                                        * @returns CheckParamsDataResourceResponseDto
 */
 func (client *ManagementClient) CheckDataResourceExists(reqDto *dto.CheckDataResourceExistsDto) *dto.CheckParamsDataResourceResponseDto {
-	b, err := client.SendHttpRequest("/api/v3/check-data-resource-exists", fasthttp.MethodGet, reqDto)
-	var response dto.CheckParamsDataResourceResponseDto
+	response, err := client.CheckDataResourceExistsWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CheckParamsDataResourceResponseDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// CheckDataResourceExistsWithContext 同 CheckDataResourceExists，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) CheckDataResourceExistsWithContext(ctx context.Context, reqDto *dto.CheckDataResourceExistsDto) (*dto.CheckParamsDataResourceResponseDto, error) {
+	var response dto.CheckParamsDataResourceResponseDto
+	err := client.request(ctx, "/api/v3/check-data-resource-exists", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -4781,18 +6132,25 @@ func (client *ManagementClient) CheckDataResourceExists(reqDto *dto.CheckDataRes
                            * @returns CreateDataPolicyResponseDto
 */
 func (client *ManagementClient) CreateDataPolicy(reqDto *dto.CreateDataPolicyDto) *dto.CreateDataPolicyResponseDto {
-	b, err := client.SendHttpRequest("/api/v3/create-data-policy", fasthttp.MethodPost, reqDto)
-	var response dto.CreateDataPolicyResponseDto
+	response, err := client.CreateDataPolicyWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CreateDataPolicyResponseDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// CreateDataPolicyWithContext 同 CreateDataPolicy，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) CreateDataPolicyWithContext(ctx context.Context, reqDto *dto.CreateDataPolicyDto) (*dto.CreateDataPolicyResponseDto, error) {
+	var response dto.CreateDataPolicyResponseDto
+	err := client.request(ctx, "/api/v3/create-data-policy", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -4804,18 +6162,25 @@ func (client *ManagementClient) CreateDataPolicy(reqDto *dto.CreateDataPolicyDto
  * @returns ListDataPoliciesPaginatedRespDto
  */
 func (client *ManagementClient) ListDataPolices(reqDto *dto.ListDataPoliciesDto) *dto.ListDataPoliciesPaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/list-data-policies", fasthttp.MethodGet, reqDto)
-	var response dto.ListDataPoliciesPaginatedRespDto
+	response, err := client.ListDataPolicesWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.ListDataPoliciesPaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ListDataPolicesWithContext 同 ListDataPolices，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ListDataPolicesWithContext(ctx context.Context, reqDto *dto.ListDataPoliciesDto) (*dto.ListDataPoliciesPaginatedRespDto, error) {
+	var response dto.ListDataPoliciesPaginatedRespDto
+	err := client.request(ctx, "/api/v3/list-data-policies", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -4827,18 +6192,25 @@ func (client *ManagementClient) ListDataPolices(reqDto *dto.ListDataPoliciesDto)
  * @returns ListSimpleDataPoliciesPaginatedRespDto
  */
 func (client *ManagementClient) ListSimpleDataPolices(reqDto *dto.ListSimpleDataPoliciesDto) *dto.ListSimpleDataPoliciesPaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/list-simple-data-policies", fasthttp.MethodGet, reqDto)
-	var response dto.ListSimpleDataPoliciesPaginatedRespDto
+	response, err := client.ListSimpleDataPolicesWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.ListSimpleDataPoliciesPaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ListSimpleDataPolicesWithContext 同 ListSimpleDataPolices，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ListSimpleDataPolicesWithContext(ctx context.Context, reqDto *dto.ListSimpleDataPoliciesDto) (*dto.ListSimpleDataPoliciesPaginatedRespDto, error) {
+	var response dto.ListSimpleDataPoliciesPaginatedRespDto
+	err := client.request(ctx, "/api/v3/list-simple-data-policies", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -4848,18 +6220,25 @@ func (client *ManagementClient) ListSimpleDataPolices(reqDto *dto.ListSimpleData
  * @returns GetDataPolicyResponseDto
  */
 func (client *ManagementClient) GetDataPolicy(reqDto *dto.GetDataPolicyDto) *dto.GetDataPolicyResponseDto {
-	b, err := client.SendHttpRequest("/api/v3/get-data-policy", fasthttp.MethodGet, reqDto)
-	var response dto.GetDataPolicyResponseDto
+	response, err := client.GetDataPolicyWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.GetDataPolicyResponseDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetDataPolicyWithContext 同 GetDataPolicy，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetDataPolicyWithContext(ctx context.Context, reqDto *dto.GetDataPolicyDto) (*dto.GetDataPolicyResponseDto, error) {
+	var response dto.GetDataPolicyResponseDto
+	err := client.request(ctx, "/api/v3/get-data-policy", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -4869,18 +6248,25 @@ func (client *ManagementClient) GetDataPolicy(reqDto *dto.GetDataPolicyDto) *dto
  * @returns UpdateDataPolicyResponseDto
  */
 func (client *ManagementClient) UpdateDataPolicy(reqDto *dto.UpdateDataPolicyDto) *dto.UpdateDataPolicyResponseDto {
-	b, err := client.SendHttpRequest("/api/v3/update-data-policy", fasthttp.MethodPost, reqDto)
-	var response dto.UpdateDataPolicyResponseDto
+	response, err := client.UpdateDataPolicyWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.UpdateDataPolicyResponseDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// UpdateDataPolicyWithContext 同 UpdateDataPolicy，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) UpdateDataPolicyWithContext(ctx context.Context, reqDto *dto.UpdateDataPolicyDto) (*dto.UpdateDataPolicyResponseDto, error) {
+	var response dto.UpdateDataPolicyResponseDto
+	err := client.request(ctx, "/api/v3/update-data-policy", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -4890,18 +6276,25 @@ func (client *ManagementClient) UpdateDataPolicy(reqDto *dto.UpdateDataPolicyDto
  * @returns CommonResponseDto
  */
 func (client *ManagementClient) DeleteDataPolicy(reqDto *dto.DeleteDataPolicyDto) *dto.CommonResponseDto {
-	b, err := client.SendHttpRequest("/api/v3/delete-data-policy", fasthttp.MethodPost, reqDto)
-	var response dto.CommonResponseDto
+	response, err := client.DeleteDataPolicyWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CommonResponseDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// DeleteDataPolicyWithContext 同 DeleteDataPolicy，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) DeleteDataPolicyWithContext(ctx context.Context, reqDto *dto.DeleteDataPolicyDto) (*dto.CommonResponseDto, error) {
+	var response dto.CommonResponseDto
+	err := client.request(ctx, "/api/v3/delete-data-policy", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -4911,18 +6304,25 @@ func (client *ManagementClient) DeleteDataPolicy(reqDto *dto.DeleteDataPolicyDto
  * @returns CheckParamsDataPolicyResponseDto
  */
 func (client *ManagementClient) CheckDataPolicyExists(reqDto *dto.CheckDataPolicyExistsDto) *dto.CheckParamsDataPolicyResponseDto {
-	b, err := client.SendHttpRequest("/api/v3/check-data-policy-exists", fasthttp.MethodGet, reqDto)
-	var response dto.CheckParamsDataPolicyResponseDto
+	response, err := client.CheckDataPolicyExistsWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CheckParamsDataPolicyResponseDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// CheckDataPolicyExistsWithContext 同 CheckDataPolicyExists，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) CheckDataPolicyExistsWithContext(ctx context.Context, reqDto *dto.CheckDataPolicyExistsDto) (*dto.CheckParamsDataPolicyResponseDto, error) {
+	var response dto.CheckParamsDataPolicyResponseDto
+	err := client.request(ctx, "/api/v3/check-data-policy-exists", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -4936,18 +6336,25 @@ func (client *ManagementClient) CheckDataPolicyExists(reqDto *dto.CheckDataPolic
  * @returns ListDataPolicySubjectPaginatedRespDto
  */
 func (client *ManagementClient) ListDataPolicyTargets(reqDto *dto.ListDataPolicyTargetsDto) *dto.ListDataPolicySubjectPaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/list-data-policy-targets", fasthttp.MethodGet, reqDto)
-	var response dto.ListDataPolicySubjectPaginatedRespDto
+	response, err := client.ListDataPolicyTargetsWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.ListDataPolicySubjectPaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ListDataPolicyTargetsWithContext 同 ListDataPolicyTargets，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ListDataPolicyTargetsWithContext(ctx context.Context, reqDto *dto.ListDataPolicyTargetsDto) (*dto.ListDataPolicySubjectPaginatedRespDto, error) {
+	var response dto.ListDataPolicySubjectPaginatedRespDto
+	err := client.request(ctx, "/api/v3/list-data-policy-targets", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -4957,18 +6364,25 @@ func (client *ManagementClient) ListDataPolicyTargets(reqDto *dto.ListDataPolicy
  * @returns CommonResponseDto
  */
 func (client *ManagementClient) AuthorizeDataPolicies(reqDto *dto.CreateAuthorizeDataPolicyDto) *dto.CommonResponseDto {
-	b, err := client.SendHttpRequest("/api/v3/authorize-data-policies", fasthttp.MethodPost, reqDto)
-	var response dto.CommonResponseDto
+	response, err := client.AuthorizeDataPoliciesWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CommonResponseDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// AuthorizeDataPoliciesWithContext 同 AuthorizeDataPolicies，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) AuthorizeDataPoliciesWithContext(ctx context.Context, reqDto *dto.CreateAuthorizeDataPolicyDto) (*dto.CommonResponseDto, error) {
+	var response dto.CommonResponseDto
+	err := client.request(ctx, "/api/v3/authorize-data-policies", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -4978,18 +6392,25 @@ func (client *ManagementClient) AuthorizeDataPolicies(reqDto *dto.CreateAuthoriz
  * @returns CommonResponseDto
  */
 func (client *ManagementClient) RevokeDataPolicy(reqDto *dto.DeleteAuthorizeDataPolicyDto) *dto.CommonResponseDto {
-	b, err := client.SendHttpRequest("/api/v3/revoke-data-policy", fasthttp.MethodPost, reqDto)
-	var response dto.CommonResponseDto
+	response, err := client.RevokeDataPolicyWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CommonResponseDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// RevokeDataPolicyWithContext 同 RevokeDataPolicy，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) RevokeDataPolicyWithContext(ctx context.Context, reqDto *dto.DeleteAuthorizeDataPolicyDto) (*dto.CommonResponseDto, error) {
+	var response dto.CommonResponseDto
+	err := client.request(ctx, "/api/v3/revoke-data-policy", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -5235,18 +6656,25 @@ func (client *ManagementClient) RevokeDataPolicy(reqDto *dto.DeleteAuthorizeData
                                                                                                                                                                                                                                    * @returns GetUserPermissionListRespDto
 */
 func (client *ManagementClient) GetUserPermissionList(reqDto *dto.GetUserPermissionListDto) *dto.GetUserPermissionListRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-user-permission-list", fasthttp.MethodPost, reqDto)
-	var response dto.GetUserPermissionListRespDto
+	response, err := client.GetUserPermissionListWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.GetUserPermissionListRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetUserPermissionListWithContext 同 GetUserPermissionList，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetUserPermissionListWithContext(ctx context.Context, reqDto *dto.GetUserPermissionListDto) (*dto.GetUserPermissionListRespDto, error) {
+	var response dto.GetUserPermissionListRespDto
+	err := client.request(ctx, "/api/v3/get-user-permission-list", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -5382,18 +6810,25 @@ func (client *ManagementClient) GetUserPermissionList(reqDto *dto.GetUserPermiss
                                                                            * @returns CheckPermissionRespDto
 */
 func (client *ManagementClient) CheckPermission(reqDto *dto.CheckPermissionDto) *dto.CheckPermissionRespDto {
-	b, err := client.SendHttpRequest("/api/v3/check-permission", fasthttp.MethodPost, reqDto)
-	var response dto.CheckPermissionRespDto
+	response, err := client.CheckPermissionWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CheckPermissionRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// CheckPermissionWithContext 同 CheckPermission，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) CheckPermissionWithContext(ctx context.Context, reqDto *dto.CheckPermissionDto) (*dto.CheckPermissionRespDto, error) {
+	var response dto.CheckPermissionRespDto
+	err := client.request(ctx, "/api/v3/check-permission", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -5403,18 +6838,25 @@ func (client *ManagementClient) CheckPermission(reqDto *dto.CheckPermissionDto)
  * @returns CheckExternalUserPermissionRespDto
  */
 func (client *ManagementClient) CheckExternalUserPermission(reqDto *dto.CheckExternalUserPermissionDto) *dto.CheckExternalUserPermissionRespDto {
-	b, err := client.SendHttpRequest("/api/v3/check-external-user-permission", fasthttp.MethodPost, reqDto)
-	var response dto.CheckExternalUserPermissionRespDto
+	response, err := client.CheckExternalUserPermissionWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CheckExternalUserPermissionRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// CheckExternalUserPermissionWithContext 同 CheckExternalUserPermission，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) CheckExternalUserPermissionWithContext(ctx context.Context, reqDto *dto.CheckExternalUserPermissionDto) (*dto.CheckExternalUserPermissionRespDto, error) {
+	var response dto.CheckExternalUserPermissionRespDto
+	err := client.request(ctx, "/api/v3/check-external-user-permission", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -5492,18 +6934,25 @@ func (client *ManagementClient) CheckExternalUserPermission(reqDto *dto.CheckExt
                                            * @returns GetUserResourcePermissionListRespDto
 */
 func (client *ManagementClient) GetUserResourcePermissionList(reqDto *dto.GetUserResourcePermissionListDto) *dto.GetUserResourcePermissionListRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-user-resource-permission-list", fasthttp.MethodPost, reqDto)
-	var response dto.GetUserResourcePermissionListRespDto
+	response, err := client.GetUserResourcePermissionListWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.GetUserResourcePermissionListRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetUserResourcePermissionListWithContext 同 GetUserResourcePermissionList，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetUserResourcePermissionListWithContext(ctx context.Context, reqDto *dto.GetUserResourcePermissionListDto) (*dto.GetUserResourcePermissionListRespDto, error) {
+	var response dto.GetUserResourcePermissionListRespDto
+	err := client.request(ctx, "/api/v3/get-user-resource-permission-list", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -5612,18 +7061,25 @@ func (client *ManagementClient) GetUserResourcePermissionList(reqDto *dto.GetUse
                                                                                            * @returns ListResourceTargetsRespDto
 */
 func (client *ManagementClient) ListResourceTargets(reqDto *dto.ListResourceTargetsDto) *dto.ListResourceTargetsRespDto {
-	b, err := client.SendHttpRequest("/api/v3/list-resource-targets", fasthttp.MethodPost, reqDto)
-	var response dto.ListResourceTargetsRespDto
+	response, err := client.ListResourceTargetsWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.ListResourceTargetsRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ListResourceTargetsWithContext 同 ListResourceTargets，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ListResourceTargetsWithContext(ctx context.Context, reqDto *dto.ListResourceTargetsDto) (*dto.ListResourceTargetsRespDto, error) {
+	var response dto.ListResourceTargetsRespDto
+	err := client.request(ctx, "/api/v3/list-resource-targets", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -5744,18 +7200,25 @@ func (client *ManagementClient) ListResourceTargets(reqDto *dto.ListResourceTarg
                                                                * @returns GetUserResourceStructRespDto
 */
 func (client *ManagementClient) GetUserResourceStruct(reqDto *dto.GetUserResourceStructDto) *dto.GetUserResourceStructRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-user-resource-struct", fasthttp.MethodPost, reqDto)
-	var response dto.GetUserResourceStructRespDto
+	response, err := client.GetUserResourceStructWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.GetUserResourceStructRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetUserResourceStructWithContext 同 GetUserResourceStruct，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetUserResourceStructWithContext(ctx context.Context, reqDto *dto.GetUserResourceStructDto) (*dto.GetUserResourceStructRespDto, error) {
+	var response dto.GetUserResourceStructRespDto
+	err := client.request(ctx, "/api/v3/get-user-resource-struct", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -5877,18 +7340,25 @@ func (client *ManagementClient) GetUserResourceStruct(reqDto *dto.GetUserResourc
                                                                * @returns GetExternalUserResourceStructRespDto
 */
 func (client *ManagementClient) GetExternalUserResourceStruct(reqDto *dto.GetExternalUserResourceStructDto) *dto.GetExternalUserResourceStructRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-external-user-resource-struct", fasthttp.MethodPost, reqDto)
-	var response dto.GetExternalUserResourceStructRespDto
+	response, err := client.GetExternalUserResourceStructWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.GetExternalUserResourceStructRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetExternalUserResourceStructWithContext 同 GetExternalUserResourceStruct，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetExternalUserResourceStructWithContext(ctx context.Context, reqDto *dto.GetExternalUserResourceStructDto) (*dto.GetExternalUserResourceStructRespDto, error) {
+	var response dto.GetExternalUserResourceStructRespDto
+	err := client.request(ctx, "/api/v3/get-external-user-resource-struct", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -5935,18 +7405,25 @@ func (client *ManagementClient) GetExternalUserResourceStruct(reqDto *dto.GetExt
                * @returns CheckUserSameLevelPermissionResponseDto
 */
 func (client *ManagementClient) CheckUserSameLevelPermission(reqDto *dto.CheckUserSameLevelPermissionDto) *dto.CheckUserSameLevelPermissionResponseDto {
-	b, err := client.SendHttpRequest("/api/v3/check-user-same-level-permission", fasthttp.MethodPost, reqDto)
-	var response dto.CheckUserSameLevelPermissionResponseDto
+	response, err := client.CheckUserSameLevelPermissionWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CheckUserSameLevelPermissionResponseDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// CheckUserSameLevelPermissionWithContext 同 CheckUserSameLevelPermission，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) CheckUserSameLevelPermissionWithContext(ctx context.Context, reqDto *dto.CheckUserSameLevelPermissionDto) (*dto.CheckUserSameLevelPermissionResponseDto, error) {
+	var response dto.CheckUserSameLevelPermissionResponseDto
+	err := client.request(ctx, "/api/v3/check-user-same-level-permission", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -5955,18 +7432,25 @@ func (client *ManagementClient) CheckUserSameLevelPermission(reqDto *dto.CheckUs
  * @returns CostGetCurrentPackageRespDto
  */
 func (client *ManagementClient) GetCurrentPackageInfo() *dto.CostGetCurrentPackageRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-current-package-info", fasthttp.MethodGet, nil)
-	var response dto.CostGetCurrentPackageRespDto
+	response, err := client.GetCurrentPackageInfoWithContext(context.Background())
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CostGetCurrentPackageRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetCurrentPackageInfoWithContext 同 GetCurrentPackageInfo，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetCurrentPackageInfoWithContext(ctx context.Context) (*dto.CostGetCurrentPackageRespDto, error) {
+	var response dto.CostGetCurrentPackageRespDto
+	err := client.request(ctx, "/api/v3/get-current-package-info", fasthttp.MethodGet, nil, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -5975,18 +7459,25 @@ func (client *ManagementClient) GetCurrentPackageInfo() *dto.CostGetCurrentPacka
  * @returns CostGetCurrentUsageRespDto
  */
 func (client *ManagementClient) GetUsageInfo() *dto.CostGetCurrentUsageRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-usage-info", fasthttp.MethodGet, nil)
-	var response dto.CostGetCurrentUsageRespDto
+	response, err := client.GetUsageInfoWithContext(context.Background())
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CostGetCurrentUsageRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetUsageInfoWithContext 同 GetUsageInfo，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetUsageInfoWithContext(ctx context.Context) (*dto.CostGetCurrentUsageRespDto, error) {
+	var response dto.CostGetCurrentUsageRespDto
+	err := client.request(ctx, "/api/v3/get-usage-info", fasthttp.MethodGet, nil, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -5997,18 +7488,25 @@ func (client *ManagementClient) GetUsageInfo() *dto.CostGetCurrentUsageRespDto {
  * @returns CostGetMauPeriodUsageHistoryRespDto
  */
 func (client *ManagementClient) GetMauPeriodUsageHistory(reqDto *dto.GetMauPeriodUsageHistoryDto) *dto.CostGetMauPeriodUsageHistoryRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-mau-period-usage-history", fasthttp.MethodGet, reqDto)
-	var response dto.CostGetMauPeriodUsageHistoryRespDto
+	response, err := client.GetMauPeriodUsageHistoryWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CostGetMauPeriodUsageHistoryRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetMauPeriodUsageHistoryWithContext 同 GetMauPeriodUsageHistory，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetMauPeriodUsageHistoryWithContext(ctx context.Context, reqDto *dto.GetMauPeriodUsageHistoryDto) (*dto.CostGetMauPeriodUsageHistoryRespDto, error) {
+	var response dto.CostGetMauPeriodUsageHistoryRespDto
+	err := client.request(ctx, "/api/v3/get-mau-period-usage-history", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -6017,18 +7515,25 @@ func (client *ManagementClient) GetMauPeriodUsageHistory(reqDto *dto.GetMauPerio
  * @returns CostGetAllRightItemRespDto
  */
 func (client *ManagementClient) GetAllRightsItem() *dto.CostGetAllRightItemRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-all-rights-items", fasthttp.MethodGet, nil)
-	var response dto.CostGetAllRightItemRespDto
+	response, err := client.GetAllRightsItemWithContext(context.Background())
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CostGetAllRightItemRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetAllRightsItemWithContext 同 GetAllRightsItem，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetAllRightsItemWithContext(ctx context.Context) (*dto.CostGetAllRightItemRespDto, error) {
+	var response dto.CostGetAllRightItemRespDto
+	err := client.request(ctx, "/api/v3/get-all-rights-items", fasthttp.MethodGet, nil, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -6039,18 +7544,25 @@ func (client *ManagementClient) GetAllRightsItem() *dto.CostGetAllRightItemRespD
  * @returns CostGetOrdersRespDto
  */
 func (client *ManagementClient) GetOrders(reqDto *dto.GetOrdersDto) *dto.CostGetOrdersRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-orders", fasthttp.MethodGet, reqDto)
-	var response dto.CostGetOrdersRespDto
+	response, err := client.GetOrdersWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CostGetOrdersRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetOrdersWithContext 同 GetOrders，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetOrdersWithContext(ctx context.Context, reqDto *dto.GetOrdersDto) (*dto.CostGetOrdersRespDto, error) {
+	var response dto.CostGetOrdersRespDto
+	err := client.request(ctx, "/api/v3/get-orders", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -6060,18 +7572,25 @@ func (client *ManagementClient) GetOrders(reqDto *dto.GetOrdersDto) *dto.CostGet
  * @returns CostGetOrderDetailRespDto
  */
 func (client *ManagementClient) GetOrderDetail(reqDto *dto.GetOrderDetailDto) *dto.CostGetOrderDetailRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-order-detail", fasthttp.MethodGet, reqDto)
-	var response dto.CostGetOrderDetailRespDto
+	response, err := client.GetOrderDetailWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CostGetOrderDetailRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetOrderDetailWithContext 同 GetOrderDetail，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetOrderDetailWithContext(ctx context.Context, reqDto *dto.GetOrderDetailDto) (*dto.CostGetOrderDetailRespDto, error) {
+	var response dto.CostGetOrderDetailRespDto
+	err := client.request(ctx, "/api/v3/get-order-detail", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -6081,18 +7600,25 @@ func (client *ManagementClient) GetOrderDetail(reqDto *dto.GetOrderDetailDto) *d
  * @returns CostGetOrderPayDetailRespDto
  */
 func (client *ManagementClient) GetOrderPayDetail(reqDto *dto.GetOrderPayDetailDto) *dto.CostGetOrderPayDetailRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-order-pay-detail", fasthttp.MethodGet, reqDto)
-	var response dto.CostGetOrderPayDetailRespDto
+	response, err := client.GetOrderPayDetailWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CostGetOrderPayDetailRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetOrderPayDetailWithContext 同 GetOrderPayDetail，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetOrderPayDetailWithContext(ctx context.Context, reqDto *dto.GetOrderPayDetailDto) (*dto.CostGetOrderPayDetailRespDto, error) {
+	var response dto.CostGetOrderPayDetailRespDto
+	err := client.request(ctx, "/api/v3/get-order-pay-detail", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -6102,18 +7628,25 @@ func (client *ManagementClient) GetOrderPayDetail(reqDto *dto.GetOrderPayDetailD
  * @returns PipelineFunctionSingleRespDto
  */
 func (client *ManagementClient) CreatePipelineFunction(reqDto *dto.CreatePipelineFunctionDto) *dto.PipelineFunctionSingleRespDto {
-	b, err := client.SendHttpRequest("/api/v3/create-pipeline-function", fasthttp.MethodPost, reqDto)
-	var response dto.PipelineFunctionSingleRespDto
+	response, err := client.CreatePipelineFunctionWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.PipelineFunctionSingleRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// CreatePipelineFunctionWithContext 同 CreatePipelineFunction，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) CreatePipelineFunctionWithContext(ctx context.Context, reqDto *dto.CreatePipelineFunctionDto) (*dto.PipelineFunctionSingleRespDto, error) {
+	var response dto.PipelineFunctionSingleRespDto
+	err := client.request(ctx, "/api/v3/create-pipeline-function", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -6123,18 +7656,25 @@ func (client *ManagementClient) CreatePipelineFunction(reqDto *dto.CreatePipelin
  * @returns PipelineFunctionSingleRespDto
  */
 func (client *ManagementClient) GetPipelineFunction(reqDto *dto.GetPipelineFunctionDto) *dto.PipelineFunctionSingleRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-pipeline-function", fasthttp.MethodGet, reqDto)
-	var response dto.PipelineFunctionSingleRespDto
+	response, err := client.GetPipelineFunctionWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.PipelineFunctionSingleRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetPipelineFunctionWithContext 同 GetPipelineFunction，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetPipelineFunctionWithContext(ctx context.Context, reqDto *dto.GetPipelineFunctionDto) (*dto.PipelineFunctionSingleRespDto, error) {
+	var response dto.PipelineFunctionSingleRespDto
+	err := client.request(ctx, "/api/v3/get-pipeline-function", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -6144,18 +7684,25 @@ func (client *ManagementClient) GetPipelineFunction(reqDto *dto.GetPipelineFunct
  * @returns PipelineFunctionSingleRespDto
  */
 func (client *ManagementClient) ReuploadPipelineFunction(reqDto *dto.ReUploadPipelineFunctionDto) *dto.PipelineFunctionSingleRespDto {
-	b, err := client.SendHttpRequest("/api/v3/reupload-pipeline-function", fasthttp.MethodPost, reqDto)
-	var response dto.PipelineFunctionSingleRespDto
+	response, err := client.ReuploadPipelineFunctionWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.PipelineFunctionSingleRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ReuploadPipelineFunctionWithContext 同 ReuploadPipelineFunction，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ReuploadPipelineFunctionWithContext(ctx context.Context, reqDto *dto.ReUploadPipelineFunctionDto) (*dto.PipelineFunctionSingleRespDto, error) {
+	var response dto.PipelineFunctionSingleRespDto
+	err := client.request(ctx, "/api/v3/reupload-pipeline-function", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -6165,18 +7712,25 @@ func (client *ManagementClient) ReuploadPipelineFunction(reqDto *dto.ReUploadPip
  * @returns PipelineFunctionSingleRespDto
  */
 func (client *ManagementClient) UpdatePipelineFunction(reqDto *dto.UpdatePipelineFunctionDto) *dto.PipelineFunctionSingleRespDto {
-	b, err := client.SendHttpRequest("/api/v3/update-pipeline-function", fasthttp.MethodPost, reqDto)
-	var response dto.PipelineFunctionSingleRespDto
+	response, err := client.UpdatePipelineFunctionWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.PipelineFunctionSingleRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// UpdatePipelineFunctionWithContext 同 UpdatePipelineFunction，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) UpdatePipelineFunctionWithContext(ctx context.Context, reqDto *dto.UpdatePipelineFunctionDto) (*dto.PipelineFunctionSingleRespDto, error) {
+	var response dto.PipelineFunctionSingleRespDto
+	err := client.request(ctx, "/api/v3/update-pipeline-function", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -6186,18 +7740,25 @@ func (client *ManagementClient) UpdatePipelineFunction(reqDto *dto.UpdatePipelin
  * @returns CommonResponseDto
  */
 func (client *ManagementClient) UpdatePipelineOrder(reqDto *dto.UpdatePipelineOrderDto) *dto.CommonResponseDto {
-	b, err := client.SendHttpRequest("/api/v3/update-pipeline-order", fasthttp.MethodPost, reqDto)
-	var response dto.CommonResponseDto
+	response, err := client.UpdatePipelineOrderWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CommonResponseDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// UpdatePipelineOrderWithContext 同 UpdatePipelineOrder，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) UpdatePipelineOrderWithContext(ctx context.Context, reqDto *dto.UpdatePipelineOrderDto) (*dto.CommonResponseDto, error) {
+	var response dto.CommonResponseDto
+	err := client.request(ctx, "/api/v3/update-pipeline-order", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -6207,18 +7768,25 @@ func (client *ManagementClient) UpdatePipelineOrder(reqDto *dto.UpdatePipelineOr
  * @returns CommonResponseDto
  */
 func (client *ManagementClient) DeletePipelineFunction(reqDto *dto.DeletePipelineFunctionDto) *dto.CommonResponseDto {
-	b, err := client.SendHttpRequest("/api/v3/delete-pipeline-function", fasthttp.MethodPost, reqDto)
-	var response dto.CommonResponseDto
+	response, err := client.DeletePipelineFunctionWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CommonResponseDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// DeletePipelineFunctionWithContext 同 DeletePipelineFunction，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) DeletePipelineFunctionWithContext(ctx context.Context, reqDto *dto.DeletePipelineFunctionDto) (*dto.CommonResponseDto, error) {
+	var response dto.CommonResponseDto
+	err := client.request(ctx, "/api/v3/delete-pipeline-function", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -6236,18 +7804,25 @@ func (client *ManagementClient) DeletePipelineFunction(reqDto *dto.DeletePipelin
  * @returns PipelineFunctionPaginatedRespDto
  */
 func (client *ManagementClient) ListPipelineFunctions(reqDto *dto.ListPipelineFunctionsDto) *dto.PipelineFunctionPaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/list-pipeline-functions", fasthttp.MethodGet, reqDto)
-	var response dto.PipelineFunctionPaginatedRespDto
+	response, err := client.ListPipelineFunctionsWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.PipelineFunctionPaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ListPipelineFunctionsWithContext 同 ListPipelineFunctions，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ListPipelineFunctionsWithContext(ctx context.Context, reqDto *dto.ListPipelineFunctionsDto) (*dto.PipelineFunctionPaginatedRespDto, error) {
+	var response dto.PipelineFunctionPaginatedRespDto
+	err := client.request(ctx, "/api/v3/list-pipeline-functions", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -6259,18 +7834,25 @@ func (client *ManagementClient) ListPipelineFunctions(reqDto *dto.ListPipelineFu
  * @returns PipelineFunctionPaginatedRespDto
  */
 func (client *ManagementClient) GetPipelineLogs(reqDto *dto.GetPipelineLogsDto) *dto.PipelineFunctionPaginatedRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-pipeline-logs", fasthttp.MethodGet, reqDto)
-	var response dto.PipelineFunctionPaginatedRespDto
+	response, err := client.GetPipelineLogsWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.PipelineFunctionPaginatedRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetPipelineLogsWithContext 同 GetPipelineLogs，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetPipelineLogsWithContext(ctx context.Context, reqDto *dto.GetPipelineLogsDto) (*dto.PipelineFunctionPaginatedRespDto, error) {
+	var response dto.PipelineFunctionPaginatedRespDto
+	err := client.request(ctx, "/api/v3/get-pipeline-logs", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -6280,18 +7862,25 @@ func (client *ManagementClient) GetPipelineLogs(reqDto *dto.GetPipelineLogsDto)
  * @returns CreateWebhookRespDto
  */
 func (client *ManagementClient) CreateWebhook(reqDto *dto.CreateWebhookDto) *dto.CreateWebhookRespDto {
-	b, err := client.SendHttpRequest("/api/v3/create-webhook", fasthttp.MethodPost, reqDto)
-	var response dto.CreateWebhookRespDto
+	response, err := client.CreateWebhookWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CreateWebhookRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// CreateWebhookWithContext 同 CreateWebhook，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) CreateWebhookWithContext(ctx context.Context, reqDto *dto.CreateWebhookDto) (*dto.CreateWebhookRespDto, error) {
+	var response dto.CreateWebhookRespDto
+	err := client.request(ctx, "/api/v3/create-webhook", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -6302,18 +7891,25 @@ func (client *ManagementClient) CreateWebhook(reqDto *dto.CreateWebhookDto) *dto
  * @returns GetWebhooksRespDto
  */
 func (client *ManagementClient) ListWebhooks(reqDto *dto.ListWebhooksDto) *dto.GetWebhooksRespDto {
-	b, err := client.SendHttpRequest("/api/v3/list-webhooks", fasthttp.MethodGet, reqDto)
-	var response dto.GetWebhooksRespDto
+	response, err := client.ListWebhooksWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.GetWebhooksRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// ListWebhooksWithContext 同 ListWebhooks，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) ListWebhooksWithContext(ctx context.Context, reqDto *dto.ListWebhooksDto) (*dto.GetWebhooksRespDto, error) {
+	var response dto.GetWebhooksRespDto
+	err := client.request(ctx, "/api/v3/list-webhooks", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -6323,18 +7919,25 @@ func (client *ManagementClient) ListWebhooks(reqDto *dto.ListWebhooksDto) *dto.G
  * @returns UpdateWebhooksRespDto
  */
 func (client *ManagementClient) UpdateWebhook(reqDto *dto.UpdateWebhookDto) *dto.UpdateWebhooksRespDto {
-	b, err := client.SendHttpRequest("/api/v3/update-webhook", fasthttp.MethodPost, reqDto)
-	var response dto.UpdateWebhooksRespDto
+	response, err := client.UpdateWebhookWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.UpdateWebhooksRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// UpdateWebhookWithContext 同 UpdateWebhook，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) UpdateWebhookWithContext(ctx context.Context, reqDto *dto.UpdateWebhookDto) (*dto.UpdateWebhooksRespDto, error) {
+	var response dto.UpdateWebhooksRespDto
+	err := client.request(ctx, "/api/v3/update-webhook", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -6344,18 +7947,25 @@ func (client *ManagementClient) UpdateWebhook(reqDto *dto.UpdateWebhookDto) *dto
  * @returns DeleteWebhookRespDto
  */
 func (client *ManagementClient) DeleteWebhook(reqDto *dto.DeleteWebhookDto) *dto.DeleteWebhookRespDto {
-	b, err := client.SendHttpRequest("/api/v3/delete-webhook", fasthttp.MethodPost, reqDto)
-	var response dto.DeleteWebhookRespDto
+	response, err := client.DeleteWebhookWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.DeleteWebhookRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// DeleteWebhookWithContext 同 DeleteWebhook，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) DeleteWebhookWithContext(ctx context.Context, reqDto *dto.DeleteWebhookDto) (*dto.DeleteWebhookRespDto, error) {
+	var response dto.DeleteWebhookRespDto
+	err := client.request(ctx, "/api/v3/delete-webhook", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -6365,18 +7975,25 @@ func (client *ManagementClient) DeleteWebhook(reqDto *dto.DeleteWebhookDto) *dto
  * @returns ListWebhookLogsRespDto
  */
 func (client *ManagementClient) GetWebhookLogs(reqDto *dto.ListWebhookLogs) *dto.ListWebhookLogsRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-webhook-logs", fasthttp.MethodPost, reqDto)
-	var response dto.ListWebhookLogsRespDto
+	response, err := client.GetWebhookLogsWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.ListWebhookLogsRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetWebhookLogsWithContext 同 GetWebhookLogs，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetWebhookLogsWithContext(ctx context.Context, reqDto *dto.ListWebhookLogs) (*dto.ListWebhookLogsRespDto, error) {
+	var response dto.ListWebhookLogsRespDto
+	err := client.request(ctx, "/api/v3/get-webhook-logs", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -6386,18 +8003,25 @@ func (client *ManagementClient) GetWebhookLogs(reqDto *dto.ListWebhookLogs) *dto
  * @returns TriggerWebhookRespDto
  */
 func (client *ManagementClient) TriggerWebhook(reqDto *dto.TriggerWebhookDto) *dto.TriggerWebhookRespDto {
-	b, err := client.SendHttpRequest("/api/v3/trigger-webhook", fasthttp.MethodPost, reqDto)
-	var response dto.TriggerWebhookRespDto
+	response, err := client.TriggerWebhookWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.TriggerWebhookRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// TriggerWebhookWithContext 同 TriggerWebhook，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) TriggerWebhookWithContext(ctx context.Context, reqDto *dto.TriggerWebhookDto) (*dto.TriggerWebhookRespDto, error) {
+	var response dto.TriggerWebhookRespDto
+	err := client.request(ctx, "/api/v3/trigger-webhook", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -6407,18 +8031,25 @@ func (client *ManagementClient) TriggerWebhook(reqDto *dto.TriggerWebhookDto) *d
  * @returns GetWebhookRespDto
  */
 func (client *ManagementClient) GetWebhook(reqDto *dto.GetWebhookDto) *dto.GetWebhookRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-webhook", fasthttp.MethodGet, reqDto)
-	var response dto.GetWebhookRespDto
+	response, err := client.GetWebhookWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.GetWebhookRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetWebhookWithContext 同 GetWebhook，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetWebhookWithContext(ctx context.Context, reqDto *dto.GetWebhookDto) (*dto.GetWebhookRespDto, error) {
+	var response dto.GetWebhookRespDto
+	err := client.request(ctx, "/api/v3/get-webhook", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -6427,18 +8058,25 @@ func (client *ManagementClient) GetWebhook(reqDto *dto.GetWebhookDto) *dto.GetWe
  * @returns WebhookEventListRespDto
  */
 func (client *ManagementClient) GetWebhookEventList() *dto.WebhookEventListRespDto {
-	b, err := client.SendHttpRequest("/api/v3/get-webhook-event-list", fasthttp.MethodGet, nil)
-	var response dto.WebhookEventListRespDto
+	response, err := client.GetWebhookEventListWithContext(context.Background())
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.WebhookEventListRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetWebhookEventListWithContext 同 GetWebhookEventList，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetWebhookEventListWithContext(ctx context.Context) (*dto.WebhookEventListRespDto, error) {
+	var response dto.WebhookEventListRespDto
+	err := client.request(ctx, "/api/v3/get-webhook-event-list", fasthttp.MethodGet, nil, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -6451,18 +8089,25 @@ func (client *ManagementClient) GetWebhookEventList() *dto.WebhookEventListRespD
  * @returns ListAccessKeyResponseDto
  */
 func (client *ManagementClient) GetAccessKeyList(reqDto *dto.ListAccessKeyDto) *dto.ListAccessKeyResponseDto {
-	b, err := client.SendHttpRequest("/api/v3/list-access-key", fasthttp.MethodGet, reqDto)
-	var response dto.ListAccessKeyResponseDto
+	response, err := client.GetAccessKeyListWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.ListAccessKeyResponseDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetAccessKeyListWithContext 同 GetAccessKeyList，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetAccessKeyListWithContext(ctx context.Context, reqDto *dto.ListAccessKeyDto) (*dto.ListAccessKeyResponseDto, error) {
+	var response dto.ListAccessKeyResponseDto
+	err := client.request(ctx, "/api/v3/list-access-key", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -6473,18 +8118,25 @@ func (client *ManagementClient) GetAccessKeyList(reqDto *dto.ListAccessKeyDto) *
  * @returns GetAccessKeyResponseDto
  */
 func (client *ManagementClient) GetAccessKey(reqDto *dto.GetAccessKeyDto) *dto.GetAccessKeyResponseDto {
-	b, err := client.SendHttpRequest("/api/v3/get-access-key", fasthttp.MethodGet, reqDto)
-	var response dto.GetAccessKeyResponseDto
+	response, err := client.GetAccessKeyWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.GetAccessKeyResponseDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// GetAccessKeyWithContext 同 GetAccessKey，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) GetAccessKeyWithContext(ctx context.Context, reqDto *dto.GetAccessKeyDto) (*dto.GetAccessKeyResponseDto, error) {
+	var response dto.GetAccessKeyResponseDto
+	err := client.request(ctx, "/api/v3/get-access-key", fasthttp.MethodGet, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -6494,18 +8146,25 @@ func (client *ManagementClient) GetAccessKey(reqDto *dto.GetAccessKeyDto) *dto.G
  * @returns CreateAccessKeyResponseDto
  */
 func (client *ManagementClient) CreateAccessKey(reqDto *dto.CreateAccessKeyDto) *dto.CreateAccessKeyResponseDto {
-	b, err := client.SendHttpRequest("/api/v3/create-access-key", fasthttp.MethodPost, reqDto)
-	var response dto.CreateAccessKeyResponseDto
+	response, err := client.CreateAccessKeyWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CreateAccessKeyResponseDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// CreateAccessKeyWithContext 同 CreateAccessKey，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) CreateAccessKeyWithContext(ctx context.Context, reqDto *dto.CreateAccessKeyDto) (*dto.CreateAccessKeyResponseDto, error) {
+	var response dto.CreateAccessKeyResponseDto
+	err := client.request(ctx, "/api/v3/create-access-key", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -6515,18 +8174,25 @@ func (client *ManagementClient) CreateAccessKey(reqDto *dto.CreateAccessKeyDto)
  * @returns CommonResponseDto
  */
 func (client *ManagementClient) DeleteAccessKey(reqDto *dto.DeleteAccessKeyDto) *dto.CommonResponseDto {
-	b, err := client.SendHttpRequest("/api/v3/delete-access-key", fasthttp.MethodPost, reqDto)
-	var response dto.CommonResponseDto
+	response, err := client.DeleteAccessKeyWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.CommonResponseDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// DeleteAccessKeyWithContext 同 DeleteAccessKey，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) DeleteAccessKeyWithContext(ctx context.Context, reqDto *dto.DeleteAccessKeyDto) (*dto.CommonResponseDto, error) {
+	var response dto.CommonResponseDto
+	err := client.request(ctx, "/api/v3/delete-access-key", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -6536,18 +8202,25 @@ func (client *ManagementClient) DeleteAccessKey(reqDto *dto.DeleteAccessKeyDto)
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) UpdateAccessKey(reqDto *dto.UpdateAccessKeyDto) *dto.IsSuccessRespDto {
-	b, err := client.SendHttpRequest("/api/v3/update-access-key", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.UpdateAccessKeyWithContext(context.Background(), reqDto)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// UpdateAccessKeyWithContext 同 UpdateAccessKey，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) UpdateAccessKeyWithContext(ctx context.Context, reqDto *dto.UpdateAccessKeyDto) (*dto.IsSuccessRespDto, error) {
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/update-access-key", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
@@ -6558,19 +8231,26 @@ func (client *ManagementClient) UpdateAccessKey(reqDto *dto.UpdateAccessKeyDto)
  * @returns IsSuccessRespDto
  */
 func (client *ManagementClient) PubEvent(eventCode string, data interface{}) *dto.IsSuccessRespDto {
-	var reqDto = dto.NewEventReqDto(eventCode, data)
-	b, err := client.SendHttpRequest("/api/v3/pub-event", fasthttp.MethodPost, reqDto)
-	var response dto.IsSuccessRespDto
+	response, err := client.PubEventWithContext(context.Background(), eventCode, data)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		response = &dto.IsSuccessRespDto{}
+		if !util.FillErrorResponse(err, response) {
+			log.Println(err)
+			return nil
+		}
 	}
-	err = json.Unmarshal(b, &response)
+	return response
+}
+
+// PubEventWithContext 同 PubEvent，ctx 用于取消请求或控制请求截止时间，请求失败或响应 statusCode 非 2xx 时返回 error
+func (client *ManagementClient) PubEventWithContext(ctx context.Context, eventCode string, data interface{}) (*dto.IsSuccessRespDto, error) {
+	var reqDto = dto.NewEventReqDto(eventCode, data)
+	var response dto.IsSuccessRespDto
+	err := client.request(ctx, "/api/v3/pub-event", fasthttp.MethodPost, reqDto, &response)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
-	return &response
+	return &response, nil
 }
 
 /*
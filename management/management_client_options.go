@@ -1,6 +1,8 @@
 package management
 
 import (
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/Authing/authing-golang-sdk/v3/constant"
@@ -9,10 +11,13 @@ import (
 )
 
 type ManagementClient struct {
-	httpClient *fasthttp.Client
-	options    *ManagementClientOptions
-	userPoolId string
-	eventHub   *util.WebSocketEventHub
+	httpClient   *fasthttp.Client
+	options      *ManagementClientOptions
+	eventHub     *util.WebSocketEventHub
+	interceptors []util.Interceptor
+	tokenMutex   sync.Mutex
+	// lastToken 本 client 最近获取的 *ManagementToken，TokenStore 不可用时使用
+	lastToken atomic.Value
 }
 
 type ManagementClientOptions struct {
@@ -33,6 +38,23 @@ type ManagementClientOptions struct {
 	 * 自定义 Client 创建函数
 	 */
 	CreateClientFunc func(options *ManagementClientOptions) *fasthttp.Client
+	/**
+	 * 请求重试策略，默认为 util.DefaultRetryPolicy()；设置 MaxAttempts 为 1 可关闭重试
+	 */
+	RetryPolicy *util.RetryPolicy
+	/**
+	 * 管理 token 存储，默认为每个 client 独立的内存存储；多副本共享 token 时可使用基于 Redis、文件等的实现
+	 */
+	TokenStore TokenStore
+	/**
+	 * 管理 token 到期前多久主动刷新，默认 5 分钟
+	 */
+	TokenRefreshBefore time.Duration
+	/**
+	 * 表示管理 token 失效的响应 apiCode；除 401 外，响应中出现这些 apiCode 时同样会重新获取 token 并重放请求。
+	 * 默认为 constant.NotLoggedInApiCode 与 constant.TokenExpiredApiCode
+	 */
+	TokenExpiredApiCodes []int
 }
 
 func NewManagementClient(options *ManagementClientOptions) (*ManagementClient, error) {
@@ -45,6 +67,18 @@ func NewManagementClient(options *ManagementClientOptions) (*ManagementClient, e
 	if options.ReadTimeout == 0 {
 		options.ReadTimeout = 10 * time.Second
 	}
+	if options.RetryPolicy == nil {
+		options.RetryPolicy = util.DefaultRetryPolicy()
+	}
+	if options.TokenStore == nil {
+		options.TokenStore = NewMemoryTokenStore()
+	}
+	if options.TokenRefreshBefore == 0 {
+		options.TokenRefreshBefore = 5 * time.Minute
+	}
+	if options.TokenExpiredApiCodes == nil {
+		options.TokenExpiredApiCodes = []int{constant.NotLoggedInApiCode, constant.TokenExpiredApiCode}
+	}
 	c := &ManagementClient{
 		options: options,
 	}
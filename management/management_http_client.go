@@ -1,15 +1,16 @@
 package management
 
 import (
+	"context"
 	"crypto/tls"
 	"encoding/json"
-	"sync"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/Authing/authing-golang-sdk/v3/constant"
 	"github.com/Authing/authing-golang-sdk/v3/dto"
 	"github.com/Authing/authing-golang-sdk/v3/util"
-	"github.com/Authing/authing-golang-sdk/v3/util/cache"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/valyala/fasthttp"
 )
@@ -22,51 +23,123 @@ type JwtClaims struct {
 }
 
 func GetAccessToken(client *ManagementClient) (string, error) {
-	// 从缓存获取token
-	cacheToken, b := cache.GetCache(constant.TokenCacheKeyPrefix + client.options.AccessKeyId)
-	if b && cacheToken != nil {
-		return cacheToken.(string), nil
-	}
-	// 从服务获取token，加锁
-	var mutex sync.Mutex
-	mutex.Lock()
-	defer mutex.Unlock()
-	cacheToken, b = cache.GetCache(constant.TokenCacheKeyPrefix + client.options.AccessKeyId)
-	if b && cacheToken != nil {
-		return cacheToken.(string), nil
-	}
-	resp, err := QueryAccessToken(client)
+	return GetAccessTokenWithContext(context.Background(), client)
+}
+
+// GetAccessTokenWithContext 获取管理 token，TokenStore 中没有可用 token 或即将过期时使用 ctx 请求服务端
+func GetAccessTokenWithContext(ctx context.Context, client *ManagementClient) (string, error) {
+	token, err := client.getManagementToken(ctx)
 	if err != nil {
 		return "", err
 	}
+	return token.AccessToken, nil
+}
+
+func (client *ManagementClient) tokenStoreKey() string {
+	return constant.TokenCacheKeyPrefix + client.options.AccessKeyId
+}
+
+// loadToken 从 TokenStore 读取 token，TokenStore 返回错误时使用本 client 最近获取的 token
+func (client *ManagementClient) loadToken(ctx context.Context) *ManagementToken {
+	token, err := client.options.TokenStore.Get(ctx, client.tokenStoreKey())
+	if err != nil {
+		token, _ = client.lastToken.Load().(*ManagementToken)
+	}
+	return token
+}
 
-	if token, _ := jwt.Parse(resp.Data.AccessToken, nil); token != nil {
-		userPoolId := token.Claims.(jwt.MapClaims)["scoped_userpool_id"]
-		client.userPoolId = userPoolId.(string)
+func (client *ManagementClient) getManagementToken(ctx context.Context) (*ManagementToken, error) {
+	refreshBefore := client.options.TokenRefreshBefore
+	cached := client.loadToken(ctx)
+	if cached != nil && !cached.needRefresh(time.Now(), refreshBefore) {
+		return cached, nil
 	}
 
-	cache.SetCache(constant.TokenCacheKeyPrefix+client.options.AccessKeyId, resp.Data.AccessToken, time.Duration(resp.Data.ExpiresIn*int(time.Second)))
-	return resp.Data.AccessToken, nil
+	// 同一个 client 同时只有一个请求刷新 token，其余请求等待后复用刷新结果
+	client.tokenMutex.Lock()
+	defer client.tokenMutex.Unlock()
+	if latest := client.loadToken(ctx); latest != nil {
+		cached = latest
+		if !cached.needRefresh(time.Now(), refreshBefore) {
+			return cached, nil
+		}
+	}
+
+	token, err := client.refreshManagementToken(ctx)
+	if err != nil {
+		// 刷新失败时，未过期的 token 仍然可用
+		if cached != nil && !cached.Expired(time.Now()) {
+			return cached, nil
+		}
+		return nil, err
+	}
+	return token, nil
+}
+
+// renewManagementToken 使失效的 stale token 从 TokenStore 中移除并获取新 token；
+// 若其他请求已经完成刷新，则直接使用新的 token
+func (client *ManagementClient) renewManagementToken(ctx context.Context, stale *ManagementToken) (*ManagementToken, error) {
+	client.tokenMutex.Lock()
+	defer client.tokenMutex.Unlock()
+	if latest := client.loadToken(ctx); latest != nil && latest.AccessToken != stale.AccessToken {
+		return latest, nil
+	}
+	// 清除失败时仍然获取新 token，保存新 token 时会覆盖失效的 token
+	client.options.TokenStore.Delete(ctx, client.tokenStoreKey())
+	return client.refreshManagementToken(ctx)
+}
+
+func (client *ManagementClient) refreshManagementToken(ctx context.Context) (*ManagementToken, error) {
+	resp, err := QueryAccessTokenWithContext(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	token := &ManagementToken{
+		AccessToken: resp.Data.AccessToken,
+		IssuedAt:    now,
+		ExpiresAt:   now.Add(time.Duration(resp.Data.ExpiresIn) * time.Second),
+	}
+	if parsed, _, err := jwt.NewParser().ParseUnverified(token.AccessToken, jwt.MapClaims{}); err == nil {
+		token.UserPoolId, _ = parsed.Claims.(jwt.MapClaims)["scoped_userpool_id"].(string)
+	}
+	client.lastToken.Store(token)
+	// 保存失败时本 client 仍使用新 token，TokenStore 恢复后由下次刷新写入
+	client.options.TokenStore.Set(ctx, client.tokenStoreKey(), token)
+	return token, nil
 }
 
 func QueryAccessToken(client *ManagementClient) (*dto.GetManagementTokenRespDto, error) {
+	return QueryAccessTokenWithContext(context.Background(), client)
+}
+
+func QueryAccessTokenWithContext(ctx context.Context, client *ManagementClient) (*dto.GetManagementTokenRespDto, error) {
 	variables := map[string]interface{}{
 		"accessKeyId":     client.options.AccessKeyId,
 		"accessKeySecret": client.options.AccessKeySecret,
 	}
 
-	b, err := client.SendHttpRequest("/api/v3/get-management-token", fasthttp.MethodPost, variables)
+	var r dto.GetManagementTokenRespDto
+	err := client.request(ctx, "/api/v3/get-management-token", fasthttp.MethodPost, variables, &r)
 	if err != nil {
 		return nil, err
 	}
-	var r dto.GetManagementTokenRespDto
-	if b != nil {
-		json.Unmarshal(b, &r)
-	}
 	return &r, nil
 }
 
 func (client *ManagementClient) SendHttpRequest(url string, method string, reqDto interface{}) ([]byte, error) {
+	b, err := client.SendHttpRequestWithContext(context.Background(), url, method, reqDto)
+	var authingError *dto.AuthingError
+	if errors.As(err, &authingError) {
+		return json.Marshal(authingError.Response())
+	}
+	return b, err
+}
+
+// SendHttpRequestWithContext 发送请求，ctx 被取消或到达截止时间时中止请求。
+// 网络错误以 *dto.AuthingError 返回，超时可通过 errors.Is(err, dto.ErrTimeout) 判断
+func (client *ManagementClient) SendHttpRequestWithContext(ctx context.Context, url string, method string, reqDto interface{}) ([]byte, error) {
+	path := url
 	req := fasthttp.AcquireRequest()
 	defer fasthttp.ReleaseRequest(req)
 
@@ -93,38 +166,95 @@ func (client *ManagementClient) SendHttpRequest(url string, method string, reqDt
 	//req.Header.Add("x-authing-request-from", client.options.RequestFrom)
 	req.Header.Add("x-authing-sdk-version", constant.SdkVersion)
 	req.Header.Add("x-authing-lang", client.options.Lang)
-	if url != "/api/v3/get-management-token" {
-		token, _ := GetAccessToken(client)
-		req.Header.Add("Authorization", "Bearer "+token)
-		req.Header.Add("x-authing-userpool-id", client.userPoolId)
-	}
 	req.Header.Add("Content-Type", "application/json;charset=UTF-8")
 
 	if method != fasthttp.MethodGet {
 		req.SetBody(reqJsonBytes)
 	}
 
-	resp := fasthttp.AcquireResponse()
-	defer fasthttp.ReleaseResponse(resp)
+	request := &util.RoundTripRequest{
+		Context: ctx,
+		Path:    path,
+		Method:  method,
+		ReqDto:  reqDto,
+		Request: req,
+	}
+	if path == "/api/v3/get-management-token" {
+		res, err := client.roundTrip(request)
+		if err != nil {
+			return nil, util.NewTransportError(err)
+		}
+		return res.Body, nil
+	}
 
-	err = client.httpClient.DoTimeout(req, resp, client.options.ReadTimeout)
+	token, err := client.getManagementToken(ctx)
 	if err != nil {
-		resultMap := make(map[string]interface{})
-		if err == fasthttp.ErrTimeout {
-			resultMap["statusCode"] = 504
-			resultMap["message"] = "请求超时"
-		} else {
-			resultMap["statusCode"] = 500
-			resultMap["message"] = err.Error()
-		}
-		b, err := json.Marshal(resultMap)
-		if err != nil {
+		return nil, err
+	}
+	res, err := client.roundTripWithToken(request, token)
+	if err != nil {
+		return nil, util.NewTransportError(err)
+	}
+	if client.isTokenInvalid(res) {
+		// token 已被撤销或 AccessKey 已轮换，清除缓存后重新获取 token 并重放一次请求
+		if token, err = client.renewManagementToken(ctx, token); err != nil {
 			return nil, err
 		}
-		return b, err
+		if res, err = client.roundTripWithToken(request, token); err != nil {
+			return nil, util.NewTransportError(err)
+		}
+	}
+	return res.Body, nil
+}
+
+func (client *ManagementClient) roundTripWithToken(request *util.RoundTripRequest, token *ManagementToken) (*util.RoundTripResponse, error) {
+	request.Request.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	request.Request.Header.Set("x-authing-userpool-id", token.UserPoolId)
+	return client.roundTrip(request)
+}
+
+func (client *ManagementClient) isTokenInvalid(res *util.RoundTripResponse) bool {
+	if res.StatusCode == fasthttp.StatusUnauthorized {
+		return true
+	}
+	var common dto.CommonResponseDto
+	if json.Unmarshal(res.Body, &common) != nil {
+		return false
+	}
+	if common.StatusCode == fasthttp.StatusUnauthorized {
+		return true
+	}
+	for _, apiCode := range client.options.TokenExpiredApiCodes {
+		if common.ApiCode == apiCode {
+			return true
+		}
+	}
+	return false
+}
+
+// Use 添加请求拦截器，先添加的拦截器位于最外层；应在发起请求前调用
+func (client *ManagementClient) Use(interceptors ...util.Interceptor) {
+	client.interceptors = append(client.interceptors, interceptors...)
+}
+
+func (client *ManagementClient) roundTrip(request *util.RoundTripRequest) (*util.RoundTripResponse, error) {
+	roundTrip := util.NewRoundTrip(client.httpClient, client.options.RetryPolicy, client.options.ReadTimeout)
+	return util.ChainInterceptors(roundTrip, client.interceptors)(request)
+}
+
+// request 发送请求并将响应体解析到 response，响应体中 statusCode 非 2xx 时返回 *dto.AuthingError
+func (client *ManagementClient) request(ctx context.Context, url string, method string, reqDto interface{}, response interface{}) error {
+	b, err := client.SendHttpRequestWithContext(ctx, url, method, reqDto)
+	if err != nil {
+		return err
+	}
+	if err = util.CheckResponse(b); err != nil {
+		return err
+	}
+	if err = json.Unmarshal(b, response); err != nil {
+		return fmt.Errorf("解析响应失败: %w", err)
 	}
-	body := resp.Body()
-	return body, err
+	return nil
 }
 
 func (client *ManagementClient) createHttpClient() *fasthttp.Client {
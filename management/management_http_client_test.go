@@ -0,0 +1,184 @@
+package management
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Authing/authing-golang-sdk/v3/dto"
+	"github.com/Authing/authing-golang-sdk/v3/util"
+)
+
+const testManagementToken = "eyJhbGciOiJIUzI1NiJ9.eyJzY29wZWRfdXNlcnBvb2xfaWQiOiJwb29sLTEifQ.signature"
+
+// newTestServer 返回一个模拟 Authing 的服务端，handler 处理除获取管理 token 以外的请求
+func newTestServer(handler http.HandlerFunc) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v3/get-management-token" {
+			w.Write([]byte(`{"statusCode":200,"data":{"access_token":"` + testManagementToken + `","expires_in":7200}}`))
+			return
+		}
+		handler(w, r)
+	}))
+}
+
+func newTestClient(t *testing.T, host string, policy *util.RetryPolicy) *ManagementClient {
+	c, err := NewManagementClient(&ManagementClientOptions{
+		AccessKeyId:     "ak-" + t.Name(),
+		AccessKeySecret: "sk",
+		Host:            host,
+		RetryPolicy:     policy,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestRetryPolicy_RetryGet(t *testing.T) {
+	var calls int32
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"statusCode":503,"message":"busy"}`))
+			return
+		}
+		w.Write([]byte(`{"statusCode":200,"message":"","data":{"userId":"u1"}}`))
+	})
+	defer server.Close()
+
+	c := newTestClient(t, server.URL, &util.RetryPolicy{
+		MaxAttempts:          3,
+		BaseDelay:            10 * time.Millisecond,
+		RetryableStatusCodes: []int{503},
+	})
+	resp, err := c.GetUserWithContext(context.Background(), &dto.GetUserDto{UserId: "u1"})
+	if err != nil {
+		t.Fatalf("重试后仍然失败: %v", err)
+	}
+	if resp.Data.UserId != "u1" || atomic.LoadInt32(&calls) != 3 {
+		t.Fatalf("重试次数不符合预期: %d", calls)
+	}
+}
+
+func TestRetryPolicy_PostRequiresIdempotent(t *testing.T) {
+	var calls int32
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"statusCode":503,"message":"busy"}`))
+	})
+	defer server.Close()
+
+	c := newTestClient(t, server.URL, &util.RetryPolicy{
+		MaxAttempts:          2,
+		BaseDelay:            time.Millisecond,
+		RetryableStatusCodes: []int{503},
+	})
+	request := &dto.ListUsersRequestDto{}
+	if _, err := c.ListUsersWithContext(context.Background(), request); err == nil {
+		t.Fatal("期望返回错误")
+	}
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Fatalf("未标记幂等的 POST 请求不应重试, 实际请求 %d 次", n)
+	}
+
+	atomic.StoreInt32(&calls, 0)
+	c.ListUsersWithContext(util.WithIdempotent(context.Background()), request)
+	if n := atomic.LoadInt32(&calls); n != 2 {
+		t.Fatalf("标记幂等的 POST 请求应重试, 实际请求 %d 次", n)
+	}
+}
+
+func TestRetryPolicy_RetryAfterCappedByMaxDelay(t *testing.T) {
+	var calls int32
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "3600")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{"statusCode":200,"message":"","data":{"userId":"u1"}}`))
+	})
+	defer server.Close()
+
+	c := newTestClient(t, server.URL, &util.RetryPolicy{
+		MaxAttempts:          2,
+		BaseDelay:            time.Millisecond,
+		MaxDelay:             10 * time.Millisecond,
+		RetryableStatusCodes: []int{429},
+	})
+	start := time.Now()
+	if _, err := c.GetUserWithContext(context.Background(), &dto.GetUserDto{UserId: "u1"}); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("Retry-After 应受 MaxDelay 限制, 实际等待 %v", elapsed)
+	}
+}
+
+func TestRetryPolicy_ContextCanceledDuringBackoff(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "10")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"statusCode":503,"message":"busy"}`))
+	})
+	defer server.Close()
+
+	c := newTestClient(t, server.URL, &util.RetryPolicy{
+		MaxAttempts:          3,
+		BaseDelay:            time.Millisecond,
+		RetryableStatusCodes: []int{503},
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := c.GetUserWithContext(ctx, &dto.GetUserDto{UserId: "u1"}); !errors.Is(err, dto.ErrTimeout) {
+		t.Fatalf("等待重试时 ctx 到期应返回超时错误: %v", err)
+	}
+}
+
+func TestUse_Interceptors(t *testing.T) {
+	var traceId string
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		traceId = r.Header.Get("x-trace-id")
+		w.Write([]byte(`{"statusCode":200,"message":"","data":{"userId":"u1"}}`))
+	})
+	defer server.Close()
+
+	c := newTestClient(t, server.URL, nil)
+	var observedPath string
+	var observedStatus int
+	c.Use(
+		util.MetricsInterceptor(func(method string, path string, statusCode int, latency time.Duration, err error) {
+			observedPath, observedStatus = path, statusCode
+		}),
+		util.HeaderInterceptor(map[string]string{"x-trace-id": "trace-1"}, nil),
+	)
+	if _, err := c.GetUserWithContext(context.Background(), &dto.GetUserDto{UserId: "u1"}); err != nil {
+		t.Fatal(err)
+	}
+	if traceId != "trace-1" {
+		t.Fatalf("请求头未注入: %q", traceId)
+	}
+	if observedPath != "/api/v3/get-user" || observedStatus != 200 {
+		t.Fatalf("拦截器观测结果不符合预期: %s %d", observedPath, observedStatus)
+	}
+
+	c.Use(func(next util.RoundTrip) util.RoundTrip {
+		return func(request *util.RoundTripRequest) (*util.RoundTripResponse, error) {
+			return &util.RoundTripResponse{
+				StatusCode: 200,
+				Body:       []byte(`{"statusCode":200,"message":"","data":{"userId":"cached"}}`),
+			}, nil
+		}
+	})
+	resp, err := c.GetUserWithContext(context.Background(), &dto.GetUserDto{UserId: "u1"})
+	if err != nil || resp.Data.UserId != "cached" {
+		t.Fatalf("拦截器未能直接返回响应: %v %+v", err, resp)
+	}
+}
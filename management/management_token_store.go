@@ -0,0 +1,155 @@
+package management
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ManagementToken 管理 token 及其有效期
+type ManagementToken struct {
+	AccessToken string    `json:"accessToken"`
+	UserPoolId  string    `json:"userPoolId"`
+	IssuedAt    time.Time `json:"issuedAt"`
+	ExpiresAt   time.Time `json:"expiresAt"`
+}
+
+// Expired 判断 token 在 now 时是否已过期
+func (token *ManagementToken) Expired(now time.Time) bool {
+	return token == nil || token.AccessToken == "" || !now.Before(token.ExpiresAt)
+}
+
+// needRefresh 判断 token 是否进入主动刷新窗口，窗口不超过 token 有效期的一半
+func (token *ManagementToken) needRefresh(now time.Time, refreshBefore time.Duration) bool {
+	if token.Expired(now) {
+		return true
+	}
+	if lifetime := token.ExpiresAt.Sub(token.IssuedAt); refreshBefore > lifetime/2 {
+		refreshBefore = lifetime / 2
+	}
+	return !now.Before(token.ExpiresAt.Add(-refreshBefore))
+}
+
+/*
+TokenStore 管理 token 存储。
+
+实现约定：
+  - 所有方法必须并发安全，多个 ManagementClient 可以共享同一个 TokenStore
+  - key 由 SDK 根据 AccessKeyId 生成，同一 key 的 token 可在持有相同 AccessKey 的多个副本之间共享
+  - Get 在 key 不存在或已过期时返回 nil, nil；返回 error 时 SDK 使用本 client 最近获取的 token，没有可用 token 时向服务端获取
+  - Set 与 Delete 返回的 error 不会导致请求失败，SDK 继续使用本 client 最近获取的 token
+  - Set 保存的数据至少保留到 token.ExpiresAt，例如 Redis 实现可使用 SET key value PXAT ExpiresAt
+  - Delete 在 token 失效时调用，key 不存在时不应返回错误
+*/
+type TokenStore interface {
+	Get(ctx context.Context, key string) (*ManagementToken, error)
+	Set(ctx context.Context, key string, token *ManagementToken) error
+	Delete(ctx context.Context, key string) error
+}
+
+type memoryTokenStore struct {
+	mutex  sync.RWMutex
+	tokens map[string]*ManagementToken
+}
+
+// NewMemoryTokenStore 创建进程内的 TokenStore，是 ManagementClient 的默认实现
+func NewMemoryTokenStore() TokenStore {
+	return &memoryTokenStore{tokens: make(map[string]*ManagementToken)}
+}
+
+func (store *memoryTokenStore) Get(ctx context.Context, key string) (*ManagementToken, error) {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+	token := store.tokens[key]
+	if token.Expired(time.Now()) {
+		return nil, nil
+	}
+	return token, nil
+}
+
+func (store *memoryTokenStore) Set(ctx context.Context, key string, token *ManagementToken) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	store.tokens[key] = token
+	return nil
+}
+
+func (store *memoryTokenStore) Delete(ctx context.Context, key string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	delete(store.tokens, key)
+	return nil
+}
+
+type fileTokenStore struct {
+	dir   string
+	mutex sync.Mutex
+}
+
+// NewFileTokenStore 创建基于文件的 TokenStore，每个 key 对应 dir 下的一个 JSON 文件，
+// 可供同一主机上的多个进程共享 token，也可作为实现 Redis 等 TokenStore 的参考
+func NewFileTokenStore(dir string) (TokenStore, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	return &fileTokenStore{dir: dir}, nil
+}
+
+func (store *fileTokenStore) path(key string) string {
+	return filepath.Join(store.dir, strings.Replace(key, string(filepath.Separator), "_", -1)+".json")
+}
+
+func (store *fileTokenStore) Get(ctx context.Context, key string) (*ManagementToken, error) {
+	b, err := ioutil.ReadFile(store.path(key))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var token ManagementToken
+	if err = json.Unmarshal(b, &token); err != nil {
+		return nil, err
+	}
+	if token.Expired(time.Now()) {
+		return nil, nil
+	}
+	return &token, nil
+}
+
+func (store *fileTokenStore) Set(ctx context.Context, key string, token *ManagementToken) error {
+	b, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	// 先写入临时文件再重命名，避免其他进程读到写了一半的内容
+	tmp, err := ioutil.TempFile(store.dir, ".token-")
+	if err != nil {
+		return err
+	}
+	if _, err = tmp.Write(b); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), store.path(key))
+}
+
+func (store *fileTokenStore) Delete(ctx context.Context, key string) error {
+	err := os.Remove(store.path(key))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
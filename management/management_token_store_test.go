@@ -0,0 +1,234 @@
+package management
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Authing/authing-golang-sdk/v3/constant"
+	"github.com/Authing/authing-golang-sdk/v3/dto"
+)
+
+func newTokenServer(expiresIn int, tokenRequests *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v3/get-management-token" {
+			n := atomic.AddInt32(tokenRequests, 1)
+			time.Sleep(20 * time.Millisecond)
+			fmt.Fprintf(w, `{"statusCode":200,"data":{"access_token":"%s-%d","expires_in":%d}}`, testManagementToken, n, expiresIn)
+			return
+		}
+		w.Write([]byte(`{"statusCode":200,"message":""}`))
+	}))
+}
+
+func TestGetAccessToken_SingleFlight(t *testing.T) {
+	var tokenRequests int32
+	server := newTokenServer(7200, &tokenRequests)
+	defer server.Close()
+
+	c := newTestClient(t, server.URL, nil)
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := GetAccessToken(c); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	if n := atomic.LoadInt32(&tokenRequests); n != 1 {
+		t.Fatalf("并发获取 token 时应只请求一次, 实际 %d 次", n)
+	}
+	token, _ := c.getManagementToken(context.Background())
+	if token.UserPoolId != "pool-1" {
+		t.Fatalf("未解析出用户池 ID: %q", token.UserPoolId)
+	}
+}
+
+func TestGetAccessToken_ProactiveRefresh(t *testing.T) {
+	var tokenRequests int32
+	server := newTokenServer(7200, &tokenRequests)
+	defer server.Close()
+
+	c := newTestClient(t, server.URL, nil)
+	key := c.tokenStoreKey()
+	now := time.Now()
+	c.options.TokenStore.Set(context.Background(), key, &ManagementToken{
+		AccessToken: "old",
+		IssuedAt:    now.Add(-2 * time.Hour),
+		ExpiresAt:   now.Add(time.Minute),
+	})
+	token, err := GetAccessToken(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token == "old" || atomic.LoadInt32(&tokenRequests) != 1 {
+		t.Fatalf("即将过期的 token 应被主动刷新: %s", token)
+	}
+}
+
+func TestFileTokenStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "authing-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	var tokenRequests int32
+	server := newTokenServer(7200, &tokenRequests)
+	defer server.Close()
+
+	store, err := NewFileTokenStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	replicas := make([]*ManagementClient, 2)
+	for i := range replicas {
+		replicas[i], err = NewManagementClient(&ManagementClientOptions{
+			AccessKeyId:     "ak-file",
+			AccessKeySecret: "sk",
+			Host:            server.URL,
+			TokenStore:      store,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	first, _ := GetAccessToken(replicas[0])
+	second, _ := GetAccessToken(replicas[1])
+	if first == "" || first != second || atomic.LoadInt32(&tokenRequests) != 1 {
+		t.Fatalf("多个 client 应共享同一个 token: %s %s", first, second)
+	}
+
+	if err = store.Delete(context.Background(), replicas[0].tokenStoreKey()); err != nil {
+		t.Fatal(err)
+	}
+	if token, err := store.Get(context.Background(), replicas[0].tokenStoreKey()); token != nil || err != nil {
+		t.Fatalf("删除后不应再读取到 token: %+v %v", token, err)
+	}
+}
+
+func TestSendHttpRequest_RenewTokenOn401(t *testing.T) {
+	var tokenRequests, calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v3/get-management-token" {
+			n := atomic.AddInt32(&tokenRequests, 1)
+			fmt.Fprintf(w, `{"statusCode":200,"data":{"access_token":"%s-%d","expires_in":7200}}`, testManagementToken, n)
+			return
+		}
+		atomic.AddInt32(&calls, 1)
+		if r.Header.Get("Authorization") == "Bearer "+testManagementToken+"-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"statusCode":401,"message":"token 已被撤销"}`))
+			return
+		}
+		w.Write([]byte(`{"statusCode":200,"message":"","data":{"userId":"u1"}}`))
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL, nil)
+	resp, err := c.GetUserWithContext(context.Background(), &dto.GetUserDto{UserId: "u1"})
+	if err != nil {
+		t.Fatalf("重新获取 token 后应成功: %v", err)
+	}
+	if resp.Data.UserId != "u1" || atomic.LoadInt32(&tokenRequests) != 2 || atomic.LoadInt32(&calls) != 2 {
+		t.Fatalf("token 请求 %d 次, 接口请求 %d 次", tokenRequests, calls)
+	}
+}
+
+func TestSendHttpRequest_RenewTokenOnExpiredApiCode(t *testing.T) {
+	var tokenRequests, calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v3/get-management-token" {
+			n := atomic.AddInt32(&tokenRequests, 1)
+			fmt.Fprintf(w, `{"statusCode":200,"data":{"access_token":"%s-%d","expires_in":7200}}`, testManagementToken, n)
+			return
+		}
+		atomic.AddInt32(&calls, 1)
+		if r.Header.Get("Authorization") == "Bearer "+testManagementToken+"-1" {
+			fmt.Fprintf(w, `{"statusCode":400,"apiCode":%d,"message":"登录信息已过期"}`, constant.TokenExpiredApiCode)
+			return
+		}
+		w.Write([]byte(`{"statusCode":200,"message":"","data":{"userId":"u1"}}`))
+	}))
+	defer server.Close()
+
+	// 未设置 TokenExpiredApiCodes 时使用默认值
+	c := newTestClient(t, server.URL, nil)
+	resp, err := c.GetUserWithContext(context.Background(), &dto.GetUserDto{UserId: "u1"})
+	if err != nil {
+		t.Fatalf("重新获取 token 后应成功: %v", err)
+	}
+	if resp.Data.UserId != "u1" || atomic.LoadInt32(&tokenRequests) != 2 || atomic.LoadInt32(&calls) != 2 {
+		t.Fatalf("token 请求 %d 次, 接口请求 %d 次", tokenRequests, calls)
+	}
+}
+
+func TestSendHttpRequest_TokenError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"statusCode":403,"apiCode":1001,"message":"AccessKey 已禁用","requestId":"req-1"}`))
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL, nil)
+	_, err := c.GetUserWithContext(context.Background(), &dto.GetUserDto{UserId: "u1"})
+	var authingError *dto.AuthingError
+	if !errors.As(err, &authingError) || authingError.StatusCode != 403 || authingError.ApiCode != 1001 {
+		t.Fatalf("获取 token 的错误应返回给调用方: %v", err)
+	}
+}
+
+type failingTokenStore struct{}
+
+func (failingTokenStore) Get(ctx context.Context, key string) (*ManagementToken, error) {
+	return nil, errors.New("store unavailable")
+}
+
+func (failingTokenStore) Set(ctx context.Context, key string, token *ManagementToken) error {
+	return errors.New("store unavailable")
+}
+
+func (failingTokenStore) Delete(ctx context.Context, key string) error {
+	return errors.New("store unavailable")
+}
+
+func TestGetAccessToken_TokenStoreUnavailable(t *testing.T) {
+	var tokenRequests, calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v3/get-management-token" {
+			n := atomic.AddInt32(&tokenRequests, 1)
+			fmt.Fprintf(w, `{"statusCode":200,"data":{"access_token":"%s-%d","expires_in":7200}}`, testManagementToken, n)
+			return
+		}
+		atomic.AddInt32(&calls, 1)
+		if r.Header.Get("Authorization") == "Bearer "+testManagementToken+"-1" && atomic.LoadInt32(&calls) > 2 {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"statusCode":401,"message":"token 已被撤销"}`))
+			return
+		}
+		w.Write([]byte(`{"statusCode":200,"message":"","data":{"userId":"u1"}}`))
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL, nil)
+	c.options.TokenStore = failingTokenStore{}
+	for i := 0; i < 4; i++ {
+		if _, err := c.GetUserWithContext(context.Background(), &dto.GetUserDto{UserId: "u1"}); err != nil {
+			t.Fatalf("TokenStore 不可用时应继续使用获取到的 token: %v", err)
+		}
+	}
+	// 第 3 次请求时 token 被撤销，清除失败后仍应获取新 token
+	if n := atomic.LoadInt32(&tokenRequests); n != 2 {
+		t.Fatalf("TokenStore 不可用时不应每次请求 token, 实际 %d 次", n)
+	}
+}
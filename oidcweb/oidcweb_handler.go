@@ -0,0 +1,244 @@
+// Package oidcweb 基于 AuthenticationClient 为 net/http 应用提供开箱即用的 OIDC 登录、回调与登出处理器
+package oidcweb
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Authing/authing-golang-sdk/v3/authentication"
+	"github.com/Authing/authing-golang-sdk/v3/util"
+)
+
+// ErrMissingIDToken token 端点没有返回 id token，通常是 scope 中缺少 openid
+var ErrMissingIDToken = errors.New("token 端点未返回 id token，请检查 scope 是否包含 openid")
+
+const defaultStateTTL = 10 * time.Minute
+
+type Config struct {
+	/**
+	必填，用于构造授权地址、换取 token、获取用户信息与构造登出地址
+	*/
+	Client *authentication.AuthenticationClient
+	/**
+	登录请求信息的存储，为空时使用 CookieSecret 创建 CookieStateStore
+	*/
+	StateStore StateStore
+	/**
+	StateStore 为空时必填，用于加密保存登录请求信息的 cookie
+	*/
+	CookieSecret []byte
+	/**
+	授权请求的模板，可设置 Scope、RedirectUri、Prompt 等参数；State、Nonce 与 PKCE 参数由处理器生成
+	*/
+	AuthURLParams authentication.OIDCAuthURLParams
+	/**
+	是否关闭 PKCE，默认使用 S256
+	*/
+	DisablePKCE bool
+	/**
+	登录请求信息的有效期，默认为 10 分钟
+	*/
+	StateTTL time.Duration
+	/**
+	登录后默认跳转的地址，默认为 /
+	*/
+	DefaultReturnTo string
+	/**
+	登录地址中指定登录后跳转地址的参数名，默认为 return_to；只接受以 / 开头的站内地址，避免开放重定向
+	*/
+	ReturnToParam string
+	/**
+	是否跳过获取用户信息，跳过时 OnLogin 的 userInfo 为 nil
+	*/
+	SkipUserInfo bool
+	/**
+	登录成功的回调，通常用于建立应用自己的会话；返回错误时交由 OnError 处理
+	*/
+	OnLogin func(w http.ResponseWriter, r *http.Request, tokens *authentication.TokenSet, userInfo *authentication.UserInfo) error
+	/**
+	登出时用于获取当前用户 id token 的回调，用作 id_token_hint
+	*/
+	IDTokenHint func(r *http.Request) string
+	/**
+	登出回调，通常用于清除应用自己的会话
+	*/
+	OnLogout func(w http.ResponseWriter, r *http.Request)
+	/**
+	登出后跳转的地址，为空时使用 AuthenticationClientOptions.LogoutRedirectUri
+	*/
+	PostLogoutRedirectUri string
+	/**
+	错误处理，默认返回 400（state 无效或授权失败）或 500，不向用户暴露错误详情
+	*/
+	OnError func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type Handler struct {
+	config Config
+	store  StateStore
+}
+
+// New 创建处理器
+func New(config Config) (*Handler, error) {
+	if config.Client == nil {
+		return nil, errors.New("Client 不能为空")
+	}
+	store := config.StateStore
+	if store == nil {
+		cookieStore, err := NewCookieStateStore(config.CookieSecret)
+		if err != nil {
+			return nil, err
+		}
+		store = cookieStore
+	}
+	if config.StateTTL <= 0 {
+		config.StateTTL = defaultStateTTL
+	}
+	if config.DefaultReturnTo == "" {
+		config.DefaultReturnTo = "/"
+	}
+	if config.ReturnToParam == "" {
+		config.ReturnToParam = "return_to"
+	}
+	if config.OnError == nil {
+		config.OnError = defaultOnError
+	}
+	return &Handler{config: config, store: store}, nil
+}
+
+func defaultOnError(w http.ResponseWriter, r *http.Request, err error) {
+	status := http.StatusInternalServerError
+	var authorizeError *authentication.AuthorizeError
+	if errors.Is(err, ErrInvalidState) || errors.As(err, &authorizeError) {
+		status = http.StatusBadRequest
+	}
+	http.Error(w, http.StatusText(status), status)
+}
+
+// sanitizeReturnTo 只允许站内的相对地址，"//host" 与 "/\host" 会被浏览器当作其他站点
+func sanitizeReturnTo(returnTo string) string {
+	if !strings.HasPrefix(returnTo, "/") || strings.HasPrefix(returnTo, "//") || strings.HasPrefix(returnTo, "/\\") {
+		return ""
+	}
+	parsed, err := url.Parse(returnTo)
+	if err != nil || parsed.Scheme != "" || parsed.Host != "" {
+		return ""
+	}
+	return returnTo
+}
+
+// LoginHandler 生成 state、nonce 与 PKCE 参数并保存后，跳转到授权地址
+func (handler *Handler) LoginHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		params := handler.config.AuthURLParams
+		// 每次登录由 BuildAuthorizeUrlByOidc 生成新的 state、nonce 与 code_verifier
+		params.State = ""
+		params.Nonce = ""
+		params.CodeVerifier = ""
+		params.CodeChallengeMethod = authentication.CodeChallengeS256
+		if handler.config.DisablePKCE {
+			params.CodeChallengeMethod = ""
+		}
+		result, err := handler.config.Client.BuildAuthorizeUrlByOidc(&params)
+		if err != nil {
+			handler.config.OnError(w, r, err)
+			return
+		}
+		returnTo := util.GetValueOrDefault(sanitizeReturnTo(r.URL.Query().Get(handler.config.ReturnToParam)), handler.config.DefaultReturnTo)
+		err = handler.store.Save(w, r, &AuthState{
+			State:        result.State,
+			Nonce:        result.Nonce,
+			CodeVerifier: result.CodeVerifier,
+			RedirectUri:  params.RedirectUri,
+			ReturnTo:     returnTo,
+			ExpiresAt:    time.Now().Add(handler.config.StateTTL),
+		})
+		if err != nil {
+			handler.config.OnError(w, r, err)
+			return
+		}
+		http.Redirect(w, r, result.Url, http.StatusFound)
+	})
+}
+
+// CallbackHandler 校验 state，使用 code 换取 token 并校验 id token（含 nonce），获取用户信息后调用 OnLogin，最后跳转到登录前的地址
+func (handler *Handler) CallbackHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response, err := authentication.ParseAuthorizeResponseFromRequest(r)
+		if response == nil {
+			handler.config.OnError(w, r, err)
+			return
+		}
+		// 先取回并销毁登录请求信息，授权失败时也不允许重放同一个 state
+		saved, loadErr := handler.store.Load(w, r, response.State)
+		if loadErr != nil {
+			handler.config.OnError(w, r, loadErr)
+			return
+		}
+		if err != nil {
+			handler.config.OnError(w, r, err)
+			return
+		}
+		ctx := r.Context()
+		client := handler.config.Client
+		tokens, err := client.GetAccessTokenByCodeWithContext(ctx, &authentication.CodeToTokenParams{
+			Code:         response.Code,
+			RedirectUri:  saved.RedirectUri,
+			Nonce:        saved.Nonce,
+			CodeVerifier: saved.CodeVerifier,
+		})
+		if err != nil {
+			handler.config.OnError(w, r, err)
+			return
+		}
+		// 没有 id token 时无法校验 nonce，不能视为登录成功
+		if tokens.IDToken == "" {
+			handler.config.OnError(w, r, ErrMissingIDToken)
+			return
+		}
+		var userInfo *authentication.UserInfo
+		if !handler.config.SkipUserInfo {
+			if userInfo, err = client.GetUserInfoWithContext(ctx, tokens.AccessToken); err != nil {
+				handler.config.OnError(w, r, err)
+				return
+			}
+		}
+		if handler.config.OnLogin != nil {
+			if err = handler.config.OnLogin(w, r, tokens, userInfo); err != nil {
+				handler.config.OnError(w, r, err)
+				return
+			}
+		}
+		http.Redirect(w, r, saved.ReturnTo, http.StatusFound)
+	})
+}
+
+// LogoutHandler 调用 OnLogout 清除应用会话后跳转到认证服务器的登出地址；
+// 无法获取 id token 时只清除应用会话，直接跳转到登出后的地址
+func (handler *Handler) LogoutHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var idToken string
+		if handler.config.IDTokenHint != nil {
+			idToken = handler.config.IDTokenHint(r)
+		}
+		if handler.config.OnLogout != nil {
+			handler.config.OnLogout(w, r)
+		}
+		if idToken == "" {
+			http.Redirect(w, r, util.GetValueOrDefault(handler.config.PostLogoutRedirectUri, handler.config.DefaultReturnTo), http.StatusFound)
+			return
+		}
+		logoutUrl, err := handler.config.Client.BuildLogoutUrl(&authentication.BuildLogoutURLParams{
+			PostLogoutRedirectUri: handler.config.PostLogoutRedirectUri,
+			IDTokenHint:           idToken,
+		})
+		if err != nil {
+			handler.config.OnError(w, r, err)
+			return
+		}
+		http.Redirect(w, r, logoutUrl, http.StatusFound)
+	})
+}
@@ -0,0 +1,212 @@
+package oidcweb
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Authing/authing-golang-sdk/v3/authentication"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+type testProvider struct {
+	server *httptest.Server
+	nonce  string
+	form   url.Values
+}
+
+func newTestProvider(t *testing.T) *testProvider {
+	provider := &testProvider{}
+	provider.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oidc/token":
+			r.ParseForm()
+			provider.form = r.PostForm
+			idToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+				"iss":   provider.server.URL + "/oidc",
+				"aud":   "app",
+				"sub":   "user-1",
+				"iat":   time.Now().Unix(),
+				"exp":   time.Now().Add(time.Hour).Unix(),
+				"nonce": provider.nonce,
+			}).SignedString([]byte("secret"))
+			if err != nil {
+				t.Error(err)
+			}
+			w.Write([]byte(`{"access_token":"at","id_token":"` + idToken + `","expires_in":3600}`))
+		case "/oidc/me":
+			w.Write([]byte(`{"sub":"user-1","name":"test"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	return provider
+}
+
+func newTestHandler(t *testing.T, provider *testProvider, config Config) *Handler {
+	client, err := authentication.NewAuthenticationClient(&authentication.AuthenticationClientOptions{
+		AppId:       "app",
+		AppSecret:   "secret",
+		AppHost:     provider.server.URL,
+		RedirectUri: "https://app.example.com/callback",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.Client = client
+	config.CookieSecret = []byte("0123456789abcdef0123456789abcdef")
+	handler, err := New(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return handler
+}
+
+// login 请求登录地址，返回授权地址的参数与写入的 cookie
+func login(t *testing.T, handler *Handler, target string) (url.Values, []*http.Cookie) {
+	w := httptest.NewRecorder()
+	handler.LoginHandler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, target, nil))
+	if w.Code != http.StatusFound {
+		t.Fatalf("登录应跳转到授权地址, 实际 %d", w.Code)
+	}
+	location, err := url.Parse(w.Header().Get("Location"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return location.Query(), w.Result().Cookies()
+}
+
+func callback(handler *Handler, query string, cookies []*http.Cookie) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodGet, "/callback?"+query, nil)
+	for _, cookie := range cookies {
+		r.AddCookie(cookie)
+	}
+	w := httptest.NewRecorder()
+	handler.CallbackHandler().ServeHTTP(w, r)
+	return w
+}
+
+func TestLoginAndCallback(t *testing.T) {
+	provider := newTestProvider(t)
+	defer provider.server.Close()
+	var loggedIn *authentication.UserInfo
+	handler := newTestHandler(t, provider, Config{
+		OnLogin: func(w http.ResponseWriter, r *http.Request, tokens *authentication.TokenSet, userInfo *authentication.UserInfo) error {
+			if tokens.AccessToken != "at" {
+				t.Errorf("access token 不符合预期: %+v", tokens)
+			}
+			loggedIn = userInfo
+			return nil
+		},
+	})
+
+	authParams, cookies := login(t, handler, "/login?return_to=/orders?id=1")
+	if authParams.Get("code_challenge_method") != "S256" || len(authParams.Get("state")) != authentication.RandStringLen || len(cookies) != 1 {
+		t.Fatalf("授权参数不符合预期: %v %v", authParams, cookies)
+	}
+	provider.nonce = authParams.Get("nonce")
+
+	w := callback(handler, "code=abc&state="+authParams.Get("state"), cookies)
+	if w.Code != http.StatusFound || w.Header().Get("Location") != "/orders?id=1" {
+		t.Fatalf("回调应跳转到登录前的地址: %d %s %s", w.Code, w.Header().Get("Location"), w.Body.String())
+	}
+	if loggedIn == nil || loggedIn.Name != "test" {
+		t.Fatalf("OnLogin 未收到用户信息: %+v", loggedIn)
+	}
+	if provider.form.Get("code") != "abc" || provider.form.Get("code_verifier") == "" {
+		t.Fatalf("换取 token 的参数不符合预期: %v", provider.form)
+	}
+	if cleared := w.Result().Cookies(); len(cleared) != 1 || cleared[0].MaxAge >= 0 {
+		t.Fatalf("回调后应删除 state cookie: %v", cleared)
+	}
+}
+
+func TestCallback_Rejects(t *testing.T) {
+	provider := newTestProvider(t)
+	defer provider.server.Close()
+	var handlerErr error
+	handler := newTestHandler(t, provider, Config{
+		OnError: func(w http.ResponseWriter, r *http.Request, err error) {
+			handlerErr = err
+			w.WriteHeader(http.StatusBadRequest)
+		},
+	})
+	authParams, cookies := login(t, handler, "/login")
+	otherParams, _ := login(t, handler, "/login")
+
+	cases := []struct {
+		name    string
+		query   string
+		cookies []*http.Cookie
+		nonce   string
+		check   func(err error) bool
+	}{
+		{"缺少 cookie", "code=abc&state=" + authParams.Get("state"), nil, authParams.Get("nonce"), func(err error) bool { return err == ErrInvalidState }},
+		{"state 与 cookie 不匹配", "code=abc&state=" + otherParams.Get("state"), cookies, otherParams.Get("nonce"), func(err error) bool { return err == ErrInvalidState }},
+		{"nonce 不匹配", "code=abc&state=" + authParams.Get("state"), cookies, "other", func(err error) bool {
+			return errors.Is(err, authentication.ErrIDTokenNonce)
+		}},
+		{"授权失败", "error=access_denied&state=" + authParams.Get("state"), cookies, authParams.Get("nonce"), func(err error) bool {
+			var authorizeError *authentication.AuthorizeError
+			return errors.As(err, &authorizeError) && authorizeError.ErrorCode == "access_denied"
+		}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			handlerErr = nil
+			provider.nonce = c.nonce
+			if w := callback(handler, c.query, c.cookies); w.Code != http.StatusBadRequest || !c.check(handlerErr) {
+				t.Fatalf("期望回调失败, 实际 %d %v", w.Code, handlerErr)
+			}
+		})
+	}
+}
+
+func TestSanitizeReturnTo(t *testing.T) {
+	for input, expected := range map[string]string{
+		"/orders?id=1":        "/orders?id=1",
+		"https://evil.com":    "",
+		"//evil.com":          "",
+		"/\\evil.com":         "",
+		"orders":              "",
+		"javascript:alert(1)": "",
+		"/path#fragment":      "/path#fragment",
+		"":                    "",
+	} {
+		if actual := sanitizeReturnTo(input); actual != expected {
+			t.Errorf("%q: 期望 %q, 实际 %q", input, expected, actual)
+		}
+	}
+}
+
+func TestLogoutHandler(t *testing.T) {
+	provider := newTestProvider(t)
+	defer provider.server.Close()
+	loggedOut := false
+	handler := newTestHandler(t, provider, Config{
+		PostLogoutRedirectUri: "https://app.example.com/",
+		IDTokenHint: func(r *http.Request) string {
+			return r.URL.Query().Get("id_token")
+		},
+		OnLogout: func(w http.ResponseWriter, r *http.Request) {
+			loggedOut = true
+		},
+	})
+
+	w := httptest.NewRecorder()
+	handler.LogoutHandler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/logout?id_token=it", nil))
+	location := w.Header().Get("Location")
+	if !loggedOut || !strings.HasPrefix(location, provider.server.URL+"/oidc/session/end?") || !strings.Contains(location, "id_token_hint=it") {
+		t.Fatalf("登出地址不符合预期: %s", location)
+	}
+
+	w = httptest.NewRecorder()
+	handler.LogoutHandler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/logout", nil))
+	if location = w.Header().Get("Location"); location != "https://app.example.com/" {
+		t.Fatalf("没有 id token 时应直接跳转到登出后的地址: %s", location)
+	}
+}
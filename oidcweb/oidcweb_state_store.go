@@ -0,0 +1,146 @@
+package oidcweb
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// ErrInvalidState 回调中的 state 没有对应的登录请求，可能是 CSRF 攻击、重复回调或登录已超时
+var ErrInvalidState = errors.New("state 无效或已过期")
+
+// AuthState 发起登录时保存、回调时取回的登录请求信息
+type AuthState struct {
+	State        string    `json:"state"`
+	Nonce        string    `json:"nonce"`
+	CodeVerifier string    `json:"code_verifier,omitempty"`
+	RedirectUri  string    `json:"redirect_uri,omitempty"`
+	ReturnTo     string    `json:"return_to,omitempty"`
+	ExpiresAt    time.Time `json:"expires_at"`
+}
+
+// StateStore 保存登录请求信息。Load 需保证同一个 state 只能取回一次，未找到或已过期时返回 ErrInvalidState
+type StateStore interface {
+	Save(w http.ResponseWriter, r *http.Request, state *AuthState) error
+	Load(w http.ResponseWriter, r *http.Request, state string) (*AuthState, error)
+}
+
+// CookieStateStore 将登录请求信息加密（AES-GCM）后保存在浏览器 cookie 中，服务端无需存储。
+// 每个 state 使用单独的 cookie，同一浏览器可以同时发起多个登录
+type CookieStateStore struct {
+	aead cipher.AEAD
+	/**
+	cookie 名称前缀，默认为 oidcweb_state_
+	*/
+	NamePrefix string
+	/**
+	cookie 的 Path，默认为 /
+	*/
+	Path string
+	/**
+	是否仅通过 HTTPS 发送 cookie，默认为 true，本地 HTTP 调试时可关闭
+	*/
+	Secure bool
+	/**
+	默认为 Lax；使用 form_post 回调时需设置为 None
+	*/
+	SameSite http.SameSite
+}
+
+// NewCookieStateStore 创建 cookie 存储，secret 为任意长度的密钥，多实例部署时需保持一致
+func NewCookieStateStore(secret []byte) (*CookieStateStore, error) {
+	if len(secret) < 16 {
+		return nil, errors.New("cookie 密钥长度不能小于 16 字节")
+	}
+	key := sha256.Sum256(secret)
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &CookieStateStore{
+		aead:       aead,
+		NamePrefix: "oidcweb_state_",
+		Path:       "/",
+		Secure:     true,
+		SameSite:   http.SameSiteLaxMode,
+	}, nil
+}
+
+func (store *CookieStateStore) cookieName(state string) string {
+	sum := sha256.Sum256([]byte(state))
+	return store.NamePrefix + hex.EncodeToString(sum[:8])
+}
+
+func (store *CookieStateStore) Save(w http.ResponseWriter, r *http.Request, state *AuthState) error {
+	plaintext, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, store.aead.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return err
+	}
+	name := store.cookieName(state.State)
+	// cookie 名称作为附加数据，密文无法被挪用到其他 state 的 cookie 中
+	sealed := store.aead.Seal(nonce, nonce, plaintext, []byte(name))
+	http.SetCookie(w, &http.Cookie{
+		Name:     name,
+		Value:    base64.RawURLEncoding.EncodeToString(sealed),
+		Path:     store.Path,
+		Expires:  state.ExpiresAt,
+		MaxAge:   int(time.Until(state.ExpiresAt).Seconds()),
+		Secure:   store.Secure,
+		HttpOnly: true,
+		SameSite: store.SameSite,
+	})
+	return nil
+}
+
+func (store *CookieStateStore) Load(w http.ResponseWriter, r *http.Request, state string) (*AuthState, error) {
+	if state == "" {
+		return nil, ErrInvalidState
+	}
+	name := store.cookieName(state)
+	cookie, err := r.Cookie(name)
+	if err != nil {
+		return nil, ErrInvalidState
+	}
+	// 无论校验是否通过都删除 cookie，保证 state 只能使用一次
+	http.SetCookie(w, &http.Cookie{
+		Name:     name,
+		Path:     store.Path,
+		MaxAge:   -1,
+		Secure:   store.Secure,
+		HttpOnly: true,
+		SameSite: store.SameSite,
+	})
+	sealed, err := base64.RawURLEncoding.DecodeString(cookie.Value)
+	if err != nil || len(sealed) < store.aead.NonceSize() {
+		return nil, ErrInvalidState
+	}
+	nonceSize := store.aead.NonceSize()
+	plaintext, err := store.aead.Open(nil, sealed[:nonceSize], sealed[nonceSize:], []byte(name))
+	if err != nil {
+		return nil, ErrInvalidState
+	}
+	var saved AuthState
+	if err = json.Unmarshal(plaintext, &saved); err != nil {
+		return nil, ErrInvalidState
+	}
+	if subtle.ConstantTimeCompare([]byte(saved.State), []byte(state)) != 1 || !time.Now().Before(saved.ExpiresAt) {
+		return nil, ErrInvalidState
+	}
+	return &saved, nil
+}
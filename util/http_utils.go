@@ -1,8 +1,12 @@
 package util
 
 import (
+	"context"
 	"fmt"
 	"net/url"
+	"time"
+
+	"github.com/valyala/fasthttp"
 )
 
 func GenQueryString(variables map[string]interface{}) string {
@@ -13,3 +17,46 @@ func GenQueryString(variables map[string]interface{}) string {
 	qs := params.Encode()
 	return qs
 }
+
+// DoWithContext 发送请求，超时时间取 timeout 与 ctx 截止时间中较早者，ctx 被取消时立即返回 ctx.Err()
+func DoWithContext(ctx context.Context, client *fasthttp.Client, req *fasthttp.Request, resp *fasthttp.Response, timeout time.Duration) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	deadline := time.Now().Add(timeout)
+	ctxBound := false
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+		ctxBound = true
+	}
+	if ctx.Done() == nil {
+		return client.DoDeadline(req, resp, deadline)
+	}
+
+	// 请求在独立的 goroutine 中执行，使用副本避免 ctx 取消后调用方释放 req/resp 引起竞争
+	reqCopy := fasthttp.AcquireRequest()
+	req.CopyTo(reqCopy)
+	respCopy := fasthttp.AcquireResponse()
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- client.DoDeadline(reqCopy, respCopy, deadline)
+	}()
+
+	select {
+	case err := <-errCh:
+		if err == nil {
+			respCopy.CopyTo(resp)
+		}
+		fasthttp.ReleaseRequest(reqCopy)
+		fasthttp.ReleaseResponse(respCopy)
+		if err == fasthttp.ErrTimeout && ctxBound {
+			return context.DeadlineExceeded
+		}
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,117 @@
+package util
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/valyala/fasthttp"
+)
+
+// RoundTripRequest 拦截器可见的请求信息，拦截器可以修改 Request 的请求头
+type RoundTripRequest struct {
+	Context context.Context
+	// 接口路径，不含域名与查询参数，例如 /api/v3/list-users
+	Path   string
+	Method string
+	// 调用方传入的请求参数，可能为 nil
+	ReqDto  interface{}
+	Request *fasthttp.Request
+}
+
+// RoundTripResponse 拦截器可见的响应信息
+type RoundTripResponse struct {
+	StatusCode int
+	Header     *fasthttp.ResponseHeader
+	Body       []byte
+	// 请求耗时，包含重试等待时间
+	Latency time.Duration
+}
+
+// RoundTrip 发送一次请求并返回响应
+type RoundTrip func(request *RoundTripRequest) (*RoundTripResponse, error)
+
+// Interceptor 包装 RoundTrip，可以在请求前后执行逻辑，或不调用 next 直接返回响应
+type Interceptor func(next RoundTrip) RoundTrip
+
+// NewRoundTrip 创建实际发送请求的 RoundTrip
+func NewRoundTrip(client *fasthttp.Client, policy *RetryPolicy, timeout time.Duration) RoundTrip {
+	return func(request *RoundTripRequest) (*RoundTripResponse, error) {
+		resp := fasthttp.AcquireResponse()
+		defer fasthttp.ReleaseResponse(resp)
+
+		start := time.Now()
+		err := DoWithRetry(request.Context, policy, client, request.Request, resp, timeout)
+		if err != nil {
+			return nil, err
+		}
+		header := &fasthttp.ResponseHeader{}
+		resp.Header.CopyTo(header)
+		return &RoundTripResponse{
+			StatusCode: resp.StatusCode(),
+			Header:     header,
+			Body:       append([]byte(nil), resp.Body()...),
+			Latency:    time.Since(start),
+		}, nil
+	}
+}
+
+// ChainInterceptors 按顺序组合拦截器，先添加的拦截器位于最外层
+func ChainInterceptors(roundTrip RoundTrip, interceptors []Interceptor) RoundTrip {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		roundTrip = interceptors[i](roundTrip)
+	}
+	return roundTrip
+}
+
+// LoggingInterceptor 记录每个请求的方法、路径、状态码与耗时，不记录请求体与响应体
+func LoggingInterceptor(logger *log.Logger) Interceptor {
+	if logger == nil {
+		logger = log.Default()
+	}
+	return func(next RoundTrip) RoundTrip {
+		return func(request *RoundTripRequest) (*RoundTripResponse, error) {
+			start := time.Now()
+			response, err := next(request)
+			if err != nil {
+				logger.Printf("authing: %s %s failed after %v: %v", request.Method, request.Path, time.Since(start), err)
+				return response, err
+			}
+			logger.Printf("authing: %s %s %d %v", request.Method, request.Path, response.StatusCode, time.Since(start))
+			return response, err
+		}
+	}
+}
+
+// MetricsInterceptor 在每个请求结束后回调 observe，statusCode 在网络错误时为 0
+func MetricsInterceptor(observe func(method string, path string, statusCode int, latency time.Duration, err error)) Interceptor {
+	return func(next RoundTrip) RoundTrip {
+		return func(request *RoundTripRequest) (*RoundTripResponse, error) {
+			start := time.Now()
+			response, err := next(request)
+			statusCode := 0
+			if response != nil {
+				statusCode = response.StatusCode
+			}
+			observe(request.Method, request.Path, statusCode, time.Since(start), err)
+			return response, err
+		}
+	}
+}
+
+// HeaderInterceptor 为每个请求设置固定请求头；headerFunc 不为 nil 时，其返回的请求头也会被设置，可用于注入链路追踪信息
+func HeaderInterceptor(headers map[string]string, headerFunc func(ctx context.Context) map[string]string) Interceptor {
+	return func(next RoundTrip) RoundTrip {
+		return func(request *RoundTripRequest) (*RoundTripResponse, error) {
+			for key, value := range headers {
+				request.Request.Header.Set(key, value)
+			}
+			if headerFunc != nil {
+				for key, value := range headerFunc(request.Context) {
+					request.Request.Header.Set(key, value)
+				}
+			}
+			return next(request)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package util
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+)
+
+const (
+	PKCEMethodS256  = "S256"
+	PKCEMethodPlain = "plain"
+)
+
+// GenerateCodeVerifier 生成符合 RFC 7636 的 code_verifier，长度为 43 个字符
+func GenerateCodeVerifier() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// CodeChallenge 根据 code_verifier 计算 code_challenge，method 为 plain 时原样返回
+func CodeChallenge(verifier string, method string) string {
+	if method == PKCEMethodPlain {
+		return verifier
+	}
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
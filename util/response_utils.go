@@ -0,0 +1,52 @@
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/Authing/authing-golang-sdk/v3/dto"
+	"github.com/valyala/fasthttp"
+)
+
+// NewTransportError 将发送请求时的网络错误转换为 *dto.AuthingError
+func NewTransportError(err error) *dto.AuthingError {
+	if authingError, ok := err.(*dto.AuthingError); ok {
+		return authingError
+	}
+	if err == fasthttp.ErrTimeout || err == context.DeadlineExceeded {
+		return &dto.AuthingError{StatusCode: 504, Message: dto.ErrTimeout.Error(), Err: dto.ErrTimeout}
+	}
+	return &dto.AuthingError{StatusCode: 500, Message: err.Error(), Err: err}
+}
+
+// CheckResponse 检查响应体中的 statusCode，非 2xx 时返回 *dto.AuthingError
+func CheckResponse(body []byte) error {
+	var common dto.CommonResponseDto
+	if err := json.Unmarshal(body, &common); err != nil {
+		return nil
+	}
+	if common.StatusCode != 0 && (common.StatusCode < 200 || common.StatusCode >= 300) {
+		authingError := dto.NewAuthingError(&common)
+		authingError.Body = body
+		return authingError
+	}
+	return nil
+}
+
+// FillErrorResponse 将 *dto.AuthingError 对应的响应写入 response，有原始响应体时使用原始响应体，
+// 使 data 等字段与旧版方法一致；err 不是 *dto.AuthingError 时返回 false
+func FillErrorResponse(err error, response interface{}) bool {
+	var authingError *dto.AuthingError
+	if !errors.As(err, &authingError) {
+		return false
+	}
+	if authingError.Body != nil {
+		return json.Unmarshal(authingError.Body, response) == nil
+	}
+	b, err := json.Marshal(authingError.Response())
+	if err != nil {
+		return false
+	}
+	return json.Unmarshal(b, response) == nil
+}
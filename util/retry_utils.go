@@ -0,0 +1,197 @@
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/valyala/fasthttp"
+)
+
+// RetryPolicy 请求重试策略。GET 请求默认重试，POST 请求仅在路径位于 IdempotentPaths
+// 或通过 WithIdempotent 标记时重试
+type RetryPolicy struct {
+	// 最大尝试次数（包含首次请求），小于等于 1 时不重试
+	MaxAttempts int
+	// 首次重试前的等待时间，之后每次翻倍
+	BaseDelay time.Duration
+	// 单次等待时间上限，同样作用于服务端 Retry-After 指定的时间
+	MaxDelay time.Duration
+	// 随机抖动比例，取值 0 ~ 1，例如 0.2 表示在等待时间上下浮动 20%
+	Jitter float64
+	// 可重试的状态码，同时匹配 HTTP 状态码与响应体中的 statusCode
+	RetryableStatusCodes []int
+	// 可重试的响应体 apiCode
+	RetryableApiCodes []int
+	// 可安全重试的 POST 接口路径，例如 /api/v3/get-user-batch
+	IdempotentPaths []string
+}
+
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:          3,
+		BaseDelay:            200 * time.Millisecond,
+		MaxDelay:             5 * time.Second,
+		Jitter:               0.2,
+		RetryableStatusCodes: []int{429, 500, 502, 503, 504},
+	}
+}
+
+type idempotentKey struct{}
+
+// WithIdempotent 标记本次调用是幂等的，POST 请求也将按 RetryPolicy 重试
+func WithIdempotent(ctx context.Context) context.Context {
+	return context.WithValue(ctx, idempotentKey{}, true)
+}
+
+func isIdempotent(ctx context.Context) bool {
+	idempotent, _ := ctx.Value(idempotentKey{}).(bool)
+	return idempotent
+}
+
+func (policy *RetryPolicy) allowRetry(ctx context.Context, method string, path string) bool {
+	if policy == nil || policy.MaxAttempts <= 1 {
+		return false
+	}
+	if method == fasthttp.MethodGet || isIdempotent(ctx) {
+		return true
+	}
+	for _, idempotentPath := range policy.IdempotentPaths {
+		if idempotentPath == path {
+			return true
+		}
+	}
+	return false
+}
+
+func (policy *RetryPolicy) backoff(attempt int) time.Duration {
+	delay := policy.BaseDelay
+	for i := 1; i < attempt && (policy.MaxDelay <= 0 || delay < policy.MaxDelay); i++ {
+		delay *= 2
+	}
+	if policy.MaxDelay > 0 && delay > policy.MaxDelay {
+		delay = policy.MaxDelay
+	}
+	if policy.Jitter > 0 {
+		delta := float64(delay) * policy.Jitter
+		delay = time.Duration(float64(delay) - delta + rand.Float64()*2*delta)
+	}
+	return delay
+}
+
+func (policy *RetryPolicy) retryableStatus(statusCode int) bool {
+	for _, code := range policy.RetryableStatusCodes {
+		if code == statusCode {
+			return true
+		}
+	}
+	return false
+}
+
+func (policy *RetryPolicy) retryableResponse(resp *fasthttp.Response) bool {
+	if policy.retryableStatus(resp.StatusCode()) {
+		return true
+	}
+	body := resp.Body()
+	if len(body) == 0 || body[0] != '{' {
+		return false
+	}
+	var common struct {
+		StatusCode int `json:"statusCode"`
+		ApiCode    int `json:"apiCode"`
+	}
+	if json.Unmarshal(body, &common) != nil {
+		return false
+	}
+	if common.StatusCode != 0 && policy.retryableStatus(common.StatusCode) {
+		return true
+	}
+	for _, code := range policy.RetryableApiCodes {
+		if code == common.ApiCode {
+			return true
+		}
+	}
+	return false
+}
+
+func retryableError(err error) bool {
+	return err == fasthttp.ErrTimeout ||
+		err == fasthttp.ErrDialTimeout ||
+		err == fasthttp.ErrConnectionClosed ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.EPIPE)
+}
+
+// parseRetryAfter 解析 Retry-After 响应头，支持秒数与 HTTP 日期两种格式
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+	return 0, false
+}
+
+func sleepWithContext(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// DoWithRetry 按 policy 发送请求，policy 为 nil 时等同于 DoWithContext
+func DoWithRetry(ctx context.Context, policy *RetryPolicy, client *fasthttp.Client, req *fasthttp.Request, resp *fasthttp.Response, timeout time.Duration) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if !policy.allowRetry(ctx, string(req.Header.Method()), string(req.URI().Path())) {
+		return DoWithContext(ctx, client, req, resp, timeout)
+	}
+	for attempt := 1; ; attempt++ {
+		resp.Reset()
+		err := DoWithContext(ctx, client, req, resp, timeout)
+		if attempt >= policy.MaxAttempts {
+			return err
+		}
+		var delay time.Duration
+		if err != nil {
+			if !retryableError(err) {
+				return err
+			}
+			delay = policy.backoff(attempt)
+		} else {
+			if !policy.retryableResponse(resp) {
+				return nil
+			}
+			var ok bool
+			if delay, ok = parseRetryAfter(string(resp.Header.Peek("Retry-After"))); !ok {
+				delay = policy.backoff(attempt)
+			} else if policy.MaxDelay > 0 && delay > policy.MaxDelay {
+				delay = policy.MaxDelay
+			}
+		}
+		if sleepErr := sleepWithContext(ctx, delay); sleepErr != nil {
+			return sleepErr
+		}
+	}
+}
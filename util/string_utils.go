@@ -1,45 +1,44 @@
 package util
 
 import (
-	"github.com/Authing/authing-golang-sdk/v3/constant"
-	"math/rand"
+	"crypto/rand"
+	"math/big"
 	"sort"
+
+	"github.com/Authing/authing-golang-sdk/v3/constant"
 )
 
 var letters = []rune("abcdefhijkmnprstwxyz2345678")
 
+// RandomString 使用 crypto/rand 生成由 letters 组成的随机字符串
 func RandomString(length int) string {
 	b := make([]rune, length)
 	for i := range b {
-		b[i] = letters[rand.Intn(len(letters))]
+		b[i] = letters[randomIndex(len(letters))]
 	}
 	return string(b)
 }
 
 const letterBytes = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
-const (
-	letterIdxBits = 6                    // 6 bits to represent a letter index
-	letterIdxMask = 1<<letterIdxBits - 1 // All 1-bits, as many as letterIdxBits
-	letterIdxMax  = 63 / letterIdxBits   // # of letter indices fitting in 63 bits
-)
 
+// RandStringImpr 使用 crypto/rand 生成由大小写字母组成的随机字符串，可用于 state、nonce、jti 等
 func RandStringImpr(n int) string {
 	b := make([]byte, n)
-	// A rand.Int63() generates 63 random bits, enough for letterIdxMax letters!
-	for i, cache, remain := n-1, rand.Int63(), letterIdxMax; i >= 0; {
-		if remain == 0 {
-			cache, remain = rand.Int63(), letterIdxMax
-		}
-		if idx := int(cache & letterIdxMask); idx < len(letterBytes) {
-			b[i] = letterBytes[idx]
-			i--
-		}
-		cache >>= letterIdxBits
-		remain--
+	for i := range b {
+		b[i] = letterBytes[randomIndex(len(letterBytes))]
 	}
 	return string(b)
 }
 
+// randomIndex 返回 [0, n) 内均匀分布的密码学安全随机数
+func randomIndex(n int) int {
+	i, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		panic("crypto/rand 不可用: " + err.Error())
+	}
+	return int(i.Int64())
+}
+
 func GetValueOrDefault(value ...string) string {
 	if value == nil || len(value) == 0 {
 		return constant.StringEmpty
@@ -56,3 +55,13 @@ func StringContains(s []string, searchTerm string) bool {
 	i := sort.SearchStrings(s, searchTerm)
 	return i < len(s) && s[i] == searchTerm
 }
+
+// ContainsString 判断 s 中是否包含 value，与 StringContains 不同，s 无需有序
+func ContainsString(s []string, value string) bool {
+	for _, item := range s {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}